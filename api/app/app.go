@@ -2,10 +2,14 @@ package app
 
 import (
 	"microservice/config"
+	"microservice/internal/adapter/health"
 	"microservice/internal/adapter/locale"
 	"microservice/internal/adapter/logger"
+	"microservice/internal/adapter/metrics"
 	"microservice/internal/adapter/orm"
 	"microservice/internal/adapter/registry"
+	"microservice/internal/adapter/storage"
+	"microservice/internal/adapter/tracing"
 )
 
 // App Dependency Injection
@@ -15,10 +19,15 @@ type App struct {
 	registry     registry.IRegistry
 	logger       logger.ILogger
 	locale       locale.ILocale
+	metrics      metrics.IMetrics
+	tracing      tracing.ITracing
+	health       health.IHealth
 	database     orm.ISql
+	storage      storage.IStorage
 	repo         *Repositories
 	port         *Ports
 	httpHandlers *HttpHandlers
+	jobs         *Jobs
 }
 
 func New() *App {
@@ -31,4 +40,5 @@ func (c *App) Init() {
 	c.InitRepositories()
 	c.InitPorts()
 	c.InitHandlers()
+	c.InitJobs()
 }
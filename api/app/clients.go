@@ -1,14 +1,20 @@
 package app
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	src "microservice"
 	"microservice/config"
+	"microservice/internal/adapter/health"
 	"microservice/internal/adapter/locale"
 	"microservice/internal/adapter/logger"
+	"microservice/internal/adapter/metrics"
 	"microservice/internal/adapter/orm"
 	"microservice/internal/adapter/registry"
+	"microservice/internal/adapter/storage"
+	"microservice/internal/adapter/tracing"
 	"time"
 )
 
@@ -17,7 +23,11 @@ func (c *App) InitClients() {
 	c.initService()
 	c.initLogger()
 	c.initLocale()
+	c.initMetrics()
+	c.initTracing()
 	c.initDatabase()
+	c.initStorage()
+	c.initHealth()
 }
 
 // Clients
@@ -67,9 +77,28 @@ func (c *App) Logger() logger.ILogger {
 	return c.logger
 }
 
+func (c *App) initMetrics() {
+	c.metrics = metrics.New()
+	c.metrics.Init()
+}
+
+func (c *App) Metrics() metrics.IMetrics {
+	return c.metrics
+}
+
+func (c *App) initTracing() {
+	c.tracing = tracing.New(c.registry)
+	c.tracing.Init()
+}
+
+func (c *App) Tracing() tracing.ITracing {
+	return c.tracing
+}
+
 func (c *App) initDatabase() {
 	c.database = orm.New(c.Config(), c.registry, c.locale)
 	c.database.Init()
+	c.instrumentDatabase()
 	c.database.Migrate(fmt.Sprintf("%s/schema/psql", src.Root()))
 	c.database.Seed() //NOTE: it is recommended to handle the seeder with CMD or with LIQUIBASE
 }
@@ -77,3 +106,62 @@ func (c *App) initDatabase() {
 func (c *App) DB() orm.ISql {
 	return c.database
 }
+
+// instrumentDatabase traces the queries when the tracing is enabled, and observes the queries and the connection pool when the metrics are enabled
+func (c *App) instrumentDatabase() {
+	var metricsConfig config.Metrics
+	var tracingConfig config.Tracing
+	var databaseConfig config.Database
+	c.registry.Parse(&metricsConfig)
+	c.registry.Parse(&tracingConfig)
+	c.registry.Parse(&databaseConfig)
+
+	if tracingConfig.Enable {
+		if err := c.database.C().Use(orm.NewTracingPlugin(c.tracing.Tracer(ormTracerName), "postgresql")); err != nil {
+			log.Fatalf("[sql] tracing plugin err: %s", err)
+		}
+	}
+
+	if metricsConfig.Enable == false {
+		return
+	}
+
+	if err := c.database.C().Use(orm.NewMetricsPlugin(c.metrics.ObserveQuery)); err != nil {
+		log.Fatalf("[sql] metrics plugin err: %s", err)
+	}
+
+	sqlDatabase, err := c.database.C().DB()
+	if err != nil {
+		log.Fatalf("[sql] metrics init err: %s", err)
+	}
+
+	c.metrics.WatchDB(sqlDatabase, databaseConfig.Database)
+}
+
+func (c *App) initStorage() {
+	c.storage = storage.New(c.registry)
+	c.storage.Init()
+}
+
+func (c *App) Storage() storage.IStorage {
+	return c.storage
+}
+
+// initHealth registers the readiness checks of the dependencies
+func (c *App) initHealth() {
+	c.health = health.New(c.registry)
+
+	c.health.Register("database", c.database.Ping)
+	c.health.Register("migrations", func(context.Context) error {
+		if c.database.Migrated() == false {
+			return errors.New("the migrations are not applied")
+		}
+
+		return nil
+	})
+	c.health.Register("storage", c.storage.Ping)
+}
+
+func (c *App) Health() health.IHealth {
+	return c.health
+}
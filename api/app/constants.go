@@ -0,0 +1,7 @@
+package app
+
+// the instrumentation scopes of the spans
+const (
+	usecaseTracerName = "microservice/usecase"
+	ormTracerName     = "microservice/orm"
+)
@@ -1,14 +1,28 @@
 package app
 
-import "microservice/internal/driver/delivery"
+import (
+	"microservice/config"
+	"microservice/internal/driver/delivery"
+)
 
 type HttpHandlers struct {
-	TodoHandler delivery.ITodoHandler
+	TodoHandler           delivery.ITodoHandler
+	TodoCommentHandler    delivery.ITodoCommentHandler
+	TodoAttachmentHandler delivery.ITodoAttachmentHandler
+	TodoShareHandler      delivery.ITodoShareHandler
+	TodoCalendarHandler   delivery.ITodoCalendarHandler
 }
 
 func (c *App) InitHandlers() {
+	var todoConfig config.Todo
+	c.registry.Parse(&todoConfig)
+
 	c.httpHandlers = new(HttpHandlers)
-	c.httpHandlers.TodoHandler = delivery.NewTodo(c.logger, c.locale, c.port.TodoUC)
+	c.httpHandlers.TodoHandler = delivery.NewTodo(c.logger, c.locale, todoConfig, c.port.TodoUC)
+	c.httpHandlers.TodoCommentHandler = delivery.NewTodoComment(c.logger, c.locale, c.port.TodoCommentUC)
+	c.httpHandlers.TodoAttachmentHandler = delivery.NewTodoAttachment(c.logger, c.locale, c.port.TodoAttachmentUC)
+	c.httpHandlers.TodoShareHandler = delivery.NewTodoShare(c.logger, c.locale, c.port.TodoShareUC)
+	c.httpHandlers.TodoCalendarHandler = delivery.NewTodoCalendar(c.logger, c.locale, c.port.TodoCalendarUC)
 }
 
 func (c *App) HttpHandlers() *HttpHandlers {
@@ -0,0 +1,145 @@
+package app
+
+import (
+	"context"
+	"microservice/config"
+	"microservice/internal/core/port"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultPurgeAfter the deleted items are restorable during that, when the config is not set
+	defaultPurgeAfter = 30 * 24 * time.Hour
+	// defaultPurgeInterval between the purge runs, when the config is not set
+	defaultPurgeInterval = time.Hour
+	// orphanGracePeriod the blobs younger than that are not swept, as their uploads may be in progress
+	orphanGracePeriod = 24 * time.Hour
+	// defaultRankMaxLength the board columns with a longer rank are rebalanced, when the config is not set
+	defaultRankMaxLength = 32
+	// defaultRebalanceInterval between the rebalance runs, when the config is not set
+	defaultRebalanceInterval = 6 * time.Hour
+	// defaultGaugeInterval between the refreshes of the item gauges, when the config is not set
+	defaultGaugeInterval = time.Minute
+)
+
+// Jobs the periodic background tasks of the service
+type Jobs struct {
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func (c *App) InitJobs() {
+	var todoConfig config.Todo
+	var metricsConfig config.Metrics
+	c.registry.Parse(&todoConfig)
+	c.registry.Parse(&metricsConfig)
+
+	after, interval := todoConfig.PurgeAfter, todoConfig.PurgeInterval
+	if after <= 0 {
+		after = defaultPurgeAfter
+	}
+
+	if interval <= 0 {
+		interval = defaultPurgeInterval
+	}
+
+	maxLength, rebalanceInterval := todoConfig.RankMaxLength, todoConfig.RebalanceInterval
+	if maxLength <= 0 {
+		maxLength = defaultRankMaxLength
+	}
+
+	if rebalanceInterval <= 0 {
+		rebalanceInterval = defaultRebalanceInterval
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	c.jobs = &Jobs{cancel: cancel}
+
+	c.jobs.every(ctx, interval, func() { c.purge(ctx, c.port.TodoUC, c.port.TodoAttachmentUC, after) })
+	c.jobs.every(ctx, rebalanceInterval, func() { c.rebalance(ctx, c.port.TodoUC, maxLength) })
+
+	if metricsConfig.Enable {
+		gaugeInterval := metricsConfig.GaugeInterval
+		if gaugeInterval <= 0 {
+			gaugeInterval = defaultGaugeInterval
+		}
+
+		c.jobs.every(ctx, gaugeInterval, func() { c.gauges(ctx, c.port.TodoUC) })
+	}
+}
+
+// StopJobs cancels the running jobs and waits for them
+func (c *App) StopJobs() {
+	if c.jobs == nil {
+		return
+	}
+
+	c.jobs.cancel()
+	c.jobs.wg.Wait()
+}
+
+// every runs the job at once, then at every interval until the context is canceled
+func (j *Jobs) every(ctx context.Context, interval time.Duration, job func()) {
+	j.wg.Add(1)
+	go func() {
+		defer j.wg.Done()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			job()
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// purge the deleted items with their attachments and comments, then the orphaned blobs
+func (c *App) purge(ctx context.Context, todoUC port.ITodoUsecase, attachmentUC port.ITodoAttachmentUsecase, after time.Duration) {
+	now := time.Now()
+
+	purged, err := todoUC.Purge(ctx, now.Add(-after))
+	if err != nil {
+		c.logger.Error("app.jobs.purge", zap.Error(err))
+	}
+
+	removed, err := attachmentUC.SweepOrphans(ctx, now.Add(-orphanGracePeriod))
+	if err != nil {
+		c.logger.Error("app.jobs.sweep", zap.Error(err))
+	}
+
+	if purged > 0 || removed > 0 {
+		c.logger.Info("app.jobs.purge", zap.Int("items", purged), zap.Int("blobs", removed))
+	}
+}
+
+// gauges refreshes the open and the overdue item counts of the metrics, they are kept on failure
+func (c *App) gauges(ctx context.Context, uc port.ITodoUsecase) {
+	stats, err := uc.Stats(ctx)
+	if err != nil {
+		c.logger.Error("app.jobs.gauges", zap.Error(err))
+		return
+	}
+
+	c.metrics.SetTodos(stats.Open(), stats.Overdue())
+}
+
+// rebalance the ranks of the board columns, when they are too long
+func (c *App) rebalance(ctx context.Context, uc port.ITodoUsecase, maxLength int) {
+	rebalanced, err := uc.Rebalance(ctx, maxLength)
+	if err != nil {
+		c.logger.Error("app.jobs.rebalance", zap.Error(err))
+	}
+
+	if rebalanced > 0 {
+		c.logger.Info("app.jobs.rebalance", zap.Int("items", rebalanced))
+	}
+}
@@ -1,15 +1,30 @@
 package app
 
 import (
+	"microservice/config"
 	"microservice/internal/core/port"
 	"microservice/internal/core/usecase"
 )
 
 type Ports struct {
-	TodoUC port.ITodoUsecase
+	TodoUC           port.ITodoUsecase
+	TodoCommentUC    port.ITodoCommentUsecase
+	TodoAttachmentUC port.ITodoAttachmentUsecase
+	TodoShareUC      port.ITodoShareUsecase
+	TodoCalendarUC   port.ITodoCalendarUsecase
 }
 
 func (c *App) InitPorts() {
+	var attachmentConfig config.Attachment
+	c.registry.Parse(&attachmentConfig)
+
 	c.port = new(Ports)
-	c.port.TodoUC = usecase.NewTodo(c.logger, c.locale, c.repo.TodoRepo)
+	c.port.TodoUC = usecase.NewTracedTodo(
+		usecase.NewTodo(c.logger, c.locale, c.storage, c.repo.TodoRepo, c.repo.TodoAttachmentRepo, c.repo.TodoShareRepo),
+		c.tracing.Tracer(usecaseTracerName),
+	)
+	c.port.TodoCommentUC = usecase.NewTodoComment(c.logger, c.locale, c.repo.TodoRepo, c.repo.TodoCommentRepo, c.repo.TodoShareRepo)
+	c.port.TodoAttachmentUC = usecase.NewTodoAttachment(c.logger, c.locale, attachmentConfig, c.storage, c.repo.TodoRepo, c.repo.TodoAttachmentRepo, c.repo.TodoShareRepo)
+	c.port.TodoShareUC = usecase.NewTodoShare(c.logger, c.locale, c.repo.TodoRepo, c.repo.TodoShareRepo)
+	c.port.TodoCalendarUC = usecase.NewTodoCalendar(c.logger, c.locale, c.repo.TodoRepo, c.repo.TodoCalendarRepo)
 }
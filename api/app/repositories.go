@@ -6,12 +6,22 @@ import (
 )
 
 type Repositories struct {
-	TodoRepo port.ITodoRepository
+	TodoRepo           port.ITodoRepository
+	TodoCommentRepo    port.ITodoCommentRepository
+	TodoAttachmentRepo port.ITodoAttachmentRepository
+	TodoShareRepo      port.ITodoShareRepository
+	TodoCalendarRepo   port.ITodoCalendarRepository
+	IdempotencyRepo    port.IIdempotencyRepository
 }
 
 func (c *App) InitRepositories() {
 	c.repo = new(Repositories)
 	c.repo.TodoRepo = repository.NewTodo(c.locale, c.logger, c.database)
+	c.repo.TodoCommentRepo = repository.NewTodoComment(c.locale, c.logger, c.database)
+	c.repo.TodoAttachmentRepo = repository.NewTodoAttachment(c.locale, c.logger, c.database)
+	c.repo.TodoShareRepo = repository.NewTodoShare(c.locale, c.logger, c.database)
+	c.repo.TodoCalendarRepo = repository.NewTodoCalendar(c.locale, c.logger, c.database)
+	c.repo.IdempotencyRepo = repository.NewIdempotency(c.locale, c.logger, c.database)
 }
 
 func (c *App) Repositories() *Repositories {
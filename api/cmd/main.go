@@ -38,7 +38,11 @@ func (a *Service) start() {
 
 	a.http = http.New(
 		a.service.Registry(),
+		a.service.Logger(),
 		a.service.Locale(),
+		a.service.Metrics(),
+		a.service.Tracing(),
+		a.service.Health(),
 		a.service.Repositories(),
 		a.service.HttpHandlers(),
 	)
@@ -52,8 +56,16 @@ func (a *Service) start() {
 	fmt.Printf("[service] started\n")
 
 	ch := make(chan os.Signal, 1)
-	signal.Notify(ch, syscall.SIGHUP, syscall.SIGINT, syscall.SIGTERM, syscall.SIGKILL)
-	<-ch
+	signal.Notify(ch, syscall.SIGHUP, syscall.SIGINT, syscall.SIGTERM)
+
+	// the SIGHUP reopens the log files for logrotate, the others stop the service
+	for sig := range ch {
+		if sig != syscall.SIGHUP {
+			break
+		}
+
+		a.service.Logger().Reopen()
+	}
 
 	a.stop()
 }
@@ -67,6 +79,8 @@ func (a *Service) stop() {
 	defer cancel()
 
 	a.http.Stop(ctx)
+	a.service.StopJobs()
+	a.service.Tracing().Stop(ctx)
 	a.service.DB().Stop()
 	a.service.Logger().Stop()
 }
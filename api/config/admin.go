@@ -0,0 +1,7 @@
+package config
+
+type Admin struct {
+	// Username the admin endpoints are protected with the basic auth, they are disabled when it is not set
+	Username string `mapstructure:"ADMIN_USERNAME"`
+	Password string `mapstructure:"ADMIN_PASSWORD" validate:"required_with=Username"`
+}
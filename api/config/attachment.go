@@ -0,0 +1,6 @@
+package config
+
+type Attachment struct {
+	MaxSize   int64  `mapstructure:"ATTACHMENT_MAX_SIZE" default:"10485760" validate:"gt=0"` // bytes of a file
+	MimeTypes string `mapstructure:"ATTACHMENT_MIME_TYPES"`                                  // comma separated allowed types like `image/*,application/pdf`, default: images, PDF, text and office documents
+}
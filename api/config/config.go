@@ -0,0 +1,21 @@
+package config
+
+// Config the configs of the service, they are loaded with their defaults and validated once at the startup.
+// the `default` tag is used when the key is not set, the `validate` tag checks the loaded value.
+// the secrets are read from the files of the `<KEY>_FILE` keys, like the Docker and Kubernetes secrets
+type Config struct {
+	Service    Service    `mapstructure:",squash"`
+	Locale     Locale     `mapstructure:",squash"`
+	Logger     Logger     `mapstructure:",squash"`
+	Database   Database   `mapstructure:",squash"`
+	Http       Http       `mapstructure:",squash"`
+	Todo       Todo       `mapstructure:",squash"`
+	Storage    Storage    `mapstructure:",squash"`
+	Attachment Attachment `mapstructure:",squash"`
+	Swagger    Swagger    `mapstructure:",squash"`
+	Metrics    Metrics    `mapstructure:",squash"`
+	Tracing    Tracing    `mapstructure:",squash"`
+	Health     Health     `mapstructure:",squash"`
+	Admin      Admin      `mapstructure:",squash"`
+	Identity   Identity   `mapstructure:",squash"`
+}
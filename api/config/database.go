@@ -2,14 +2,14 @@ package config
 
 type Database struct {
 	Debug              bool   `mapstructure:"DB_DEBUG"`
-	Host               string `mapstructure:"DB_HOST"`
-	Port               string `mapstructure:"DB_PORT"`
-	Username           string `mapstructure:"DB_USERNAME"`
+	Host               string `mapstructure:"DB_HOST" validate:"required"`
+	Port               string `mapstructure:"DB_PORT" default:"5432" validate:"numeric"`
+	Username           string `mapstructure:"DB_USERNAME" validate:"required"`
 	Password           string `mapstructure:"DB_PASSWORD"`
-	Database           string `mapstructure:"DB_DATABASE"`
-	Ssl                string `mapstructure:"DB_SSL"`
-	MaxIdleConnections int    `mapstructure:"DB_MAX_IDLE_CONNECTIONS"`
-	MaxOpenConnections int    `mapstructure:"DB_MAX_OPEN_CONNECTIONS"`
-	MaxLifetimeSeconds int    `mapstructure:"DB_MAX_LIFETIME_SECONDS"`
-	SlowSqlThreshold   int    `mapstructure:"DB_SLOW_SQL_THRESHOLD"`
+	Database           string `mapstructure:"DB_DATABASE" validate:"required"`
+	Ssl                string `mapstructure:"DB_SSL" default:"disable" validate:"oneof=disable allow prefer require verify-ca verify-full"`
+	MaxIdleConnections int    `mapstructure:"DB_MAX_IDLE_CONNECTIONS" validate:"gte=0"`
+	MaxOpenConnections int    `mapstructure:"DB_MAX_OPEN_CONNECTIONS" validate:"gte=0"`
+	MaxLifetimeSeconds int    `mapstructure:"DB_MAX_LIFETIME_SECONDS" validate:"gte=0"`
+	SlowSqlThreshold   int    `mapstructure:"DB_SLOW_SQL_THRESHOLD" validate:"gte=0"`
 }
@@ -0,0 +1,10 @@
+package config
+
+import "time"
+
+type Health struct {
+	// CheckTimeout the timeout of every readiness check
+	CheckTimeout time.Duration `mapstructure:"HEALTH_CHECK_TIMEOUT" default:"2s" validate:"gte=0"`
+	// DrainDelay the readiness fails during that before the server is shut down, so the load balancers stop routing to it
+	DrainDelay time.Duration `mapstructure:"HEALTH_DRAIN_DELAY" validate:"gte=0"`
+}
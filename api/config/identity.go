@@ -0,0 +1,7 @@
+package config
+
+type Identity struct {
+	// TrustHeaders the user id and the groups are read from the headers of the gateway, every request is anonymous when it is not set
+	TrustHeaders bool   `mapstructure:"IDENTITY_TRUST_HEADERS"`
+	Proxies      string `mapstructure:"IDENTITY_PROXIES" validate:"required_if=TrustHeaders true"` // comma separated IPs or CIDRs of the gateways like `10.0.0.0/8,127.0.0.1`, the headers of the other peers are ignored
+}
@@ -1,5 +1,5 @@
 package config
 
 type Locale struct {
-	Lang string `mapstructure:"APP_LOCALE"` // Lang tag, like "en-US"
+	Lang string `mapstructure:"APP_LOCALE" default:"en-US" validate:"bcp47_language_tag"` // Lang tag of the default language, like "en-US", the requests negotiate theirs by Accept-Language
 }
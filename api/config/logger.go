@@ -1,5 +1,19 @@
 package config
 
+import "time"
+
 type Logger struct {
-	LogLevel string `mapstructure:"APP_LOG_LEVEL"`
+	LogLevel string `mapstructure:"APP_LOG_LEVEL" default:"info" validate:"oneof=debug info warn error"` // debug, info, warn or error
+	// SamplingInitial the first entries of the same level and message are logged per tick, then every SamplingThereafter-th of them.
+	// the errors are not sampled, zero disables the sampling
+	SamplingInitial    int           `mapstructure:"LOG_SAMPLING_INITIAL" validate:"gte=0"`
+	SamplingThereafter int           `mapstructure:"LOG_SAMPLING_THEREAFTER" default:"100" validate:"gte=0"`
+	SamplingTick       time.Duration `mapstructure:"LOG_SAMPLING_TICK" default:"1s" validate:"gte=0"`
+	// MaxSizeMB the files are rotated by the size besides the midnight of the service time zone
+	MaxSizeMB  int           `mapstructure:"LOG_MAX_SIZE_MB" default:"100" validate:"gte=0"`
+	MaxBackups int           `mapstructure:"LOG_MAX_BACKUPS" validate:"gte=0"` // the count of the kept rotated files per level, zero keeps all of them
+	MaxAge     time.Duration `mapstructure:"LOG_MAX_AGE" validate:"gte=0"`     // the rotated files older than that are removed, zero keeps all of them
+	Compress   bool          `mapstructure:"LOG_COMPRESS"`                     // gzips the rotated files
+	// RedactKeys the comma separated keys of the masked fields, a field is masked when its key contains any of them
+	RedactKeys []string `mapstructure:"LOG_REDACT_KEYS" default:"password,secret,token,authorization,cookie,email,dsn"`
 }
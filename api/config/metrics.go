@@ -0,0 +1,12 @@
+package config
+
+import "time"
+
+type Metrics struct {
+	Enable   bool   `mapstructure:"METRICS_ENABLE"`
+	Path     string `mapstructure:"METRICS_PATH" default:"/metrics"`
+	Username string `mapstructure:"METRICS_USERNAME"` // the endpoint is protected with the basic auth, when it is set
+	Password string `mapstructure:"METRICS_PASSWORD" validate:"required_with=Username"`
+	// GaugeInterval the open and the overdue item counts are refreshed at that
+	GaugeInterval time.Duration `mapstructure:"METRICS_GAUGE_INTERVAL" default:"1m" validate:"gte=0"`
+}
@@ -4,8 +4,14 @@ import "time"
 
 type Http struct {
 	Host         string        `mapstructure:"HTTP_HOST"`
-	Port         string        `mapstructure:"HTTP_PORT"`
-	WriteTimeout time.Duration `mapstructure:"HTTP_WRITE_TIMEOUT"`
-	ReadTimeout  time.Duration `mapstructure:"HTTP_READ_TIMEOUT"`
+	Port         string        `mapstructure:"HTTP_PORT" default:"8080" validate:"numeric"`
+	WriteTimeout time.Duration `mapstructure:"HTTP_WRITE_TIMEOUT" default:"60s" validate:"gte=0"` // zero disables the timeout, the streamed responses like the exports extend it on every write
+	ReadTimeout  time.Duration `mapstructure:"HTTP_READ_TIMEOUT" default:"60s" validate:"gte=0"`
 	Tls          bool          `mapstructure:"HTTP_TLS"`
+	TlsCert      string        `mapstructure:"HTTP_TLS_CERT" validate:"required_if=Tls true,omitempty,file"` // path of the PEM certificate chain, required by the TLS
+	TlsKey       string        `mapstructure:"HTTP_TLS_KEY" validate:"required_if=Tls true,omitempty,file"`  // path of the PEM private key, required by the TLS
+	// IdempotencyTTL the responses of the `Idempotency-Key` requests are replayed during that
+	IdempotencyTTL time.Duration `mapstructure:"HTTP_IDEMPOTENCY_TTL" default:"24h" validate:"gte=0"`
+	// IdempotencyMaxBody bytes of the body of the `Idempotency-Key` requests, the body is buffered to be hashed
+	IdempotencyMaxBody int64 `mapstructure:"HTTP_IDEMPOTENCY_MAX_BODY" default:"5242880" validate:"gte=0"`
 }
@@ -1,11 +1,11 @@
 package config
 
 type Service struct {
-	Locale
-	Env         string `mapstructure:"APP_ENV"`
-	Name        string `mapstructure:"APP_NAME"`
+	Locale      `mapstructure:",squash"`
+	Env         string `mapstructure:"APP_ENV" default:"development" validate:"oneof=development stage prod"`
+	Name        string `mapstructure:"APP_NAME" default:"microservice" validate:"required"`
 	Debug       bool   `mapstructure:"APP_DEBUG"`
-	LogLevel    string `mapstructure:"APP_LOG_LEVEL"`
-	TimeZone    string `mapstructure:"APP_TIMEZONE"`
-	StopTimeout int    `mapstructure:"APP_STOP_TIMEOUT"`
+	LogLevel    string `mapstructure:"APP_LOG_LEVEL" default:"info" validate:"oneof=debug info warn error"`
+	TimeZone    string `mapstructure:"APP_TIMEZONE" default:"UTC" validate:"timezone"`
+	StopTimeout int    `mapstructure:"APP_STOP_TIMEOUT" default:"30" validate:"gt=0"` // seconds
 }
@@ -0,0 +1,12 @@
+package config
+
+type Storage struct {
+	Driver      string `mapstructure:"STORAGE_DRIVER" default:"local" validate:"oneof=local s3"` // `local` or `s3`
+	LocalPath   string `mapstructure:"STORAGE_LOCAL_PATH"`                                       // root directory of the local blobs
+	S3Endpoint  string `mapstructure:"STORAGE_S3_ENDPOINT"`                                      // endpoint of the S3-compatible services like MinIO, empty for AWS
+	S3Region    string `mapstructure:"STORAGE_S3_REGION" default:"us-east-1"`
+	S3Bucket    string `mapstructure:"STORAGE_S3_BUCKET" validate:"required_if=Driver s3"` // the bucket is not created by the service
+	S3AccessKey string `mapstructure:"STORAGE_S3_ACCESS_KEY"`                              // static credentials
+	S3SecretKey string `mapstructure:"STORAGE_S3_SECRET_KEY"`
+	S3PathStyle bool   `mapstructure:"STORAGE_S3_PATH_STYLE"` // path-style addressing, required by most of the S3-compatible services
+}
@@ -0,0 +1,15 @@
+package config
+
+import "time"
+
+type Todo struct {
+	BulkMaxSize   int   `mapstructure:"TODO_BULK_MAX_SIZE" default:"100" validate:"gt=0"`       // operations of a bulk request
+	ImportMaxRows int   `mapstructure:"TODO_IMPORT_MAX_ROWS" default:"1000" validate:"gt=0"`    // rows of an imported file
+	ImportMaxSize int64 `mapstructure:"TODO_IMPORT_MAX_SIZE" default:"5242880" validate:"gt=0"` // bytes of an imported file
+	// PurgeAfter the deleted items are restorable during that, then they are purged with their attachments
+	PurgeAfter    time.Duration `mapstructure:"TODO_PURGE_AFTER" default:"720h" validate:"gte=0"`
+	PurgeInterval time.Duration `mapstructure:"TODO_PURGE_INTERVAL" default:"1h" validate:"gte=0"`
+	// RankMaxLength the board columns with a longer rank are rebalanced
+	RankMaxLength     int           `mapstructure:"TODO_RANK_MAX_LENGTH" default:"32" validate:"gte=0"`
+	RebalanceInterval time.Duration `mapstructure:"TODO_RANK_REBALANCE_INTERVAL" default:"6h" validate:"gte=0"`
+}
@@ -0,0 +1,10 @@
+package config
+
+type Tracing struct {
+	Enable   bool   `mapstructure:"TRACING_ENABLE"`
+	Exporter string `mapstructure:"TRACING_EXPORTER" default:"otlp" validate:"oneof=otlp stdout"` // otlp or stdout
+	Endpoint string `mapstructure:"TRACING_ENDPOINT" default:"localhost:4318"`                    // host:port of the OTLP/HTTP collector
+	Insecure bool   `mapstructure:"TRACING_INSECURE"`                                             // the collector without TLS
+	// SampleRatio the ratio of the sampled root spans, the remote parents decide for their children
+	SampleRatio float64 `mapstructure:"TRACING_SAMPLE_RATIO" default:"1" validate:"gte=0,lte=1"`
+}
@@ -15,8 +15,42 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/api/v1/todo/create": {
-            "post": {
+        "/admin/log-level": {
+            "get": {
+                "description": "The minimum enabled level of the logs",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Log Level",
+                "responses": {
+                    "200": {
+                        "description": "success response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        " error": {
+                                            "type": "object"
+                                        },
+                                        "data": {
+                                            "$ref": "#/definitions/routes.logLevelResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Changes the minimum enabled level of the logs at runtime, it is reset by the restart",
                 "consumes": [
                     "application/json"
                 ],
@@ -24,22 +58,22 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Todo"
+                    "Admin"
                 ],
-                "summary": "Create New Todo",
+                "summary": "Change Log Level",
                 "parameters": [
                     {
-                        "description": "necessary fields for request",
-                        "name": "Request",
+                        "description": "the level",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.CreateRequest"
+                            "$ref": "#/definitions/routes.logLevelRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
+                    "200": {
                         "description": "success response",
                         "schema": {
                             "allOf": [
@@ -53,7 +87,79 @@ const docTemplate = `{
                                             "type": "object"
                                         },
                                         "data": {
-                                            "$ref": "#/definitions/dto.CreateResponse"
+                                            "$ref": "#/definitions/routes.logLevelResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "invalid level",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/shared/{token}": {
+            "get": {
+                "description": "The read-only access to the item by the token of its share link, the identity is not required",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Share"
+                ],
+                "summary": "Get Shared Todo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "q0Zx3Jm2b6LhT9r1Vw8yNc4uEa7sKd5fGp0oRi2jHl",
+                        "description": "Share link token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "` + "`" + `html` + "`" + ` renders the Markdown notes to the sanitised HTML",
+                        "name": "render",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        " error": {
+                                            "type": "object"
+                                        },
+                                        "data": {
+                                            "$ref": "#/definitions/dto.DetailResponse"
                                         }
                                     }
                                 }
@@ -79,7 +185,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "not found",
+                        "description": "unknown token, or the item is deleted",
                         "schema": {
                             "allOf": [
                                 {
@@ -96,8 +202,8 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "409": {
-                        "description": "already exists",
+                    "410": {
+                        "description": "the link is expired",
                         "schema": {
                             "allOf": [
                                 {
@@ -115,7 +221,7 @@ const docTemplate = `{
                         }
                     },
                     "422": {
-                        "description": "unprocessable",
+                        "description": "invalid data types",
                         "schema": {
                             "allOf": [
                                 {
@@ -135,8 +241,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/todo/list": {
-            "get": {
+        "/api/v1/todo/bulk": {
+            "post": {
+                "description": "The ` + "`" + `atomic` + "`" + ` mode applies all operations in a single transaction, the first failure rolls back all of them.\nThe ` + "`" + `best_effort` + "`" + ` mode applies every operation independently and reports the failures per item(207).",
                 "consumes": [
                     "application/json"
                 ],
@@ -146,42 +253,28 @@ const docTemplate = `{
                 "tags": [
                     "Todo"
                 ],
-                "summary": "Get Todos List",
+                "summary": "Bulk Create, Update, Complete and Delete Todos",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Page Number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page Limit",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "` + "`" + `id` + "`" + ` ` + "`" + `description` + "`" + ` ` + "`" + `created_at` + "`" + ` ` + "`" + `updated_at` + "`" + `",
-                        "name": "sort",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "` + "`" + `asc` + "`" + ` or ` + "`" + `desc` + "`" + `",
-                        "name": "order",
-                        "in": "query"
+                        "description": "operations in the order of execution",
+                        "name": "Request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.BulkRequest"
+                        }
                     },
                     {
                         "type": "string",
-                        "description": "Search the Description",
-                        "name": "search",
-                        "in": "query"
+                        "example": "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+                        "description": "unique key of the request, the retries with the same key replay the first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "success response",
+                        "description": "all operations are applied",
                         "schema": {
                             "allOf": [
                                 {
@@ -194,7 +287,28 @@ const docTemplate = `{
                                             "type": "object"
                                         },
                                         "data": {
-                                            "$ref": "#/definitions/dto.TodoListResponse"
+                                            "$ref": "#/definitions/dto.BulkResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "207": {
+                        "description": "some operations of the best-effort request are failed",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        " error": {
+                                            "type": "object"
+                                        },
+                                        "data": {
+                                            "$ref": "#/definitions/dto.BulkResponse"
                                         }
                                     }
                                 }
@@ -202,7 +316,61 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "process failure",
+                        "description": "process failure, the atomic request is rolled back",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.BulkResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "not found, the atomic request is rolled back",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.BulkResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "412": {
+                        "description": "outdated version, the atomic request is rolled back",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.BulkResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "413": {
+                        "description": "the body of the idempotent request exceeds the size limit",
                         "schema": {
                             "allOf": [
                                 {
@@ -220,7 +388,7 @@ const docTemplate = `{
                         }
                     },
                     "422": {
-                        "description": "database error while retrieving",
+                        "description": "unprocessable, or too many operations",
                         "schema": {
                             "allOf": [
                                 {
@@ -240,8 +408,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/todo/{uuid}": {
+        "/api/v1/todo/calendar/feeds": {
             "get": {
+                "description": "Lists the feeds of the user without their tokens",
                 "consumes": [
                     "application/json"
                 ],
@@ -249,16 +418,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Todo"
+                    "Calendar"
                 ],
-                "summary": "Get Todo Details",
+                "summary": "Get Calendar Feeds",
                 "parameters": [
                     {
                         "type": "string",
-                        "example": "f81eee2d-2cca-4169-8062-7404a78d5c3b",
-                        "description": "Todo UUID",
-                        "name": "uuid",
-                        "in": "path",
+                        "example": "42",
+                        "description": "id of the user",
+                        "name": "X-User-ID",
+                        "in": "header",
                         "required": true
                     }
                 ],
@@ -277,7 +446,10 @@ const docTemplate = `{
                                             "type": "object"
                                         },
                                         "data": {
-                                            "$ref": "#/definitions/dto.DetailResponse"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.CalendarFeedResponse"
+                                            }
                                         }
                                     }
                                 }
@@ -302,8 +474,8 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "404": {
-                        "description": "not found",
+                    "401": {
+                        "description": "the user is unknown",
                         "schema": {
                             "allOf": [
                                 {
@@ -319,9 +491,50 @@ const docTemplate = `{
                                 }
                             ]
                         }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates an iCalendar feed of the items readable by the user, the calendars subscribe to its path. Its token is returned only once",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Calendar"
+                ],
+                "summary": "Create Calendar Feed",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "42",
+                        "description": "id of the user",
+                        "name": "X-User-ID",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "necessary fields for request",
+                        "name": "Request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CalendarFeedCreateRequest"
+                        }
                     },
-                    "422": {
-                        "description": "invalid data types",
+                    {
+                        "type": "string",
+                        "example": "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+                        "description": "unique key of the request, the retries with the same key replay the first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "success response",
                         "schema": {
                             "allOf": [
                                 {
@@ -330,20 +543,76 @@ const docTemplate = `{
                                 {
                                     "type": "object",
                                     "properties": {
-                                        "data": {
+                                        " error": {
                                             "type": "object"
+                                        },
+                                        "data": {
+                                            "$ref": "#/definitions/dto.CalendarFeedResponse"
                                         }
                                     }
                                 }
                             ]
                         }
-                    }
-                }
-            }
-        },
-        "/handshake": {
-            "get": {
-                "description": "Checks the Service Availability",
+                    },
+                    "400": {
+                        "description": "process failure",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "the user is unknown",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "422": {
+                        "description": "unprocessable",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/todo/calendar/feeds/{feed}": {
+            "delete": {
                 "consumes": [
                     "application/json"
                 ],
@@ -351,81 +620,5139 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Health"
+                    "Calendar"
+                ],
+                "summary": "Revoke Calendar Feed",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "5c7e9a1b-3d5f-4a7b-9c1d-3e5f7a9b1c2d",
+                        "description": "Feed UUID",
+                        "name": "feed",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "42",
+                        "description": "id of the user",
+                        "name": "X-User-ID",
+                        "in": "header",
+                        "required": true
+                    }
                 ],
-                "summary": "Service Handshake",
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "success response",
                         "schema": {
-                            "$ref": "#/definitions/routes.response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        " error": {
+                                            "type": "object"
+                                        },
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "process failure",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "the user is unknown",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "422": {
+                        "description": "invalid data types",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/todo/create": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Todo"
+                ],
+                "summary": "Create New Todo",
+                "parameters": [
+                    {
+                        "description": "necessary fields for request",
+                        "name": "Request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "example": "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+                        "description": "unique key of the request, the retries with the same key replay the first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "success response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        " error": {
+                                            "type": "object"
+                                        },
+                                        "data": {
+                                            "$ref": "#/definitions/dto.CreateResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "version of the item"
+                            },
+                            "Idempotent-Replayed": {
+                                "type": "string",
+                                "description": "true for the replayed response of a retry"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "process failure",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
                         }
+                    },
+                    "409": {
+                        "description": "already exists, or the request with the same idempotency key is in progress",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "422": {
+                        "description": "unprocessable, or the idempotency key is reused with a different request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/todo/export": {
+            "get": {
+                "description": "Streams the items of the list filters in the list order as a file, the pagination is not applied.\nThe ` + "`" + `todotxt` + "`" + ` format has no notes, the due date and the ` + "`" + `in_progress` + "`" + ` column are kept in the ` + "`" + `due:` + "`" + ` and ` + "`" + `status:` + "`" + ` tags.\nThe ` + "`" + `ics` + "`" + ` format is an iCalendar(RFC 5545) of VTODO components with the recurrence rules of the due dates.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/csv",
+                    "application/x-ndjson",
+                    "text/plain",
+                    "text/calendar"
+                ],
+                "tags": [
+                    "Todo"
+                ],
+                "summary": "Export Todos",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "` + "`" + `csv` + "`" + ` ` + "`" + `json` + "`" + ` ` + "`" + `ndjson` + "`" + ` ` + "`" + `todotxt` + "`" + ` ` + "`" + `ics` + "`" + `",
+                        "name": "format",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "` + "`" + `id` + "`" + ` ` + "`" + `description` + "`" + ` ` + "`" + `due_date` + "`" + ` ` + "`" + `completed_at` + "`" + ` ` + "`" + `created_at` + "`" + `(default) ` + "`" + `updated_at` + "`" + ` ` + "`" + `status` + "`" + ` ` + "`" + `board_rank` + "`" + ` ` + "`" + `rank` + "`" + `(default of search)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "` + "`" + `asc` + "`" + ` or ` + "`" + `desc` + "`" + `",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Full-text search of the Description(web-search syntax: quoted phrase, or, -excluded)",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Language of the searched phrase, like ` + "`" + `english` + "`" + `, ` + "`" + `german` + "`" + `, ` + "`" + `simple` + "`" + `",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2025-08-07",
+                        "description": "Due at or after, date-only or RFC3339",
+                        "name": "due_after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2025-08-14T00:00:00+02:00",
+                        "description": "Due before, date-only or RFC3339",
+                        "name": "due_before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only the items with a passed due date",
+                        "name": "overdue",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "` + "`" + `today` + "`" + ` ` + "`" + `tomorrow` + "`" + ` ` + "`" + `this_week` + "`" + ` ` + "`" + `no_due_date` + "`" + `",
+                        "name": "view",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "Europe/Berlin",
+                        "description": "IANA time zone of the views and date-only values, default: UTC",
+                        "name": "tz",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "me",
+                        "description": "Only the items assigned to the user, ` + "`" + `me` + "`" + ` for the caller(X-User-ID)",
+                        "name": "assignee",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only the items without assignee",
+                        "name": "unassigned",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only the items of the board column: ` + "`" + `todo` + "`" + ` ` + "`" + `in_progress` + "`" + ` ` + "`" + `done` + "`" + `",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "the file of the format, the items of the json and the ndjson formats",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.ExportItem"
+                            }
+                        },
+                        "headers": {
+                            "Content-Disposition": {
+                                "type": "string",
+                                "description": "attachment with the file name of the format"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "process failure",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "` + "`" + `assignee=me` + "`" + ` without X-User-ID",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "422": {
+                        "description": "invalid format or filters",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/todo/import": {
+            "post": {
+                "description": "Creates the items of the file all-or-nothing, the body is the file in the format of the export.\nEvery row is validated like the create request, the invalid rows are reported by their line and nothing is imported.\nThe CSV file requires a header row with a ` + "`" + `description` + "`" + ` column, the JSON file is an array, the other columns and keys of the export are ignored.\nThe ` + "`" + `ics` + "`" + ` file imports its VTODO and VEVENT components with the DUE or the DTSTART as the due date, the cancelled ones are skipped.",
+                "consumes": [
+                    "application/json",
+                    "text/csv",
+                    "application/x-ndjson",
+                    "text/plain",
+                    "text/calendar"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Todo"
+                ],
+                "summary": "Import Todos",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "` + "`" + `csv` + "`" + ` ` + "`" + `json` + "`" + ` ` + "`" + `ndjson` + "`" + ` ` + "`" + `todotxt` + "`" + ` ` + "`" + `ics` + "`" + `",
+                        "name": "format",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "validates the file without importing it",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "description": "the file",
+                        "name": "Request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.ImportRow"
+                            }
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "example": "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+                        "description": "unique key of the request, the retries with the same key replay the first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "the file of the dry run is valid",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        " error": {
+                                            "type": "object"
+                                        },
+                                        "data": {
+                                            "$ref": "#/definitions/dto.ImportResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "201": {
+                        "description": "the rows are imported",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        " error": {
+                                            "type": "object"
+                                        },
+                                        "data": {
+                                            "$ref": "#/definitions/dto.ImportResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "process failure",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "413": {
+                        "description": "the file exceeds the size or the row limit",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "422": {
+                        "description": "invalid rows, or the file has no rows",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dto.ImportResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/todo/list": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Todo"
+                ],
+                "summary": "Get Todos List",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page Number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "` + "`" + `id` + "`" + ` ` + "`" + `description` + "`" + ` ` + "`" + `due_date` + "`" + ` ` + "`" + `completed_at` + "`" + ` ` + "`" + `created_at` + "`" + `(default) ` + "`" + `updated_at` + "`" + ` ` + "`" + `status` + "`" + ` ` + "`" + `board_rank` + "`" + ` ` + "`" + `rank` + "`" + `(default of search)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "` + "`" + `asc` + "`" + ` or ` + "`" + `desc` + "`" + `",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Full-text search of the Description(web-search syntax: quoted phrase, or, -excluded)",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Language of the searched phrase, like ` + "`" + `english` + "`" + `, ` + "`" + `german` + "`" + `, ` + "`" + `simple` + "`" + `",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2025-08-07",
+                        "description": "Due at or after, date-only or RFC3339",
+                        "name": "due_after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2025-08-14T00:00:00+02:00",
+                        "description": "Due before, date-only or RFC3339",
+                        "name": "due_before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only the items with a passed due date",
+                        "name": "overdue",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "` + "`" + `today` + "`" + ` ` + "`" + `tomorrow` + "`" + ` ` + "`" + `this_week` + "`" + ` ` + "`" + `no_due_date` + "`" + `",
+                        "name": "view",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "Europe/Berlin",
+                        "description": "IANA time zone of the views and date-only values, default: UTC",
+                        "name": "tz",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "me",
+                        "description": "Only the items assigned to the user, ` + "`" + `me` + "`" + ` for the caller(X-User-ID)",
+                        "name": "assignee",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only the items without assignee",
+                        "name": "unassigned",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only the items of the board column: ` + "`" + `todo` + "`" + ` ` + "`" + `in_progress` + "`" + ` ` + "`" + `done` + "`" + `, sort by ` + "`" + `board_rank` + "`" + ` and order ` + "`" + `asc` + "`" + ` for the column order",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        " error": {
+                                            "type": "object"
+                                        },
+                                        "data": {
+                                            "$ref": "#/definitions/dto.TodoListResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "process failure",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "` + "`" + `assignee=me` + "`" + ` without X-User-ID",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "422": {
+                        "description": "database error while retrieving",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/todo/shared": {
+            "get": {
+                "description": "Lists the items shared with the user or with the groups of the user by the other users, it supports the filters of the list",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Share"
+                ],
+                "summary": "Get Todos Shared With Me",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "42",
+                        "description": "id of the user",
+                        "name": "X-User-ID",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "design,backend",
+                        "description": "comma separated ids of the groups of the user",
+                        "name": "X-User-Groups",
+                        "in": "header"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page Number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "` + "`" + `id` + "`" + ` ` + "`" + `description` + "`" + ` ` + "`" + `due_date` + "`" + ` ` + "`" + `completed_at` + "`" + ` ` + "`" + `created_at` + "`" + `(default) ` + "`" + `updated_at` + "`" + ` ` + "`" + `status` + "`" + ` ` + "`" + `board_rank` + "`" + ` ` + "`" + `rank` + "`" + `(default of search)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "` + "`" + `asc` + "`" + ` or ` + "`" + `desc` + "`" + `",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Full-text search of the Description(web-search syntax: quoted phrase, or, -excluded)",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Language of the searched phrase, like ` + "`" + `english` + "`" + `, ` + "`" + `german` + "`" + `, ` + "`" + `simple` + "`" + `",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2025-08-07",
+                        "description": "Due at or after, date-only or RFC3339",
+                        "name": "due_after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2025-08-14T00:00:00+02:00",
+                        "description": "Due before, date-only or RFC3339",
+                        "name": "due_before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only the items with a passed due date",
+                        "name": "overdue",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "` + "`" + `today` + "`" + ` ` + "`" + `tomorrow` + "`" + ` ` + "`" + `this_week` + "`" + ` ` + "`" + `no_due_date` + "`" + `",
+                        "name": "view",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "Europe/Berlin",
+                        "description": "IANA time zone of the views and date-only values, default: UTC",
+                        "name": "tz",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        " error": {
+                                            "type": "object"
+                                        },
+                                        "data": {
+                                            "$ref": "#/definitions/dto.TodoListResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "process failure",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "the user is unknown",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "422": {
+                        "description": "invalid data types",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/todo/workload": {
+            "get": {
+                "description": "The open and the overdue items per assignee, the items without assignee are counted with the null assignee. Only the items readable by the caller are counted",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Todo"
+                ],
+                "summary": "Get Todo Workload",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "7",
+                        "description": "id of the user",
+                        "name": "X-User-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "example": "design,backend",
+                        "description": "comma separated ids of the groups of the user",
+                        "name": "X-User-Groups",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        " error": {
+                                            "type": "object"
+                                        },
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.WorkloadResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "process failure",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/todo/{uuid}": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Todo"
+                ],
+                "summary": "Get Todo Details",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "f81eee2d-2cca-4169-8062-7404a78d5c3b",
+                        "description": "Todo UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "` + "`" + `html` + "`" + ` renders the Markdown notes to the sanitised HTML",
+                        "name": "render",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "\"3\"",
+                        "description": "ETag of the cached item",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        " error": {
+                                            "type": "object"
+                                        },
+                                        "data": {
+                                            "$ref": "#/definitions/dto.DetailResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "version of the item"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "not modified"
+                    },
+                    "400": {
+                        "description": "process failure",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "422": {
+                        "description": "invalid data types",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replaces the fields of the item, the omitted optional fields are cleared",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Todo"
+                ],
+                "summary": "Update Todo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "f81eee2d-2cca-4169-8062-7404a78d5c3b",
+                        "description": "Todo UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "\"3\"",
+                        "description": "ETag of the item, the update is rejected when the item was modified meanwhile",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "necessary fields for request",
+                        "name": "Request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        " error": {
+                                            "type": "object"
+                                        },
+                                        "data": {
+                                            "$ref": "#/definitions/dto.DetailResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "version of the item"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "process failure",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "412": {
+                        "description": "modified by another request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "422": {
+                        "description": "unprocessable",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Todo"
+                ],
+                "summary": "Delete Todo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "f81eee2d-2cca-4169-8062-7404a78d5c3b",
+                        "description": "Todo UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "\"3\"",
+                        "description": "ETag of the item, the deletion is rejected when the item was modified meanwhile",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        " error": {
+                                            "type": "object"
+                                        },
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "process failure",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "412": {
+                        "description": "modified by another request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "422": {
+                        "description": "invalid data types",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/todo/{uuid}/activity": {
+            "get": {
+                "description": "The comments and the changes(revisions) of the item merged in the chronological order",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comment"
+                ],
+                "summary": "Get Todo Activity Feed",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "f81eee2d-2cca-4169-8062-7404a78d5c3b",
+                        "description": "Todo UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page Number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "` + "`" + `asc` + "`" + `(default) or ` + "`" + `desc` + "`" + ` by the creation",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        " error": {
+                                            "type": "object"
+                                        },
+                                        "data": {
+                                            "$ref": "#/definitions/dto.ActivityResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "process failure",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "todo not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "422": {
+                        "description": "invalid data types",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/todo/{uuid}/assignees": {
+            "put": {
+                "description": "Replaces the assignees of the item, the empty list unassigns everyone",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Todo"
+                ],
+                "summary": "Assign Todo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "f81eee2d-2cca-4169-8062-7404a78d5c3b",
+                        "description": "Todo UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "7",
+                        "description": "id of the editor",
+                        "name": "X-User-ID",
+                        "in": "header"
+                    },
+                    {
+                        "description": "necessary fields for request",
+                        "name": "Request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.AssignRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        " error": {
+                                            "type": "object"
+                                        },
+                                        "data": {
+                                            "$ref": "#/definitions/dto.DetailResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "process failure",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "not an editor of the item",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "422": {
+                        "description": "unprocessable",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/todo/{uuid}/attachments": {
+            "get": {
+                "description": "Lists the attachments of the item in the order of their upload",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Attachment"
+                ],
+                "summary": "Get Todo Attachments List",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "f81eee2d-2cca-4169-8062-7404a78d5c3b",
+                        "description": "Todo UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        " error": {
+                                            "type": "object"
+                                        },
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.AttachmentResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "process failure",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "todo not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "422": {
+                        "description": "invalid data types",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "The ` + "`" + `file` + "`" + ` part of the form is streamed, the size and the type detected from the content are limited by the config",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Attachment"
+                ],
+                "summary": "Upload Todo Attachment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "f81eee2d-2cca-4169-8062-7404a78d5c3b",
+                        "description": "Todo UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "42",
+                        "description": "id of the uploader",
+                        "name": "X-User-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "file",
+                        "description": "the attached file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "success response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        " error": {
+                                            "type": "object"
+                                        },
+                                        "data": {
+                                            "$ref": "#/definitions/dto.AttachmentResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "process failure",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "todo not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "413": {
+                        "description": "the file exceeds the size limit",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "415": {
+                        "description": "the type of the file is not allowed",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "422": {
+                        "description": "the file part is missing",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/todo/{uuid}/attachments/{attachment}": {
+            "get": {
+                "description": "Streams the content, the SHA-256 of the content is sent by the ` + "`" + `Repr-Digest` + "`" + ` header",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "Attachment"
+                ],
+                "summary": "Download Todo Attachment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "f81eee2d-2cca-4169-8062-7404a78d5c3b",
+                        "description": "Todo UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "0d6c2b1e-4f3a-4b8e-9c7d-1a2b3c4d5e6f",
+                        "description": "Attachment UUID",
+                        "name": "attachment",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "the content of the file",
+                        "schema": {
+                            "type": "file"
+                        },
+                        "headers": {
+                            "Repr-Digest": {
+                                "type": "string",
+                                "description": "sha-256=:\u003cbase64 of the checksum\u003e:"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "process failure",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "422": {
+                        "description": "invalid data types",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes the attachment with its content",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Attachment"
+                ],
+                "summary": "Delete Todo Attachment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "f81eee2d-2cca-4169-8062-7404a78d5c3b",
+                        "description": "Todo UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "0d6c2b1e-4f3a-4b8e-9c7d-1a2b3c4d5e6f",
+                        "description": "Attachment UUID",
+                        "name": "attachment",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        " error": {
+                                            "type": "object"
+                                        },
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "process failure",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "422": {
+                        "description": "invalid data types",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/todo/{uuid}/comments": {
+            "get": {
+                "description": "Lists the root comments with their reply counts, or the replies of the ` + "`" + `parent` + "`" + ` comment",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comment"
+                ],
+                "summary": "Get Todo Comments List",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "f81eee2d-2cca-4169-8062-7404a78d5c3b",
+                        "description": "Todo UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page Number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "` + "`" + `asc` + "`" + `(default) or ` + "`" + `desc` + "`" + ` by the creation",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "5b0e7f1c-3d4a-4c1e-9b8f-2a6d7e9c0f13",
+                        "description": "UUID of the root comment, lists its replies",
+                        "name": "parent",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        " error": {
+                                            "type": "object"
+                                        },
+                                        "data": {
+                                            "$ref": "#/definitions/dto.CommentListResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "process failure",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "todo not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "422": {
+                        "description": "invalid data types",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "The ` + "`" + `@user` + "`" + ` mentions of the body are parsed, the reply to a reply is added to the thread of its root comment",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comment"
+                ],
+                "summary": "Create Todo Comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "f81eee2d-2cca-4169-8062-7404a78d5c3b",
+                        "description": "Todo UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "42",
+                        "description": "id of the author",
+                        "name": "X-User-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "example": "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+                        "description": "unique key of the request, the retries with the same key replay the first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "necessary fields for request",
+                        "name": "Request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CommentCreateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "success response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        " error": {
+                                            "type": "object"
+                                        },
+                                        "data": {
+                                            "$ref": "#/definitions/dto.CommentResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "process failure",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "todo not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "422": {
+                        "description": "unprocessable, or the replied comment does not belong to the item",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/todo/{uuid}/comments/{comment}": {
+            "put": {
+                "description": "Only the author edits the comment, the anonymous comments are editable by anyone",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comment"
+                ],
+                "summary": "Edit Todo Comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "f81eee2d-2cca-4169-8062-7404a78d5c3b",
+                        "description": "Todo UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "5b0e7f1c-3d4a-4c1e-9b8f-2a6d7e9c0f13",
+                        "description": "Comment UUID",
+                        "name": "comment",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "42",
+                        "description": "id of the author",
+                        "name": "X-User-ID",
+                        "in": "header"
+                    },
+                    {
+                        "description": "necessary fields for request",
+                        "name": "Request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CommentUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        " error": {
+                                            "type": "object"
+                                        },
+                                        "data": {
+                                            "$ref": "#/definitions/dto.CommentResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "process failure",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "not the author of the comment",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "422": {
+                        "description": "unprocessable",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Only the author deletes the comment, the replies of a root comment are deleted with it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comment"
+                ],
+                "summary": "Delete Todo Comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "f81eee2d-2cca-4169-8062-7404a78d5c3b",
+                        "description": "Todo UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "5b0e7f1c-3d4a-4c1e-9b8f-2a6d7e9c0f13",
+                        "description": "Comment UUID",
+                        "name": "comment",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "42",
+                        "description": "id of the author",
+                        "name": "X-User-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        " error": {
+                                            "type": "object"
+                                        },
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "process failure",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "not the author of the comment",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "422": {
+                        "description": "invalid data types",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/todo/{uuid}/history": {
+            "get": {
+                "description": "Every create, update, complete, delete, restore and revert of the item is recorded as an immutable revision",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Todo"
+                ],
+                "summary": "Get Todo Revision History",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "f81eee2d-2cca-4169-8062-7404a78d5c3b",
+                        "description": "Todo UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page Number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "` + "`" + `asc` + "`" + ` or ` + "`" + `desc` + "`" + `(default) by the revision number",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        " error": {
+                                            "type": "object"
+                                        },
+                                        "data": {
+                                            "$ref": "#/definitions/dto.HistoryResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "process failure",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "422": {
+                        "description": "invalid data types",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/todo/{uuid}/history/{revision}/revert": {
+            "post": {
+                "description": "Restores the state of the item after the revision, the revert itself is recorded as a new revision",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Todo"
+                ],
+                "summary": "Revert Todo to Revision",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "f81eee2d-2cca-4169-8062-7404a78d5c3b",
+                        "description": "Todo UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "example": 12,
+                        "description": "Revision number",
+                        "name": "revision",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "\"3\"",
+                        "description": "ETag of the item, the revert is rejected when the item was modified meanwhile",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        " error": {
+                                            "type": "object"
+                                        },
+                                        "data": {
+                                            "$ref": "#/definitions/dto.DetailResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "version of the item"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "process failure",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "412": {
+                        "description": "modified by another request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "422": {
+                        "description": "invalid data types, or the revision of a delete",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/todo/{uuid}/move": {
+            "post": {
+                "description": "Places the item into the column between the neighbours, only the moved item is written. The item moved to the ` + "`" + `done` + "`" + ` column is completed, the item moved out of it is reopened",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Todo"
+                ],
+                "summary": "Move Todo on Board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "f81eee2d-2cca-4169-8062-7404a78d5c3b",
+                        "description": "Todo UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "\"3\"",
+                        "description": "ETag of the item, the move is rejected when the item was modified meanwhile",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "necessary fields for request",
+                        "name": "Request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.MoveRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        " error": {
+                                            "type": "object"
+                                        },
+                                        "data": {
+                                            "$ref": "#/definitions/dto.DetailResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "version of the item"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "process failure",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "not an editor of the item",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "409": {
+                        "description": "the neighbours are not in the column or not in order, the board is stale",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "412": {
+                        "description": "modified by another request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "422": {
+                        "description": "unprocessable",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/todo/{uuid}/restore": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Todo"
+                ],
+                "summary": "Restore Deleted Todo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "f81eee2d-2cca-4169-8062-7404a78d5c3b",
+                        "description": "Todo UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "\"3\"",
+                        "description": "ETag of the deleted item, the restore is rejected when the item was modified meanwhile",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        " error": {
+                                            "type": "object"
+                                        },
+                                        "data": {
+                                            "$ref": "#/definitions/dto.DetailResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "version of the item"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "process failure",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "409": {
+                        "description": "the item is not deleted",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "412": {
+                        "description": "modified by another request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "422": {
+                        "description": "invalid data types",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/todo/{uuid}/share-links": {
+            "get": {
+                "description": "Lists the links of the item without their tokens, only for its owner",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Share"
+                ],
+                "summary": "Get Todo Share Links",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "f81eee2d-2cca-4169-8062-7404a78d5c3b",
+                        "description": "Todo UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "7",
+                        "description": "id of the owner",
+                        "name": "X-User-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        " error": {
+                                            "type": "object"
+                                        },
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.ShareLinkResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "process failure",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "not the owner of the item",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "todo not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "422": {
+                        "description": "invalid data types",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates a read-only link of the item, its token is returned only once",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Share"
+                ],
+                "summary": "Create Todo Share Link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "f81eee2d-2cca-4169-8062-7404a78d5c3b",
+                        "description": "Todo UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "7",
+                        "description": "id of the owner",
+                        "name": "X-User-ID",
+                        "in": "header"
+                    },
+                    {
+                        "description": "necessary fields for request",
+                        "name": "Request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ShareLinkCreateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "success response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        " error": {
+                                            "type": "object"
+                                        },
+                                        "data": {
+                                            "$ref": "#/definitions/dto.ShareLinkResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "process failure",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "not the owner of the item",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "todo not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "422": {
+                        "description": "unprocessable",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/todo/{uuid}/share-links/{link}": {
+            "delete": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Share"
+                ],
+                "summary": "Revoke Todo Share Link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "f81eee2d-2cca-4169-8062-7404a78d5c3b",
+                        "description": "Todo UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "3e5f7a9b-1c2d-4e6f-8a0b-2c4d6e8f0a1b",
+                        "description": "Share Link UUID",
+                        "name": "link",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "7",
+                        "description": "id of the owner",
+                        "name": "X-User-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        " error": {
+                                            "type": "object"
+                                        },
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "process failure",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "not the owner of the item",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "422": {
+                        "description": "invalid data types",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/todo/{uuid}/shares": {
+            "get": {
+                "description": "Lists the grants of the item, only for its owner",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Share"
+                ],
+                "summary": "Get Todo Shares",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "f81eee2d-2cca-4169-8062-7404a78d5c3b",
+                        "description": "Todo UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "7",
+                        "description": "id of the owner",
+                        "name": "X-User-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        " error": {
+                                            "type": "object"
+                                        },
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.ShareResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "process failure",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "not the owner of the item",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "todo not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "422": {
+                        "description": "invalid data types",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Grants the permission to a user or a group, the existing grant of the grantee is replaced. Only the owner of the item manages its shares",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Share"
+                ],
+                "summary": "Share Todo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "f81eee2d-2cca-4169-8062-7404a78d5c3b",
+                        "description": "Todo UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "7",
+                        "description": "id of the owner",
+                        "name": "X-User-ID",
+                        "in": "header"
+                    },
+                    {
+                        "description": "necessary fields for request",
+                        "name": "Request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ShareGrantRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        " error": {
+                                            "type": "object"
+                                        },
+                                        "data": {
+                                            "$ref": "#/definitions/dto.ShareResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "process failure",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "not the owner of the item",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "todo not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "422": {
+                        "description": "unprocessable, or the grantee is the owner",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/todo/{uuid}/shares/{share}": {
+            "delete": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Share"
+                ],
+                "summary": "Revoke Todo Share",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "f81eee2d-2cca-4169-8062-7404a78d5c3b",
+                        "description": "Todo UUID",
+                        "name": "uuid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "7a1d3c5e-9b2f-4e6a-8c0d-1f2e3d4c5b6a",
+                        "description": "Share UUID",
+                        "name": "share",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "7",
+                        "description": "id of the owner",
+                        "name": "X-User-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        " error": {
+                                            "type": "object"
+                                        },
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "process failure",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "not the owner of the item",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "not found",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "422": {
+                        "description": "invalid data types",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/handshake": {
+            "get": {
+                "description": "Checks the Service Availability",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "Service Handshake",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/routes.response"
+                        }
+                    }
+                }
+            }
+        },
+        "/healthz": {
+            "get": {
+                "description": "The process is alive, the dependencies are not checked",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "Liveness",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/routes.healthResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/ical/{file}": {
+            "get": {
+                "description": "The iCalendar(RFC 5545) of the feed, the token of the path grants the read-only access without identity.\nThe ` + "`" + `vtodo` + "`" + ` feeds list the items as tasks, the ` + "`" + `vevent` + "`" + ` feeds list the items with due date as events.",
+                "produces": [
+                    "text/calendar"
+                ],
+                "tags": [
+                    "Calendar"
+                ],
+                "summary": "Calendar Feed",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "q0Zx3Jm2b6LhT9r1Vw8yNc4uEa7sKd5fGp0oRi2jHl.ics",
+                        "description": "the token of the feed with the .ics extension",
+                        "name": "file",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "the calendar",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "process failure",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "unknown token",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "422": {
+                        "description": "invalid data types",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/meta.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/readyz": {
+            "get": {
+                "description": "The dependencies of the service are up: the database, its migrations and the storage. It fails during the graceful shutdown",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "Readiness",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/routes.healthResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/routes.healthResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "domain.TodoChange": {
+            "type": "object",
+            "properties": {
+                "from": {},
+                "to": {}
+            }
+        },
+        "domain.TodoSnapshot": {
+            "type": "object",
+            "properties": {
+                "allDay": {
+                    "type": "boolean"
+                },
+                "completedAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "dueDate": {
+                    "type": "string"
+                },
+                "dueTimeZone": {
+                    "type": "string"
+                },
+                "language": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "recurrence": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "empty for the revisions older than the board",
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.ActivityItemResponse": {
+            "type": "object",
+            "properties": {
+                "change": {
+                    "$ref": "#/definitions/dto.RevisionResponse"
+                },
+                "comment": {
+                    "$ref": "#/definitions/dto.CommentResponse"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2025-08-07T08:15:00Z"
+                },
+                "type": {
+                    "description": "` + "`" + `comment` + "`" + ` or ` + "`" + `change` + "`" + `",
+                    "type": "string",
+                    "example": "comment"
+                }
+            }
+        },
+        "dto.ActivityResponse": {
+            "type": "object",
+            "properties": {
+                "activities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ActivityItemResponse"
+                    }
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "pages": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 27
+                }
+            }
+        },
+        "dto.AssignRequest": {
+            "type": "object",
+            "required": [
+                "assignees"
+            ],
+            "properties": {
+                "assignees": {
+                    "description": "X-User-ID of the users",
+                    "type": "array",
+                    "maxItems": 20,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "42",
+                        "7"
+                    ]
+                }
+            }
+        },
+        "dto.AttachmentResponse": {
+            "type": "object",
+            "properties": {
+                "checksum": {
+                    "description": "SHA-256, hex",
+                    "type": "string",
+                    "example": "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+                },
+                "contentType": {
+                    "description": "detected from the content",
+                    "type": "string",
+                    "example": "application/pdf"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2025-08-07T08:15:00Z"
+                },
+                "fileName": {
+                    "type": "string",
+                    "example": "outline.pdf"
+                },
+                "size": {
+                    "description": "bytes",
+                    "type": "integer",
+                    "example": 52814
+                },
+                "uploader": {
+                    "description": "X-User-ID of the upload, null for the anonymous uploads",
+                    "type": "string",
+                    "example": "42"
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "0d6c2b1e-4f3a-4b8e-9c7d-1a2b3c4d5e6f"
+                }
+            }
+        },
+        "dto.BulkItemResponse": {
+            "type": "object",
+            "properties": {
+                "applied": {
+                    "description": "false for the failures and the rolled back(424) operations",
+                    "type": "boolean",
+                    "example": true
+                },
+                "index": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "message": {
+                    "description": "reason of the failure",
+                    "type": "string",
+                    "example": "record not found"
+                },
+                "op": {
+                    "type": "string",
+                    "example": "update"
+                },
+                "status": {
+                    "description": "HTTP status of the operation",
+                    "type": "integer",
+                    "example": 200
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "e48c48a3-cb72-4d64-b035-5c30fc900ef6"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 4
+                }
+            }
+        },
+        "dto.BulkOperationRequest": {
+            "type": "object",
+            "required": [
+                "op"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.CreateRequest"
+                },
+                "op": {
+                    "type": "string",
+                    "enum": [
+                        "create",
+                        "update",
+                        "complete",
+                        "delete"
+                    ],
+                    "example": "update"
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "e48c48a3-cb72-4d64-b035-5c30fc900ef6"
+                },
+                "version": {
+                    "description": "expected version(ETag) of the item, zero skips the check",
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "dto.BulkRequest": {
+            "type": "object",
+            "required": [
+                "operations"
+            ],
+            "properties": {
+                "mode": {
+                    "description": "default: atomic",
+                    "type": "string",
+                    "enum": [
+                        "atomic",
+                        "best_effort"
+                    ],
+                    "example": "atomic"
+                },
+                "operations": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/dto.BulkOperationRequest"
+                    }
+                }
+            }
+        },
+        "dto.BulkResponse": {
+            "type": "object",
+            "properties": {
+                "failed": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "mode": {
+                    "type": "string",
+                    "example": "atomic"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.BulkItemResponse"
+                    }
+                },
+                "succeeded": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "dto.CalendarFeedCreateRequest": {
+            "type": "object",
+            "properties": {
+                "component": {
+                    "description": "` + "`" + `vevent` + "`" + ` for the calendars without task support, default: vtodo",
+                    "type": "string",
+                    "enum": [
+                        "vtodo",
+                        "vevent"
+                    ],
+                    "example": "vtodo"
+                }
+            }
+        },
+        "dto.CalendarFeedResponse": {
+            "type": "object",
+            "properties": {
+                "component": {
+                    "type": "string",
+                    "example": "vtodo"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2025-08-07T08:15:00Z"
+                },
+                "path": {
+                    "description": "the subscribed path, with the token",
+                    "type": "string",
+                    "example": "/ical/q0Zx3Jm2b6LhT9r1Vw8yNc4uEa7sKd5fGp0oRi2jHl.ics"
+                },
+                "token": {
+                    "description": "returned only once, by the create",
+                    "type": "string",
+                    "example": "q0Zx3Jm2b6LhT9r1Vw8yNc4uEa7sKd5fGp0oRi2jHl"
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "5c7e9a1b-3d5f-4a7b-9c1d-3e5f7a9b1c2d"
+                }
+            }
+        },
+        "dto.CommentCreateRequest": {
+            "type": "object",
+            "required": [
+                "body"
+            ],
+            "properties": {
+                "body": {
+                    "description": "Markdown, the @mentions are parsed",
+                    "type": "string",
+                    "maxLength": 10000,
+                    "example": "@alice could you review the **outline**?"
+                },
+                "parentUuid": {
+                    "description": "the replied comment",
+                    "type": "string",
+                    "example": "5b0e7f1c-3d4a-4c1e-9b8f-2a6d7e9c0f13"
+                }
+            }
+        },
+        "dto.CommentListResponse": {
+            "type": "object",
+            "properties": {
+                "comments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CommentResponse"
+                    }
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "pages": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 27
+                }
+            }
+        },
+        "dto.CommentResponse": {
+            "type": "object",
+            "properties": {
+                "author": {
+                    "description": "X-User-ID of the request, null for the anonymous comments",
+                    "type": "string",
+                    "example": "42"
+                },
+                "body": {
+                    "type": "string",
+                    "example": "@alice could you review the **outline**?"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2025-08-07T08:15:00Z"
+                },
+                "edited": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "mentions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "alice"
+                    ]
+                },
+                "parentUuid": {
+                    "description": "null for the root comments",
+                    "type": "string",
+                    "example": "2c4f9a7e-8b1d-4e3a-a6f5-0d9c8b7a6e51"
+                },
+                "replies": {
+                    "description": "reply count of the listed root comments",
+                    "type": "integer",
+                    "example": 2
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2025-08-07T08:15:00Z"
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "5b0e7f1c-3d4a-4c1e-9b8f-2a6d7e9c0f13"
+                }
+            }
+        },
+        "dto.CommentUpdateRequest": {
+            "type": "object",
+            "required": [
+                "body"
+            ],
+            "properties": {
+                "body": {
+                    "description": "Markdown, the @mentions are parsed",
+                    "type": "string",
+                    "maxLength": 10000,
+                    "example": "@alice @bob could you review the **outline**?"
+                }
+            }
+        },
+        "dto.CreateRequest": {
+            "type": "object",
+            "required": [
+                "description"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Create new todo item"
+                },
+                "dueDate": {
+                    "description": "RFC3339, date-only(all-day), \"2025-08-07 10:11 Europe/Berlin\" or relative like \"next friday\"",
+                    "type": "string",
+                    "example": "tomorrow 9am"
+                },
+                "language": {
+                    "type": "string",
+                    "example": "english"
+                },
+                "notes": {
+                    "description": "Markdown",
+                    "type": "string",
+                    "maxLength": 20000,
+                    "example": "## Steps\n- [ ] draft the **outline**"
+                },
+                "recurrence": {
+                    "description": "RFC 5545 RRULE of the due date",
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "FREQ=WEEKLY;BYDAY=MO"
+                },
+                "timeZone": {
+                    "description": "IANA time zone of the zone-less due dates, default: UTC",
+                    "type": "string",
+                    "example": "Europe/Berlin"
+                }
+            }
+        },
+        "dto.CreateResponse": {
+            "type": "object",
+            "properties": {
+                "allDay": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Create new todo item"
+                },
+                "dueDate": {
+                    "description": "RFC3339, date-only for the all-day items",
+                    "type": "string",
+                    "example": "2025-08-07T09:00:00+02:00"
+                },
+                "notes": {
+                    "type": "string",
+                    "example": "## Steps\n- [ ] draft the **outline**"
+                },
+                "recurrence": {
+                    "type": "string",
+                    "example": "FREQ=WEEKLY;BYDAY=MO"
+                },
+                "timeZone": {
+                    "type": "string",
+                    "example": "Europe/Berlin"
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "e48c48a3-cb72-4d64-b035-5c30fc900ef6"
+                }
+            }
+        },
+        "dto.DetailResponse": {
+            "type": "object",
+            "properties": {
+                "allDay": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "assignees": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "42",
+                        "7"
+                    ]
+                },
+                "boardRank": {
+                    "description": "position in the column, the items are ordered by its byte order",
+                    "type": "string",
+                    "example": "a0V"
+                },
+                "completedAt": {
+                    "description": "RFC3339, null for the open items",
+                    "type": "string",
+                    "example": "2025-08-07T08:15:00Z"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Create new todo item"
+                },
+                "dueDate": {
+                    "description": "RFC3339, date-only for the all-day items",
+                    "type": "string",
+                    "example": "2025-08-07T09:00:00+02:00"
+                },
+                "notes": {
+                    "type": "string",
+                    "example": "## Steps\n- [ ] draft the **outline**"
+                },
+                "notesHtml": {
+                    "type": "string",
+                    "example": "\u003ch2\u003eSteps\u003c/h2\u003e\n\u003cul\u003e\n\u003cli\u003e\u003cinput disabled=\"\" type=\"checkbox\"\u003e draft the \u003cstrong\u003eoutline\u003c/strong\u003e\u003c/li\u003e\n\u003c/ul\u003e\n"
+                },
+                "owner": {
+                    "description": "X-User-ID of the creator, null for the items accessible by everyone",
+                    "type": "string",
+                    "example": "42"
+                },
+                "recurrence": {
+                    "description": "RFC 5545 RRULE of the due date",
+                    "type": "string",
+                    "example": "FREQ=WEEKLY;BYDAY=MO"
+                },
+                "status": {
+                    "description": "column of the board: todo, in_progress or done",
+                    "type": "string",
+                    "example": "in_progress"
+                },
+                "timeZone": {
+                    "type": "string",
+                    "example": "Europe/Berlin"
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "e48c48a3-cb72-4d64-b035-5c30fc900ef6"
+                }
+            }
+        },
+        "dto.ExportItem": {
+            "type": "object",
+            "properties": {
+                "allDay": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "completedAt": {
+                    "type": "string",
+                    "example": "2025-08-07T08:15:00Z"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2025-08-01T10:11:12Z"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Create new todo item"
+                },
+                "dueDate": {
+                    "description": "RFC3339, date-only for the all-day items",
+                    "type": "string",
+                    "example": "2025-08-07T09:00:00+02:00"
+                },
+                "language": {
+                    "type": "string",
+                    "example": "english"
+                },
+                "notes": {
+                    "type": "string",
+                    "example": "## Steps\n- [ ] draft the **outline**"
+                },
+                "owner": {
+                    "type": "string",
+                    "example": "42"
+                },
+                "recurrence": {
+                    "type": "string",
+                    "example": "FREQ=WEEKLY;BYDAY=MO"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "in_progress"
+                },
+                "timeZone": {
+                    "type": "string",
+                    "example": "Europe/Berlin"
+                },
+                "uuid": {
+                    "type": "string",
+                    "example": "e48c48a3-cb72-4d64-b035-5c30fc900ef6"
+                }
+            }
+        },
+        "dto.HistoryResponse": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "pages": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "revisions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.RevisionResponse"
                     }
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 27
                 }
             }
-        }
-    },
-    "definitions": {
-        "dto.CreateRequest": {
+        },
+        "dto.ImportLineError": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string",
+                    "example": "dueDate"
+                },
+                "line": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "message": {
+                    "type": "string",
+                    "example": "the value does not satisfy the dueDate rule"
+                }
+            }
+        },
+        "dto.ImportResponse": {
+            "type": "object",
+            "properties": {
+                "dryRun": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "errors": {
+                    "description": "the first 100 invalid rows",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ImportLineError"
+                    }
+                },
+                "imported": {
+                    "type": "integer",
+                    "example": 27
+                },
+                "invalid": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "total": {
+                    "description": "rows of the file",
+                    "type": "integer",
+                    "example": 27
+                }
+            }
+        },
+        "dto.ImportRow": {
             "type": "object",
             "required": [
-                "description",
-                "dueDate"
+                "description"
             ],
             "properties": {
+                "completedAt": {
+                    "description": "date-only or RFC3339",
+                    "type": "string",
+                    "example": "2025-08-07T08:15:00Z"
+                },
                 "description": {
                     "type": "string",
+                    "maxLength": 255,
                     "example": "Create new todo item"
                 },
                 "dueDate": {
+                    "description": "RFC3339, date-only(all-day), \"2025-08-07 10:11 Europe/Berlin\" or relative like \"next friday\"",
+                    "type": "string",
+                    "example": "tomorrow 9am"
+                },
+                "language": {
+                    "type": "string",
+                    "example": "english"
+                },
+                "notes": {
+                    "description": "Markdown",
+                    "type": "string",
+                    "maxLength": 20000,
+                    "example": "## Steps\n- [ ] draft the **outline**"
+                },
+                "recurrence": {
+                    "description": "RFC 5545 RRULE of the due date",
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "FREQ=WEEKLY;BYDAY=MO"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "todo",
+                        "in_progress",
+                        "done"
+                    ],
+                    "example": "in_progress"
+                },
+                "timeZone": {
+                    "description": "IANA time zone of the zone-less due dates, default: UTC",
                     "type": "string",
-                    "example": "2025-08-07 10:11:12"
+                    "example": "Europe/Berlin"
                 }
             }
         },
-        "dto.CreateResponse": {
+        "dto.MoveRequest": {
             "type": "object",
             "properties": {
-                "description": {
+                "after": {
+                    "description": "the item right above, in the target column",
                     "type": "string",
-                    "example": "Create new todo item"
+                    "example": "bf56c6b6-dd02-47ba-8dc4-bd7d2843a77a"
                 },
-                "dueDate": {
+                "before": {
+                    "description": "the item right below, in the target column",
+                    "type": "string",
+                    "example": "02bda2f0-61e5-483c-a2d8-15eafb00b945"
+                },
+                "status": {
+                    "description": "target column, default: the column of the item",
+                    "type": "string",
+                    "enum": [
+                        "todo",
+                        "in_progress",
+                        "done"
+                    ],
+                    "example": "in_progress"
+                }
+            }
+        },
+        "dto.RevisionResponse": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "create, update, complete, delete, restore, revert, move or assign",
+                    "type": "string",
+                    "example": "update"
+                },
+                "actor": {
+                    "description": "X-User-ID of the request, null for the anonymous requests",
+                    "type": "string",
+                    "example": "42"
+                },
+                "after": {
+                    "description": "null for the deletes",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.TodoSnapshot"
+                        }
+                    ]
+                },
+                "before": {
+                    "description": "null for the creates",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.TodoSnapshot"
+                        }
+                    ]
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2025-08-07T08:15:00Z"
+                },
+                "diff": {
+                    "description": "changed fields of the snapshots",
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/domain.TodoChange"
+                    }
+                },
+                "requestId": {
+                    "type": "string",
+                    "example": "6f1c2a5e-0d7b-4d5e-9a43-3b1f0e2c7a10"
+                },
+                "revision": {
+                    "type": "integer",
+                    "example": 12
+                }
+            }
+        },
+        "dto.ShareGrantRequest": {
+            "type": "object",
+            "required": [
+                "grantee",
+                "permission",
+                "type"
+            ],
+            "properties": {
+                "grantee": {
+                    "description": "id of the user or the group",
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "42"
+                },
+                "permission": {
+                    "type": "string",
+                    "enum": [
+                        "read",
+                        "comment",
+                        "edit"
+                    ],
+                    "example": "comment"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "user",
+                        "group"
+                    ],
+                    "example": "user"
+                }
+            }
+        },
+        "dto.ShareLinkCreateRequest": {
+            "type": "object",
+            "properties": {
+                "expiresIn": {
+                    "description": "seconds, the link never expires when it is omitted",
+                    "type": "integer",
+                    "maximum": 31536000,
+                    "minimum": 60,
+                    "example": 604800
+                }
+            }
+        },
+        "dto.ShareLinkResponse": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string",
+                    "example": "2025-08-07T08:15:00Z"
+                },
+                "createdBy": {
+                    "type": "string",
+                    "example": "7"
+                },
+                "expiresAt": {
+                    "description": "null for the links without expiry",
+                    "type": "string",
+                    "example": "2025-08-14T08:15:00Z"
+                },
+                "token": {
+                    "description": "returned only once, by the create",
                     "type": "string",
-                    "example": "2025-08-07 10:11:12"
+                    "example": "q0Zx3Jm2b6LhT9r1Vw8yNc4uEa7sKd5fGp0oRi2jHl"
                 },
                 "uuid": {
                     "type": "string",
-                    "example": "e48c48a3-cb72-4d64-b035-5c30fc900ef6"
+                    "example": "3e5f7a9b-1c2d-4e6f-8a0b-2c4d6e8f0a1b"
                 }
             }
         },
-        "dto.DetailResponse": {
+        "dto.ShareResponse": {
             "type": "object",
             "properties": {
-                "description": {
+                "createdAt": {
                     "type": "string",
-                    "example": "Create new todo item"
+                    "example": "2025-08-07T08:15:00Z"
                 },
-                "dueDate": {
+                "grantedBy": {
                     "type": "string",
-                    "example": "2025-08-07 10:11:12"
+                    "example": "7"
+                },
+                "grantee": {
+                    "type": "string",
+                    "example": "42"
+                },
+                "permission": {
+                    "type": "string",
+                    "example": "comment"
+                },
+                "type": {
+                    "description": "` + "`" + `user` + "`" + ` or ` + "`" + `group` + "`" + `",
+                    "type": "string",
+                    "example": "user"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2025-08-07T08:15:00Z"
                 },
                 "uuid": {
                     "type": "string",
-                    "example": "e48c48a3-cb72-4d64-b035-5c30fc900ef6"
+                    "example": "7a1d3c5e-9b2f-4e6a-8c0d-1f2e3d4c5b6a"
                 }
             }
         },
         "dto.TodoListItemDetail": {
             "type": "object",
-            "required": [
-                "dueDate"
-            ],
             "properties": {
+                "allDay": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "assignees": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "42",
+                        "7"
+                    ]
+                },
+                "boardRank": {
+                    "type": "string",
+                    "example": "a0V"
+                },
+                "completed": {
+                    "type": "boolean",
+                    "example": false
+                },
                 "dueDate": {
+                    "description": "RFC3339, date-only for the all-day items",
+                    "type": "string",
+                    "example": "2025-08-07T09:00:00+02:00"
+                },
+                "highlight": {
                     "type": "string",
-                    "example": "2025-08-07 10:11:12"
+                    "example": "Create new \u003cmark\u003etodo\u003c/mark\u003e item"
                 },
                 "id": {
                     "type": "string",
@@ -434,12 +5761,30 @@ const docTemplate = `{
                 "name": {
                     "type": "string",
                     "example": "Create new todo..."
+                },
+                "owner": {
+                    "type": "string",
+                    "example": "42"
+                },
+                "rank": {
+                    "description": "search results",
+                    "type": "number",
+                    "example": 0.0607927
+                },
+                "status": {
+                    "type": "string",
+                    "example": "in_progress"
                 }
             }
         },
         "dto.TodoListResponse": {
             "type": "object",
             "properties": {
+                "fuzzy": {
+                    "description": "the items are similar to the searched phrase, not exact matches",
+                    "type": "boolean",
+                    "example": false
+                },
                 "limit": {
                     "type": "integer",
                     "example": 10
@@ -464,6 +5809,63 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.UpdateRequest": {
+            "type": "object",
+            "required": [
+                "description"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Create new todo item"
+                },
+                "dueDate": {
+                    "description": "RFC3339, date-only(all-day), \"2025-08-07 10:11 Europe/Berlin\" or relative like \"next friday\"",
+                    "type": "string",
+                    "example": "tomorrow 9am"
+                },
+                "language": {
+                    "type": "string",
+                    "example": "english"
+                },
+                "notes": {
+                    "description": "Markdown",
+                    "type": "string",
+                    "maxLength": 20000,
+                    "example": "## Steps\n- [ ] draft the **outline**"
+                },
+                "recurrence": {
+                    "description": "RFC 5545 RRULE of the due date",
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "FREQ=WEEKLY;BYDAY=MO"
+                },
+                "timeZone": {
+                    "description": "IANA time zone of the zone-less due dates, default: UTC",
+                    "type": "string",
+                    "example": "Europe/Berlin"
+                }
+            }
+        },
+        "dto.WorkloadResponse": {
+            "type": "object",
+            "properties": {
+                "assignee": {
+                    "description": "null for the unassigned items",
+                    "type": "string",
+                    "example": "42"
+                },
+                "open": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "overdue": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
         "meta.Response": {
             "type": "object",
             "properties": {
@@ -479,6 +5881,66 @@ const docTemplate = `{
                 }
             }
         },
+        "routes.healthCheckResponse": {
+            "type": "object",
+            "properties": {
+                "latencyMs": {
+                    "type": "number",
+                    "example": 1.25
+                },
+                "name": {
+                    "type": "string",
+                    "example": "database"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "up"
+                }
+            }
+        },
+        "routes.healthResponse": {
+            "type": "object",
+            "properties": {
+                "checks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/routes.healthCheckResponse"
+                    }
+                },
+                "status": {
+                    "description": "up, down or draining",
+                    "type": "string",
+                    "example": "up"
+                }
+            }
+        },
+        "routes.logLevelRequest": {
+            "type": "object",
+            "required": [
+                "level"
+            ],
+            "properties": {
+                "level": {
+                    "type": "string",
+                    "enum": [
+                        "debug",
+                        "info",
+                        "warn",
+                        "error"
+                    ],
+                    "example": "debug"
+                }
+            }
+        },
+        "routes.logLevelResponse": {
+            "type": "object",
+            "properties": {
+                "level": {
+                    "type": "string",
+                    "example": "info"
+                }
+            }
+        },
         "routes.response": {
             "type": "object",
             "properties": {
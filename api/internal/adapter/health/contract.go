@@ -0,0 +1,35 @@
+package health
+
+import (
+	"context"
+	"time"
+)
+
+// Check the check of a dependency, the dependency is down when it returns an error
+type Check func(ctx context.Context) error
+
+// Result the status of a check with its latency
+type Result struct {
+	Name    string
+	Status  string
+	Latency time.Duration
+	Err     error
+}
+
+// Report the status of the service with the results of its checks
+type Report struct {
+	Status string
+	Checks []Result
+}
+
+//go:generate mockgen -source=./contract.go -destination=./mocks/health_mock.go -package=health_mock
+type IHealth interface {
+	// Register adds the readiness check of the dependency, the checks are run in their registration order
+	Register(name string, check Check)
+	// Live the process is alive, the dependencies are not checked
+	Live() *Report
+	// Ready runs the checks concurrently, the service is ready when all of them are up and it is not draining
+	Ready(ctx context.Context) *Report
+	// Drain fails the readiness for the graceful shutdown, it is not reverted
+	Drain()
+}
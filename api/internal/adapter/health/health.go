@@ -0,0 +1,111 @@
+package health
+
+import (
+	"context"
+	"microservice/config"
+	"microservice/internal/adapter/registry"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// defaultCheckTimeout the timeout of a check, when the config is not set
+const defaultCheckTimeout = 2 * time.Second
+
+type check struct {
+	name string
+	fn   Check
+}
+
+type health struct {
+	config   config.Health
+	mu       sync.RWMutex
+	checks   []check
+	draining atomic.Bool
+}
+
+func New(registry registry.IRegistry) IHealth {
+	client := new(health)
+	registry.Parse(&client.config)
+
+	return client
+}
+
+func (h *health) Register(name string, fn Check) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.checks = append(h.checks, check{name: name, fn: fn})
+}
+
+func (h *health) Live() *Report {
+	return &Report{Status: StatusUp, Checks: make([]Result, 0)}
+}
+
+func (h *health) Ready(ctx context.Context) *Report {
+	h.mu.RLock()
+	checks := h.checks
+	h.mu.RUnlock()
+
+	report := &Report{Status: StatusUp, Checks: make([]Result, len(checks))}
+
+	wg := sync.WaitGroup{}
+	for i, c := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			report.Checks[i] = h.run(ctx, c)
+		}()
+	}
+
+	wg.Wait()
+
+	for _, result := range report.Checks {
+		if result.Status == StatusDown {
+			report.Status = StatusDown
+		}
+	}
+
+	// NOTE: the dependencies are still reported while draining, the status tells the load balancers to stop routing
+	if h.draining.Load() {
+		report.Status = StatusDraining
+	}
+
+	return report
+}
+
+func (h *health) Drain() {
+	h.draining.Store(true)
+}
+
+// HELPERS
+
+func (h *health) run(ctx context.Context, c check) (result Result) {
+	timeout := h.config.CheckTimeout
+	if timeout <= 0 {
+		timeout = defaultCheckTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	result = Result{Name: c.name, Status: StatusUp}
+
+	// NOTE: a check ignoring the context is abandoned at the timeout, its result is discarded
+	done := make(chan error, 1)
+	go func() { done <- c.fn(ctx) }()
+
+	select {
+	case result.Err = <-done:
+	case <-ctx.Done():
+		result.Err = ctx.Err()
+	}
+
+	result.Latency = time.Since(start)
+	if result.Err != nil {
+		result.Status = StatusDown
+	}
+
+	return
+}
@@ -0,0 +1,63 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"microservice/config"
+	"testing"
+	"time"
+)
+
+func TestHealth(t *testing.T) {
+	up := func(context.Context) error { return nil }
+	down := func(context.Context) error { return errors.New("connection refused") }
+
+	t.Run("ready when all of the checks are up", func(t *testing.T) {
+		h := &health{}
+		h.Register("database", up)
+		h.Register("storage", up)
+
+		report := h.Ready(context.Background())
+		assert.Equal(t, StatusUp, report.Status)
+		assert.Len(t, report.Checks, 2)
+		assert.Equal(t, "database", report.Checks[0].Name)
+		assert.Equal(t, "storage", report.Checks[1].Name)
+	})
+
+	t.Run("not ready when a check is down", func(t *testing.T) {
+		h := &health{}
+		h.Register("database", down)
+		h.Register("storage", up)
+
+		report := h.Ready(context.Background())
+		assert.Equal(t, StatusDown, report.Status)
+		assert.Equal(t, StatusDown, report.Checks[0].Status)
+		assert.EqualError(t, report.Checks[0].Err, "connection refused")
+		assert.Equal(t, StatusUp, report.Checks[1].Status)
+	})
+
+	t.Run("the slow check is down at the timeout", func(t *testing.T) {
+		h := &health{config: config.Health{CheckTimeout: 20 * time.Millisecond}}
+		h.Register("database", func(context.Context) error {
+			time.Sleep(time.Second) // ignores the context
+			return nil
+		})
+
+		report := h.Ready(context.Background())
+		assert.Equal(t, StatusDown, report.Status)
+		assert.ErrorIs(t, report.Checks[0].Err, context.DeadlineExceeded)
+		assert.Less(t, report.Checks[0].Latency, time.Second)
+	})
+
+	t.Run("not ready while draining, still alive", func(t *testing.T) {
+		h := &health{}
+		h.Register("database", up)
+		h.Drain()
+
+		report := h.Ready(context.Background())
+		assert.Equal(t, StatusDraining, report.Status)
+		assert.Equal(t, StatusUp, report.Checks[0].Status)
+		assert.Equal(t, StatusUp, h.Live().Status)
+	})
+}
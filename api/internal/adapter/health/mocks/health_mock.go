@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./contract.go -destination=./mocks/health_mock.go -package=health_mock
+//
+
+// Package health_mock is a generated GoMock package.
+package health_mock
+
+import (
+	context "context"
+	health "microservice/internal/adapter/health"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIHealth is a mock of IHealth interface.
+type MockIHealth struct {
+	ctrl     *gomock.Controller
+	recorder *MockIHealthMockRecorder
+	isgomock struct{}
+}
+
+// MockIHealthMockRecorder is the mock recorder for MockIHealth.
+type MockIHealthMockRecorder struct {
+	mock *MockIHealth
+}
+
+// NewMockIHealth creates a new mock instance.
+func NewMockIHealth(ctrl *gomock.Controller) *MockIHealth {
+	mock := &MockIHealth{ctrl: ctrl}
+	mock.recorder = &MockIHealthMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIHealth) EXPECT() *MockIHealthMockRecorder {
+	return m.recorder
+}
+
+// Drain mocks base method.
+func (m *MockIHealth) Drain() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Drain")
+}
+
+// Drain indicates an expected call of Drain.
+func (mr *MockIHealthMockRecorder) Drain() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Drain", reflect.TypeOf((*MockIHealth)(nil).Drain))
+}
+
+// Live mocks base method.
+func (m *MockIHealth) Live() *health.Report {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Live")
+	ret0, _ := ret[0].(*health.Report)
+	return ret0
+}
+
+// Live indicates an expected call of Live.
+func (mr *MockIHealthMockRecorder) Live() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Live", reflect.TypeOf((*MockIHealth)(nil).Live))
+}
+
+// Ready mocks base method.
+func (m *MockIHealth) Ready(ctx context.Context) *health.Report {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ready", ctx)
+	ret0, _ := ret[0].(*health.Report)
+	return ret0
+}
+
+// Ready indicates an expected call of Ready.
+func (mr *MockIHealthMockRecorder) Ready(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ready", reflect.TypeOf((*MockIHealth)(nil).Ready), ctx)
+}
+
+// Register mocks base method.
+func (m *MockIHealth) Register(name string, check health.Check) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Register", name, check)
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockIHealthMockRecorder) Register(name, check any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockIHealth)(nil).Register), name, check)
+}
@@ -5,4 +5,9 @@ type ILocale interface {
 	Init()
 	Get(key string) string
 	Plural(key string, params map[string]string) string
+	// Match the supported language of the preferences, in the Accept-Language format, the first matched preference wins.
+	// the default language is returned when none of them is supported
+	Match(preferences ...string) string
+	// Lang the messages in the language, the missing messages and the unsupported languages fall back to the default language
+	Lang(lang string) ILocale
 }
@@ -5,22 +5,29 @@ import (
 	"fmt"
 	"github.com/nicksnyder/go-i18n/v2/i18n"
 	"golang.org/x/text/language"
+	"log"
 	src "microservice"
 	"microservice/config"
 	"microservice/internal/adapter/registry"
+	"path/filepath"
 )
 
 type locale struct {
 	service config.Service
 	config  config.Locale
 	bundle  *i18n.Bundle
+	// matcher the supported languages, the default language first
+	matcher   language.Matcher
+	supported []language.Tag
+	// lang the language of the messages, the default language when it is empty
+	lang string
 }
 
 func New(registry registry.IRegistry) ILocale {
 	lang := new(locale)
 	registry.Parse(&lang.service)
 	registry.Parse(&lang.config)
-	lang.bundle = i18n.NewBundle(language.English)
+	lang.bundle = i18n.NewBundle(language.Make(lang.config.Lang))
 
 	return lang
 }
@@ -29,17 +36,39 @@ func (l *locale) Init() {
 	var path string
 
 	if l.service.Debug == false {
-		path = "%s/locale/%s"
+		path = "%s/locale"
 	} else {
-		path = "%s/internal/adapter/locale/translation/%s"
+		path = "%s/internal/adapter/locale/translation"
 	}
 
+	l.load(fmt.Sprintf(path, src.Root()))
+}
+
+// load loads all the translation files of the directory, the language of a file is its name like es-ES.json
+func (l *locale) load(dir string) {
 	l.bundle.RegisterUnmarshalFunc("json", json.Unmarshal)
-	l.bundle.MustLoadMessageFile(fmt.Sprintf(path, src.Root(), "en-US.json"))
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil || len(files) == 0 {
+		log.Fatalf("[locale] no translation file in %s\n", dir)
+	}
+
+	for _, file := range files {
+		l.bundle.MustLoadMessageFile(file)
+	}
+
+	l.supported = []language.Tag{language.Make(l.config.Lang)}
+	for _, tag := range l.bundle.LanguageTags() {
+		if tag != l.supported[0] {
+			l.supported = append(l.supported, tag)
+		}
+	}
+
+	l.matcher = language.NewMatcher(l.supported)
 }
 
 func (l *locale) Get(key string) string {
-	localizer := i18n.NewLocalizer(l.bundle, l.config.Lang)
+	localizer := i18n.NewLocalizer(l.bundle, l.lang, l.config.Lang)
 
 	localizedMessage, _ := localizer.Localize(&i18n.LocalizeConfig{
 		DefaultMessage: &i18n.Message{
@@ -51,7 +80,7 @@ func (l *locale) Get(key string) string {
 }
 
 func (l *locale) Plural(key string, params map[string]string) string {
-	localizer := i18n.NewLocalizer(l.bundle, l.config.Lang)
+	localizer := i18n.NewLocalizer(l.bundle, l.lang, l.config.Lang)
 	data := make(map[string]string)
 
 	for localizerKey, localizerValue := range params {
@@ -67,3 +96,25 @@ func (l *locale) Plural(key string, params map[string]string) string {
 
 	return formattedLocalizer
 }
+
+func (l *locale) Match(preferences ...string) string {
+	for _, preference := range preferences {
+		tags, _, err := language.ParseAcceptLanguage(preference)
+		if err != nil || len(tags) == 0 {
+			continue
+		}
+
+		if _, index, confidence := l.matcher.Match(tags...); confidence != language.No {
+			return l.supported[index].String()
+		}
+	}
+
+	return l.config.Lang
+}
+
+func (l *locale) Lang(lang string) ILocale {
+	localized := *l
+	localized.lang = lang
+
+	return &localized
+}
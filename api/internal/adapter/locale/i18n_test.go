@@ -0,0 +1,106 @@
+package locale
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+	"microservice/config"
+)
+
+const (
+	translationDir = "translation"
+	// releaseDir the translations of the release builds, next to the binary
+	releaseDir = "../../../locale"
+	sourceLang = "en-US"
+)
+
+func TestLocale(t *testing.T) {
+	newLocale := func() ILocale {
+		l := &locale{config: config.Locale{Lang: sourceLang}, bundle: i18n.NewBundle(language.Make(sourceLang))}
+		l.load(translationDir)
+		return l
+	}
+
+	t.Run("the translations have the keys of english", func(t *testing.T) {
+		for _, dir := range []string{translationDir, releaseDir} {
+			source := readTranslation(t, filepath.Join(dir, sourceLang+".json"))
+
+			files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+			require.NoError(t, err)
+
+			for _, file := range files {
+				translation := readTranslation(t, file)
+
+				for key, message := range source {
+					translated, ok := translation[key]
+					if !ok {
+						t.Errorf("%s: the %q key is missing", file, key)
+						continue
+					}
+
+					// NOTE: the plural forms differ by the language, the other form is required by all of them
+					if _, isPlural := message.(map[string]interface{}); isPlural {
+						forms, _ := translated.(map[string]interface{})
+						if _, ok = forms["other"]; !ok {
+							t.Errorf("%s: the other form of the %q key is missing", file, key)
+						}
+					}
+				}
+			}
+		}
+	})
+
+	t.Run("the release translations are the source translations", func(t *testing.T) {
+		files, err := filepath.Glob(filepath.Join(translationDir, "*.json"))
+		require.NoError(t, err)
+
+		for _, file := range files {
+			source, _ := os.ReadFile(file)
+			release, readErr := os.ReadFile(filepath.Join(releaseDir, filepath.Base(file)))
+
+			assert.NoError(t, readErr)
+			assert.Equal(t, string(source), string(release), filepath.Base(file))
+		}
+	})
+
+	t.Run("the preference of the user wins", func(t *testing.T) {
+		l := newLocale()
+
+		assert.Equal(t, "es-ES", l.Match("", "de-DE,es-MX;q=0.8,en;q=0.5"))
+		assert.Equal(t, "en-US", l.Match("en-GB", "es"))
+		assert.Equal(t, "es-ES", l.Match("invalid;;", "es"))
+	})
+
+	t.Run("the unsupported languages fall back to the default", func(t *testing.T) {
+		l := newLocale()
+
+		assert.Equal(t, "en-US", l.Match("de-DE", "ja"))
+		assert.Equal(t, "en-US", l.Match())
+		assert.Equal(t, "record not found", l.Lang("de-DE").Get("not_found"))
+	})
+
+	t.Run("the messages in the language", func(t *testing.T) {
+		l := newLocale()
+
+		assert.Equal(t, "registro no encontrado", l.Lang("es-ES").Get("not_found"))
+		assert.Equal(t, "el error se registró con la referencia r-1",
+			l.Lang("es-ES").Plural("internal_error_reference", map[string]string{"reference": "r-1"}))
+		assert.Equal(t, "record not found", l.Get("not_found"))
+	})
+}
+
+func readTranslation(t *testing.T, file string) map[string]interface{} {
+	content, err := os.ReadFile(file)
+	require.NoError(t, err)
+
+	translation := make(map[string]interface{})
+	require.NoError(t, json.Unmarshal(content, &translation), file)
+
+	return translation
+}
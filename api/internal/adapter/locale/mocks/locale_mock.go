@@ -10,6 +10,7 @@
 package locale_mock
 
 import (
+	locale "microservice/internal/adapter/locale"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -65,6 +66,38 @@ func (mr *MockILocaleMockRecorder) Init() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Init", reflect.TypeOf((*MockILocale)(nil).Init))
 }
 
+// Lang mocks base method.
+func (m *MockILocale) Lang(lang string) locale.ILocale {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lang", lang)
+	ret0, _ := ret[0].(locale.ILocale)
+	return ret0
+}
+
+// Lang indicates an expected call of Lang.
+func (mr *MockILocaleMockRecorder) Lang(lang any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lang", reflect.TypeOf((*MockILocale)(nil).Lang), lang)
+}
+
+// Match mocks base method.
+func (m *MockILocale) Match(preferences ...string) string {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	for _, a := range preferences {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Match", varargs...)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Match indicates an expected call of Match.
+func (mr *MockILocaleMockRecorder) Match(preferences ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Match", reflect.TypeOf((*MockILocale)(nil).Match), preferences...)
+}
+
 // Plural mocks base method.
 func (m *MockILocale) Plural(key string, params map[string]string) string {
 	m.ctrl.T.Helper()
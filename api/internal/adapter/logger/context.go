@@ -0,0 +1,39 @@
+package logger
+
+import (
+	"context"
+	"microservice/pkg/reqctx"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Context the request_id, the trace_id and the span_id fields of the context, so the logs of a request are correlated
+// with each other and with the traces. the missing values are skipped, it is skipped when none of them is set
+func Context(ctx context.Context) zap.Field {
+	f := contextFields{requestID: reqctx.RequestID(ctx), span: trace.SpanContextFromContext(ctx)}
+	if len(f.requestID) == 0 && f.span.IsValid() == false {
+		return zap.Skip()
+	}
+
+	return zap.Inline(f)
+}
+
+type contextFields struct {
+	requestID string
+	span      trace.SpanContext
+}
+
+func (f contextFields) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if len(f.requestID) > 0 {
+		enc.AddString("request_id", f.requestID)
+	}
+
+	if f.span.IsValid() {
+		enc.AddString("trace_id", f.span.TraceID().String())
+		enc.AddString("span_id", f.span.SpanID().String())
+	}
+
+	return nil
+}
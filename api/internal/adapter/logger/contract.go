@@ -1,6 +1,8 @@
 package logger
 
 import (
+	"context"
+
 	"go.uber.org/zap"
 )
 
@@ -8,8 +10,18 @@ import (
 type ILogger interface {
 	Init()
 	Stop()
+	// Reopen reopens the log files, after they are moved by the external tools like logrotate
+	Reopen()
 	// C logger client
 	C() *zap.Logger
+	// With the child logger with the fields, it shares the level of the logger
+	With(fields ...zap.Field) ILogger
+	// WithContext the child logger with the request and the trace ids of the context, see Context
+	WithContext(ctx context.Context) ILogger
+	// Level the minimum enabled level: debug, info, warn or error
+	Level() string
+	// SetLevel changes the level of the logger and its children at runtime
+	SetLevel(level string) error
 	Debug(scope string, fields ...zap.Field)
 	Info(scope string, fields ...zap.Field)
 	Warn(scope string, fields ...zap.Field)
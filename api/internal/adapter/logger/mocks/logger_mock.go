@@ -10,6 +10,8 @@
 package logger_mock
 
 import (
+	context "context"
+	logger "microservice/internal/adapter/logger"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -117,6 +119,46 @@ func (mr *MockILoggerMockRecorder) Init() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Init", reflect.TypeOf((*MockILogger)(nil).Init))
 }
 
+// Level mocks base method.
+func (m *MockILogger) Level() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Level")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Level indicates an expected call of Level.
+func (mr *MockILoggerMockRecorder) Level() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Level", reflect.TypeOf((*MockILogger)(nil).Level))
+}
+
+// Reopen mocks base method.
+func (m *MockILogger) Reopen() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Reopen")
+}
+
+// Reopen indicates an expected call of Reopen.
+func (mr *MockILoggerMockRecorder) Reopen() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reopen", reflect.TypeOf((*MockILogger)(nil).Reopen))
+}
+
+// SetLevel mocks base method.
+func (m *MockILogger) SetLevel(level string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLevel", level)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLevel indicates an expected call of SetLevel.
+func (mr *MockILoggerMockRecorder) SetLevel(level any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLevel", reflect.TypeOf((*MockILogger)(nil).SetLevel), level)
+}
+
 // Stop mocks base method.
 func (m *MockILogger) Stop() {
 	m.ctrl.T.Helper()
@@ -145,3 +187,35 @@ func (mr *MockILoggerMockRecorder) Warn(scope any, fields ...any) *gomock.Call {
 	varargs := append([]any{scope}, fields...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Warn", reflect.TypeOf((*MockILogger)(nil).Warn), varargs...)
 }
+
+// With mocks base method.
+func (m *MockILogger) With(fields ...zap.Field) logger.ILogger {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	for _, a := range fields {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "With", varargs...)
+	ret0, _ := ret[0].(logger.ILogger)
+	return ret0
+}
+
+// With indicates an expected call of With.
+func (mr *MockILoggerMockRecorder) With(fields ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "With", reflect.TypeOf((*MockILogger)(nil).With), fields...)
+}
+
+// WithContext mocks base method.
+func (m *MockILogger) WithContext(ctx context.Context) logger.ILogger {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithContext", ctx)
+	ret0, _ := ret[0].(logger.ILogger)
+	return ret0
+}
+
+// WithContext indicates an expected call of WithContext.
+func (mr *MockILoggerMockRecorder) WithContext(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithContext", reflect.TypeOf((*MockILogger)(nil).WithContext), ctx)
+}
@@ -0,0 +1,79 @@
+package logger
+
+import (
+	"strings"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// redacted the value of the masked fields
+const redacted = "[REDACTED]"
+
+// defaultRedactKeys the masked keys, when the config is not set
+var defaultRedactKeys = []string{"password", "secret", "token", "authorization", "cookie", "email", "dsn"}
+
+// redactCore masks the values of the fields whose keys contain any of the keys, case-insensitively.
+// the fields of the nested objects are not masked, so the sensitive values are logged as the top-level fields
+type redactCore struct {
+	zapcore.Core
+	keys []string
+}
+
+func newRedactCore(core zapcore.Core, keys []string) zapcore.Core {
+	return &redactCore{Core: core, keys: keys}
+}
+
+func (c *redactCore) With(fields []zap.Field) zapcore.Core {
+	return &redactCore{Core: c.Core.With(c.redact(fields)), keys: c.keys}
+}
+
+func (c *redactCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(ent.Level) {
+		return ce.AddCore(ent, c)
+	}
+
+	return ce
+}
+
+func (c *redactCore) Write(ent zapcore.Entry, fields []zap.Field) error {
+	return c.Core.Write(ent, c.redact(fields))
+}
+
+// redact the fields with the masked values, the fields are copied when any of them is masked
+func (c *redactCore) redact(fields []zap.Field) []zap.Field {
+	var masked []zap.Field
+
+	for i, field := range fields {
+		if c.sensitive(field.Key) == false {
+			continue
+		}
+
+		if masked == nil {
+			masked = append(make([]zap.Field, 0, len(fields)), fields...)
+		}
+
+		masked[i] = zap.String(field.Key, redacted)
+	}
+
+	if masked == nil {
+		return fields
+	}
+
+	return masked
+}
+
+func (c *redactCore) sensitive(key string) bool {
+	if len(key) == 0 {
+		return false
+	}
+
+	key = strings.ToLower(key)
+	for _, k := range c.keys {
+		if strings.Contains(key, k) {
+			return true
+		}
+	}
+
+	return false
+}
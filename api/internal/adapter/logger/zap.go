@@ -1,6 +1,7 @@
 package logger
 
 import (
+	"context"
 	"fmt"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -8,12 +9,17 @@ import (
 	src "microservice"
 	"microservice/config"
 	"microservice/internal/adapter/registry"
+	"microservice/pkg/logfile"
 	"os"
+	"strings"
 	"time"
 )
 
 type level int
 
+// defaultMaxSizeMB the size of the rotated files, when the config is not set
+const defaultMaxSizeMB = 100
+
 const (
 	_ level = iota
 	DEBUG
@@ -26,7 +32,9 @@ const (
 type logger struct {
 	service config.Service
 	config  config.Logger
+	level   zap.AtomicLevel
 	zap     *zap.Logger
+	files   []*logfile.File
 }
 
 func New(registry registry.IRegistry) ILogger {
@@ -34,17 +42,39 @@ func New(registry registry.IRegistry) ILogger {
 	registry.Parse(&client.service)
 	registry.Parse(&client.config)
 
+	// NOTE: the level is ready before the Init, the Level and the SetLevel are called by the admin routes
+	client.initLevel()
 	return client
 }
 
 func (l *logger) Init() {
+	l.build(zapcore.AddSync(os.Stdout), l.openFile("err"), l.openFile("info"))
+}
+
+// build the zap logger writing to the console and to the error and the info files
+func (l *logger) build(console, errFile, infoFile zapcore.WriteSyncer) {
 	var opts []zap.Option
 
-	core := zapcore.NewTee(
-		consoleCore(),
-		fileCore(ERR, "err"),
-		fileCore(INFO, "info"),
-	)
+	keys := l.redactKeys()
+	cores := func(enabled zap.LevelEnablerFunc) zapcore.Core {
+		return zapcore.NewTee(
+			newRedactCore(consoleCore(console, l.enabler(DEBUG, enabled)), keys),
+			newRedactCore(fileCore(errFile, l.enabler(ERR, enabled)), keys),
+			newRedactCore(fileCore(infoFile, l.enabler(INFO, enabled)), keys),
+		)
+	}
+
+	core := cores(func(zapcore.Level) bool { return true })
+	if l.config.SamplingInitial > 0 {
+		// NOTE: the errors are never dropped, only the repeated entries of the lower levels are sampled
+		core = zapcore.NewTee(
+			zapcore.NewSamplerWithOptions(
+				cores(func(lvl zapcore.Level) bool { return lvl < zapcore.ErrorLevel }),
+				l.samplingTick(), l.config.SamplingInitial, l.samplingThereafter(),
+			),
+			cores(func(lvl zapcore.Level) bool { return lvl >= zapcore.ErrorLevel }),
+		)
+	}
 
 	opts = append(opts, zap.AddCaller(), zap.AddCallerSkip(1))
 
@@ -53,9 +83,50 @@ func (l *logger) Init() {
 
 func (l *logger) Stop() {
 	_ = l.zap.Sync() // ignore Sync error: because the stdout isn't flushable
+
+	for _, file := range l.files {
+		if err := file.Close(); err != nil {
+			log.Printf("[logger] close %s err: %s", file.Name(), err)
+		}
+	}
+
 	log.Printf("[logger] zap stopped successfully")
 }
 
+func (l *logger) Reopen() {
+	for _, file := range l.files {
+		if err := file.Reopen(); err != nil {
+			log.Printf("[logger] reopen %s err: %s", file.Name(), err)
+		}
+	}
+
+	log.Printf("[logger] files reopened")
+}
+
+func (l *logger) With(fields ...zap.Field) ILogger {
+	return &logger{service: l.service, config: l.config, level: l.level, zap: l.zap.With(fields...), files: l.files}
+}
+
+func (l *logger) WithContext(ctx context.Context) ILogger {
+	return l.With(Context(ctx))
+}
+
+func (l *logger) Level() string { return l.level.Level().String() }
+
+func (l *logger) SetLevel(level string) error {
+	lvl, err := zapcore.ParseLevel(level)
+	if err != nil {
+		return err
+	}
+
+	if lvl < zapcore.DebugLevel || lvl > zapcore.ErrorLevel {
+		return fmt.Errorf("unsupported level %q", level)
+	}
+
+	l.level.SetLevel(lvl)
+	return nil
+}
+
 func (l *logger) C() *zap.Logger                          { return l.zap }
 func (l *logger) Debug(scope string, fields ...zap.Field) { l.zap.Debug(scope, fields...) }
 func (l *logger) Info(scope string, fields ...zap.Field)  { l.zap.Info(scope, fields...) }
@@ -64,6 +135,56 @@ func (l *logger) Error(scope string, fields ...zap.Field) { l.zap.Error(scope, f
 
 // HELPERS
 
+// initLevel the info level, or the configured one
+func (l *logger) initLevel() {
+	l.level = zap.NewAtomicLevelAt(zapcore.InfoLevel)
+	if len(l.config.LogLevel) > 0 {
+		if err := l.SetLevel(l.config.LogLevel); err != nil {
+			log.Fatalf("[logger] level err: %s", err)
+		}
+	}
+}
+
+// enabler the levels of the core which are enabled by the level of the logger and the filter
+func (l *logger) enabler(lvl level, filter zap.LevelEnablerFunc) zap.LevelEnablerFunc {
+	base := levelEnabler(lvl)
+	return func(z zapcore.Level) bool { return l.level.Enabled(z) && base(z) && filter(z) }
+}
+
+// redactKeys the lower case keys of the masked fields
+func (l *logger) redactKeys() []string {
+	if len(l.config.RedactKeys) == 0 {
+		return defaultRedactKeys
+	}
+
+	keys := make([]string, 0, len(l.config.RedactKeys))
+	for _, key := range l.config.RedactKeys {
+		if key = strings.ToLower(strings.TrimSpace(key)); len(key) > 0 {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+// samplingTick default: 1s
+func (l *logger) samplingTick() time.Duration {
+	if l.config.SamplingTick > 0 {
+		return l.config.SamplingTick
+	}
+
+	return time.Second
+}
+
+// samplingThereafter default: 100
+func (l *logger) samplingThereafter() int {
+	if l.config.SamplingThereafter > 0 {
+		return l.config.SamplingThereafter
+	}
+
+	return 100
+}
+
 func levelEnabler(lvl level) zap.LevelEnablerFunc {
 	levels := map[level]zap.LevelEnablerFunc{
 		DEBUG: zap.LevelEnablerFunc(func(lvl zapcore.Level) bool { return lvl >= zapcore.DebugLevel }), // Logs everything to stdout
@@ -75,26 +196,42 @@ func levelEnabler(lvl level) zap.LevelEnablerFunc {
 	return levels[lvl]
 }
 
-func consoleCore() zapcore.Core {
+func consoleCore(out zapcore.WriteSyncer, enabler zap.LevelEnablerFunc) zapcore.Core {
 	return zapcore.NewCore(
 		zapcore.NewConsoleEncoder(zap.NewDevelopmentEncoderConfig()),
-		zapcore.AddSync(os.Stdout),
-		levelEnabler(DEBUG),
+		out,
+		enabler,
 	)
 }
 
-func fileCore(lvl level, prefix string) zapcore.Core {
-	fileName := fmt.Sprintf("%s/logs/%s-%s.log", src.Root(), prefix, time.Now().Format("2006-01-02"))
-	file, err := os.OpenFile(fileName, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
-	if err != nil {
-		log.Fatal(err)
-	}
-
+func fileCore(out zapcore.WriteSyncer, enabler zap.LevelEnablerFunc) zapcore.Core {
 	// NOTE: there could be multiple Cores per level
 
 	return zapcore.NewCore(
 		zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig()),
-		zapcore.AddSync(file),
-		levelEnabler(lvl),
+		out,
+		enabler,
 	)
 }
+
+// openFile the file of the level is rotated at the midnight of the service time zone and by the size, see logfile
+func (l *logger) openFile(prefix string) zapcore.WriteSyncer {
+	maxSize := l.config.MaxSizeMB
+	if maxSize <= 0 {
+		maxSize = defaultMaxSizeMB
+	}
+
+	file, err := logfile.New(fmt.Sprintf("%s/logs", src.Root()), prefix, logfile.Options{
+		MaxSize:    int64(maxSize) << 20,
+		MaxBackups: l.config.MaxBackups,
+		MaxAge:     l.config.MaxAge,
+		Compress:   l.config.Compress,
+		Location:   time.Local,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	l.files = append(l.files, file)
+	return file
+}
@@ -0,0 +1,91 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"microservice/config"
+	"microservice/pkg/reqctx"
+	"strings"
+	"testing"
+)
+
+func TestLogger(t *testing.T) {
+	newLogger := func(conf config.Logger) (ILogger, *bytes.Buffer, *bytes.Buffer) {
+		errFile, infoFile := new(bytes.Buffer), new(bytes.Buffer)
+
+		l := &logger{config: conf}
+		l.initLevel()
+		l.build(zapcore.AddSync(new(bytes.Buffer)), zapcore.AddSync(errFile), zapcore.AddSync(infoFile))
+		return l, errFile, infoFile
+	}
+
+	t.Run("the configured level", func(t *testing.T) {
+		l, errFile, infoFile := newLogger(config.Logger{LogLevel: "error"})
+		assert.Equal(t, "error", l.Level())
+
+		l.Info("todo.uc.create")
+		l.Error("todo.repo.create")
+		assert.Empty(t, infoFile.String())
+		assert.Contains(t, errFile.String(), "todo.repo.create")
+	})
+
+	t.Run("the level before the init", func(t *testing.T) {
+		l := &logger{config: config.Logger{LogLevel: "warn"}}
+		l.initLevel()
+		assert.Equal(t, "warn", l.Level())
+
+		assert.Nil(t, l.SetLevel("debug"))
+		assert.Equal(t, "debug", l.Level())
+	})
+
+	t.Run("the runtime level is shared by the children", func(t *testing.T) {
+		l, _, infoFile := newLogger(config.Logger{LogLevel: "warn"})
+		child := l.With(zap.String("scope", "child"))
+
+		child.Info("todo.uc.skipped")
+		assert.Empty(t, infoFile.String())
+
+		assert.Nil(t, l.SetLevel("debug"))
+		child.Info("todo.uc.logged")
+		assert.Contains(t, infoFile.String(), `"scope":"child"`)
+		assert.Contains(t, infoFile.String(), "todo.uc.logged")
+
+		assert.NotNil(t, l.SetLevel("verbose"))
+		assert.NotNil(t, l.SetLevel("fatal"))
+		assert.Equal(t, "debug", l.Level())
+	})
+
+	t.Run("the context fields", func(t *testing.T) {
+		l, errFile, _ := newLogger(config.Logger{})
+		ctx := reqctx.WithRequestID(context.Background(), "req-1")
+
+		l.WithContext(ctx).Error("todo.repo.detail")
+		assert.Contains(t, errFile.String(), `"request_id":"req-1"`)
+	})
+
+	t.Run("the configured keys are masked", func(t *testing.T) {
+		l, errFile, _ := newLogger(config.Logger{RedactKeys: []string{"Password", " email "}})
+
+		l.With(zap.String("db_password", "secret-1")).Error("sql.connect", zap.String("user_email", "jane@example.com"), zap.String("user", "jane"))
+		assert.NotContains(t, errFile.String(), "secret-1")
+		assert.NotContains(t, errFile.String(), "jane@example.com")
+		assert.Contains(t, errFile.String(), `"db_password":"[REDACTED]"`)
+		assert.Contains(t, errFile.String(), `"user_email":"[REDACTED]"`)
+		assert.Contains(t, errFile.String(), `"user":"jane"`)
+	})
+
+	t.Run("the sampling keeps the errors", func(t *testing.T) {
+		l, errFile, infoFile := newLogger(config.Logger{SamplingInitial: 2, SamplingThereafter: 100})
+
+		for i := 0; i < 10; i++ {
+			l.Info("http.access")
+			l.Error("todo.repo.create")
+		}
+
+		assert.Equal(t, 2, strings.Count(infoFile.String(), "http.access"))
+		assert.Equal(t, 10, strings.Count(errFile.String(), "todo.repo.create"))
+	})
+}
@@ -0,0 +1,7 @@
+package metrics
+
+const (
+	namespace = "todo"
+	// unmatchedRoute the route label of the requests without a matched route
+	unmatchedRoute = "unmatched"
+)
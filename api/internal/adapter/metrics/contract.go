@@ -0,0 +1,20 @@
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+	"time"
+)
+
+//go:generate mockgen -source=./contract.go -destination=./mocks/metrics_mock.go -package=metrics_mock
+type IMetrics interface {
+	Init()
+	// Handler the exposition of the collected metrics
+	Handler() http.Handler
+	// ObserveRequest the route is the pattern of the matched route, so the label values stay bounded
+	ObserveRequest(method, route string, status int, elapsed time.Duration)
+	ObserveQuery(operation, table string, elapsed time.Duration)
+	// WatchDB collects the connection pool stats of the database
+	WatchDB(db *sql.DB, name string)
+	SetTodos(open, overdue int64)
+}
@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./contract.go -destination=./mocks/metrics_mock.go -package=metrics_mock
+//
+
+// Package metrics_mock is a generated GoMock package.
+package metrics_mock
+
+import (
+	sql "database/sql"
+	http "net/http"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIMetrics is a mock of IMetrics interface.
+type MockIMetrics struct {
+	ctrl     *gomock.Controller
+	recorder *MockIMetricsMockRecorder
+	isgomock struct{}
+}
+
+// MockIMetricsMockRecorder is the mock recorder for MockIMetrics.
+type MockIMetricsMockRecorder struct {
+	mock *MockIMetrics
+}
+
+// NewMockIMetrics creates a new mock instance.
+func NewMockIMetrics(ctrl *gomock.Controller) *MockIMetrics {
+	mock := &MockIMetrics{ctrl: ctrl}
+	mock.recorder = &MockIMetricsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIMetrics) EXPECT() *MockIMetricsMockRecorder {
+	return m.recorder
+}
+
+// Handler mocks base method.
+func (m *MockIMetrics) Handler() http.Handler {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handler")
+	ret0, _ := ret[0].(http.Handler)
+	return ret0
+}
+
+// Handler indicates an expected call of Handler.
+func (mr *MockIMetricsMockRecorder) Handler() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handler", reflect.TypeOf((*MockIMetrics)(nil).Handler))
+}
+
+// Init mocks base method.
+func (m *MockIMetrics) Init() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Init")
+}
+
+// Init indicates an expected call of Init.
+func (mr *MockIMetricsMockRecorder) Init() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Init", reflect.TypeOf((*MockIMetrics)(nil).Init))
+}
+
+// ObserveQuery mocks base method.
+func (m *MockIMetrics) ObserveQuery(operation, table string, elapsed time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ObserveQuery", operation, table, elapsed)
+}
+
+// ObserveQuery indicates an expected call of ObserveQuery.
+func (mr *MockIMetricsMockRecorder) ObserveQuery(operation, table, elapsed any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObserveQuery", reflect.TypeOf((*MockIMetrics)(nil).ObserveQuery), operation, table, elapsed)
+}
+
+// ObserveRequest mocks base method.
+func (m *MockIMetrics) ObserveRequest(method, route string, status int, elapsed time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ObserveRequest", method, route, status, elapsed)
+}
+
+// ObserveRequest indicates an expected call of ObserveRequest.
+func (mr *MockIMetricsMockRecorder) ObserveRequest(method, route, status, elapsed any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObserveRequest", reflect.TypeOf((*MockIMetrics)(nil).ObserveRequest), method, route, status, elapsed)
+}
+
+// SetTodos mocks base method.
+func (m *MockIMetrics) SetTodos(open, overdue int64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTodos", open, overdue)
+}
+
+// SetTodos indicates an expected call of SetTodos.
+func (mr *MockIMetricsMockRecorder) SetTodos(open, overdue any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTodos", reflect.TypeOf((*MockIMetrics)(nil).SetTodos), open, overdue)
+}
+
+// WatchDB mocks base method.
+func (m *MockIMetrics) WatchDB(db *sql.DB, name string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "WatchDB", db, name)
+}
+
+// WatchDB indicates an expected call of WatchDB.
+func (mr *MockIMetricsMockRecorder) WatchDB(db, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchDB", reflect.TypeOf((*MockIMetrics)(nil).WatchDB), db, name)
+}
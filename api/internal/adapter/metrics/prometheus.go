@@ -0,0 +1,91 @@
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+type metrics struct {
+	registry *prometheus.Registry
+	requests *prometheus.CounterVec
+	latency  *prometheus.HistogramVec
+	queries  *prometheus.HistogramVec
+	todos    *prometheus.GaugeVec
+}
+
+// New the metrics of its own registry, the default registry of the libraries is not exposed
+func New() IMetrics {
+	return new(metrics)
+}
+
+func (m *metrics) Init() {
+	m.registry = prometheus.NewRegistry()
+
+	m.requests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "The handled HTTP requests by route and status.",
+	}, []string{"method", "route", "status"})
+
+	m.latency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "The latency of the HTTP requests by route and status.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	m.queries = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "query_duration_seconds",
+		Help:      "The duration of the database queries by operation and table.",
+		Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+	}, []string{"operation", "table"})
+
+	m.todos = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "items",
+		Help:      "The open and the overdue items of all the users.",
+	}, []string{"state"})
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.requests, m.latency, m.queries, m.todos,
+	)
+}
+
+func (m *metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+func (m *metrics) ObserveRequest(method, route string, status int, elapsed time.Duration) {
+	if len(route) == 0 {
+		route = unmatchedRoute
+	}
+
+	code := strconv.Itoa(status)
+	m.requests.WithLabelValues(method, route, code).Inc()
+	m.latency.WithLabelValues(method, route, code).Observe(elapsed.Seconds())
+}
+
+func (m *metrics) ObserveQuery(operation, table string, elapsed time.Duration) {
+	m.queries.WithLabelValues(operation, table).Observe(elapsed.Seconds())
+}
+
+func (m *metrics) WatchDB(db *sql.DB, name string) {
+	m.registry.MustRegister(collectors.NewDBStatsCollector(db, name))
+}
+
+func (m *metrics) SetTodos(open, overdue int64) {
+	m.todos.WithLabelValues("open").Set(float64(open))
+	m.todos.WithLabelValues("overdue").Set(float64(overdue))
+}
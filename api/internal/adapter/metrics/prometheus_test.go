@@ -0,0 +1,82 @@
+package metrics
+
+import (
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	gormLogger "gorm.io/gorm/logger"
+	"io"
+	"microservice/internal/adapter/orm"
+	"microservice/internal/adapter/orm/model"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestMetrics(t *testing.T) {
+	scrape := func(t *testing.T, m IMetrics) string {
+		rec := httptest.NewRecorder()
+		m.Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+
+		body, err := io.ReadAll(rec.Body)
+		assert.Nil(t, err)
+		return string(body)
+	}
+
+	t.Run("the requests by route and status", func(t *testing.T) {
+		m := New()
+		m.Init()
+
+		m.ObserveRequest("GET", "/api/v1/todo/:uuid", 200, 20*time.Millisecond)
+		m.ObserveRequest("GET", "/api/v1/todo/:uuid", 200, 30*time.Millisecond)
+		m.ObserveRequest("GET", "", 404, time.Millisecond)
+
+		body := scrape(t, m)
+		assert.Contains(t, body, `todo_http_requests_total{method="GET",route="/api/v1/todo/:uuid",status="200"} 2`)
+		assert.Contains(t, body, `todo_http_requests_total{method="GET",route="unmatched",status="404"} 1`)
+		assert.Contains(t, body, `todo_http_request_duration_seconds_count{method="GET",route="/api/v1/todo/:uuid",status="200"} 2`)
+		assert.Contains(t, body, "go_goroutines")
+	})
+
+	t.Run("the item gauges", func(t *testing.T) {
+		m := New()
+		m.Init()
+
+		m.SetTodos(12, 3)
+
+		body := scrape(t, m)
+		assert.Contains(t, body, `todo_items{state="open"} 12`)
+		assert.Contains(t, body, `todo_items{state="overdue"} 3`)
+	})
+
+	t.Run("the queries and the pool of the database", func(t *testing.T) {
+		dbConn, dbErr := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{
+			Logger: gormLogger.Default.LogMode(gormLogger.Silent),
+		})
+
+		if dbErr != nil {
+			t.Fatalf("failed to open in-memory db: %v", dbErr)
+		}
+
+		sqlDatabase, dbErr := dbConn.DB()
+		if dbErr != nil {
+			t.Fatalf("failed to get the sql db: %v", dbErr)
+		}
+
+		t.Cleanup(func() { _ = sqlDatabase.Close() })
+
+		m := New()
+		m.Init()
+		m.WatchDB(sqlDatabase, "microservice")
+
+		assert.Nil(t, dbConn.Use(orm.NewMetricsPlugin(m.ObserveQuery)))
+		assert.Nil(t, dbConn.AutoMigrate(&model.Todos{}))
+		assert.Nil(t, dbConn.Create(&model.Todos{Description: "measured item"}).Error)
+		assert.Nil(t, dbConn.Find(&[]*model.Todos{}).Error)
+
+		body := scrape(t, m)
+		assert.Contains(t, body, `todo_db_query_duration_seconds_count{operation="create",table="todos"} 1`)
+		assert.Contains(t, body, `todo_db_query_duration_seconds_count{operation="query",table="todos"} 1`)
+		assert.Contains(t, body, `go_sql_open_connections{db_name="microservice"}`)
+	})
+}
@@ -1,6 +1,9 @@
 package orm
 
-import "gorm.io/gorm"
+import (
+	"context"
+	"gorm.io/gorm"
+)
 
 //go:generate mockgen -source=./contract.go -destination=./mocks/orm_mock.go -package=orm_mock
 type ISql interface {
@@ -13,6 +16,10 @@ type (
 		Init()
 		C() *gorm.DB
 		Migrate(path string)
+		// Migrated the migrations are applied
+		Migrated() bool
+		// Ping checks the connection of the database
+		Ping(ctx context.Context) error
 		Seed()
 		Stop()
 	}
@@ -23,5 +30,8 @@ type (
 		Rollback() error
 		// Resolve commit or rollback transaction by getting the error
 		Resolve(dbErr error) error
+		// Transaction runs the fn in a transaction with an isolated transactional instance,
+		//unlike Begin it is safe for the concurrent requests. it is committed when fn returns nil
+		Transaction(ctx context.Context, fn func(tx ISql) error) error
 	}
 )
@@ -1,6 +1,7 @@
 package orm
 
 import (
+	"context"
 	"fmt"
 	"microservice/config"
 	"microservice/internal/adapter/locale"
@@ -12,8 +13,8 @@ import (
 	"log"
 	"os"
 	"sort"
-	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -23,33 +24,15 @@ type sql struct {
 	l       locale.ILocale
 	db      *gorm.DB
 	tx      *gorm.DB
+	// migrated the migrations are applied, it is set once by Migrate
+	migrated atomic.Bool
 }
 
 func New(service *config.Service, registry registry.IRegistry, locale locale.ILocale) ISql {
 	db := new(sql)
+	registry.Parse(&db.config)
 
-	if service.Debug == false {
-		debug, _ := strconv.ParseBool(os.Getenv("DB_DEBUG"))
-		maxIdleConn, _ := strconv.Atoi(os.Getenv("DB_MAX_IDLE_CONNECTIONS"))
-		maxOpenConn, _ := strconv.Atoi(os.Getenv("DB_MAX_OPEN_CONNECTIONS"))
-		maxLifetimeSec, _ := strconv.Atoi(os.Getenv("DB_MAX_LIFETIME_SECONDS"))
-		slowSqlThreshold, _ := strconv.Atoi(os.Getenv("DB_SLOW_SQL_THRESHOLD"))
-
-		db.config.Debug = debug
-		db.config.Host = os.Getenv("DB_HOST")
-		db.config.Port = os.Getenv("DB_PORT")
-		db.config.Username = os.Getenv("DB_USERNAME")
-		db.config.Password = os.Getenv("DB_PASSWORD")
-		db.config.Database = os.Getenv("DB_DATABASE")
-		db.config.Ssl = os.Getenv("DB_SSL")
-		db.config.MaxIdleConnections = maxIdleConn
-		db.config.MaxOpenConnections = maxOpenConn
-		db.config.MaxLifetimeSeconds = maxLifetimeSec
-		db.config.SlowSqlThreshold = slowSqlThreshold
-	} else {
-		registry.Parse(&db.config)
-	}
-
+	db.service = service
 	db.l = locale
 	return db
 }
@@ -158,6 +141,21 @@ func (s *sql) Migrate(path string) {
 			}
 		}
 	}
+
+	s.migrated.Store(true)
+}
+
+func (s *sql) Migrated() bool {
+	return s.migrated.Load()
+}
+
+func (s *sql) Ping(ctx context.Context) error {
+	sqlDatabase, err := s.db.DB()
+	if err != nil {
+		return err
+	}
+
+	return sqlDatabase.PingContext(ctx)
 }
 
 func (s *sql) Seed() {
@@ -196,6 +194,12 @@ func (s *sql) Resolve(dbErr error) (err error) {
 	return
 }
 
+func (s *sql) Transaction(ctx context.Context, fn func(tx ISql) error) error {
+	return s.C().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(&sql{service: s.service, config: s.config, l: s.l, db: s.db, tx: tx})
+	})
+}
+
 // HELPER METHODS
 
 func (s *sql) newGormLog(SlowSqlThreshold int) logger.Interface {
@@ -0,0 +1,41 @@
+package orm
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// metricsStartKey the instance key of the start time of the statement
+const metricsStartKey = "metrics:start"
+
+// metricsPlugin observes the duration of the statements by their operation and table
+type metricsPlugin struct {
+	observe func(operation, table string, elapsed time.Duration)
+}
+
+// NewMetricsPlugin the gorm plugin reporting the statement durations to the observer
+func NewMetricsPlugin(observe func(operation, table string, elapsed time.Duration)) gorm.Plugin {
+	return &metricsPlugin{observe: observe}
+}
+
+func (p *metricsPlugin) Name() string { return "metrics" }
+
+func (p *metricsPlugin) Initialize(db *gorm.DB) error {
+	before := func(string) func(*gorm.DB) {
+		return func(tx *gorm.DB) { tx.InstanceSet(metricsStartKey, time.Now()) }
+	}
+
+	after := func(operation string) func(*gorm.DB) {
+		return func(tx *gorm.DB) {
+			start, ok := tx.InstanceGet(metricsStartKey)
+			if !ok {
+				return
+			}
+
+			p.observe(operation, statementTable(tx), time.Since(start.(time.Time)))
+		}
+	}
+
+	return registerAround(db, p.Name(), before, after)
+}
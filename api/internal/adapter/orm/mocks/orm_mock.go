@@ -10,6 +10,8 @@
 package orm_mock
 
 import (
+	context "context"
+	orm "microservice/internal/adapter/orm"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -104,6 +106,34 @@ func (mr *MockISqlMockRecorder) Migrate(path any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Migrate", reflect.TypeOf((*MockISql)(nil).Migrate), path)
 }
 
+// Migrated mocks base method.
+func (m *MockISql) Migrated() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Migrated")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Migrated indicates an expected call of Migrated.
+func (mr *MockISqlMockRecorder) Migrated() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Migrated", reflect.TypeOf((*MockISql)(nil).Migrated))
+}
+
+// Ping mocks base method.
+func (m *MockISql) Ping(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ping", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ping indicates an expected call of Ping.
+func (mr *MockISqlMockRecorder) Ping(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockISql)(nil).Ping), ctx)
+}
+
 // Resolve mocks base method.
 func (m *MockISql) Resolve(dbErr error) error {
 	m.ctrl.T.Helper()
@@ -156,6 +186,20 @@ func (mr *MockISqlMockRecorder) Stop() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockISql)(nil).Stop))
 }
 
+// Transaction mocks base method.
+func (m *MockISql) Transaction(ctx context.Context, fn func(orm.ISql) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transaction", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Transaction indicates an expected call of Transaction.
+func (mr *MockISqlMockRecorder) Transaction(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transaction", reflect.TypeOf((*MockISql)(nil).Transaction), ctx, fn)
+}
+
 // MockISqlGeneric is a mock of ISqlGeneric interface.
 type MockISqlGeneric struct {
 	ctrl     *gomock.Controller
@@ -218,6 +262,34 @@ func (mr *MockISqlGenericMockRecorder) Migrate(path any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Migrate", reflect.TypeOf((*MockISqlGeneric)(nil).Migrate), path)
 }
 
+// Migrated mocks base method.
+func (m *MockISqlGeneric) Migrated() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Migrated")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Migrated indicates an expected call of Migrated.
+func (mr *MockISqlGenericMockRecorder) Migrated() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Migrated", reflect.TypeOf((*MockISqlGeneric)(nil).Migrated))
+}
+
+// Ping mocks base method.
+func (m *MockISqlGeneric) Ping(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ping", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ping indicates an expected call of Ping.
+func (mr *MockISqlGenericMockRecorder) Ping(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockISqlGeneric)(nil).Ping), ctx)
+}
+
 // Seed mocks base method.
 func (m *MockISqlGeneric) Seed() {
 	m.ctrl.T.Helper()
@@ -319,3 +391,17 @@ func (mr *MockISqlTxMockRecorder) Rollback() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockISqlTx)(nil).Rollback))
 }
+
+// Transaction mocks base method.
+func (m *MockISqlTx) Transaction(ctx context.Context, fn func(orm.ISql) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transaction", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Transaction indicates an expected call of Transaction.
+func (mr *MockISqlTxMockRecorder) Transaction(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transaction", reflect.TypeOf((*MockISqlTx)(nil).Transaction), ctx, fn)
+}
@@ -0,0 +1,22 @@
+package model
+
+import "time"
+
+type IdempotencyKeys struct {
+	ID             uint      `json:"id" gorm:"primarykey"`
+	IdempotencyKey string    `json:"idempotencyKey" gorm:"uniqueIndex:idempotency_keys_key_scope_unique"`
+	Scope          string    `json:"scope" gorm:"uniqueIndex:idempotency_keys_key_scope_unique"`
+	RequestHash    string    `json:"requestHash"`
+	StatusCode     int       `json:"statusCode"`
+	Response       string    `json:"response"`
+	Etag           string    `json:"etag"`
+	Completed      bool      `json:"completed"`
+	LockedUntil    time.Time `json:"lockedUntil"`
+	ExpiresAt      time.Time `json:"expiresAt"`
+	CreatedAt      time.Time `json:"createdAt"`
+	UpdatedAt      time.Time `json:"updatedAt"`
+}
+
+func NewIdempotencyKey() *IdempotencyKeys { return &IdempotencyKeys{} }
+
+func (m *IdempotencyKeys) TableName() string { return "idempotency_keys" }
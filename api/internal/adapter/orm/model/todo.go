@@ -4,8 +4,23 @@ import "time"
 
 type Todos struct {
 	BaseSql
-	Description string    `json:"description"`
-	DueDate     time.Time `json:"dueDate"`
+	Description    string     `json:"description"`
+	DueDate        *time.Time `json:"dueDate"` // UTC
+	AllDay         bool       `json:"allDay"`
+	DueTimeZone    *string    `json:"dueTimeZone"`
+	Notes          *string    `json:"notes"`
+	Recurrence     *string    `json:"recurrence"`                        // RFC 5545 RRULE value
+	SearchLanguage string     `json:"searchLanguage" gorm:"default:(-)"` // database default: english
+	Version        uint       `json:"version" gorm:"not null;default:1"`
+	CompletedAt    *time.Time `json:"completedAt"` // UTC
+	Owner          *string    `json:"owner"`       // nil for the items accessible by everyone
+	Status         string     `json:"status" gorm:"not null;default:todo"`
+	BoardRank      *string    `json:"boardRank"` // fractional index in the column of the status
+	// preloaded by the reads, the writes of the item do not touch them
+	Assignees []*TodoAssignees `json:"-" gorm:"foreignKey:TodoUuid;references:Uuid"`
+	// read-only fields are filled by the full-text search queries
+	Rank      float64 `json:"-" gorm:"->;-:migration"`
+	Highlight string  `json:"-" gorm:"->;-:migration"`
 }
 
 func NewTodo() *Todos { return &Todos{} }
@@ -0,0 +1,26 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// TodoAssignees an assignee of the item, the item has at most one row per assignee
+type TodoAssignees struct {
+	ID         uint      `json:"id" gorm:"primarykey"`
+	TodoUuid   uuid.UUID `json:"todoUuid" gorm:"uniqueIndex:todo_assignees_assignee_unique"`
+	Assignee   string    `json:"assignee" gorm:"uniqueIndex:todo_assignees_assignee_unique"`
+	AssignedBy *string   `json:"assignedBy"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+func NewTodoAssignee() *TodoAssignees { return &TodoAssignees{} }
+
+func (m *TodoAssignees) TableName() string { return "todo_assignees" }
+
+// TodoWorkloads the counts of the open items of an assignee, it is scanned from the aggregation
+type TodoWorkloads struct {
+	Assignee *string `json:"assignee"` // nil for the unassigned items
+	Open     int64   `json:"open"`
+	Overdue  int64   `json:"overdue"`
+}
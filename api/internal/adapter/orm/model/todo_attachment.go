@@ -0,0 +1,25 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// TodoAttachments the attachments are deleted with their blobs, so there is no soft-delete
+type TodoAttachments struct {
+	ID          uint      `json:"id" gorm:"primarykey"`
+	Uuid        uuid.UUID `json:"uuid"`
+	TodoUuid    uuid.UUID `json:"todoUuid"`
+	FileName    string    `json:"fileName"`
+	ContentType string    `json:"contentType"`
+	Size        int64     `json:"size"`
+	Checksum    string    `json:"checksum"` // SHA-256, hex
+	StorageKey  string    `json:"storageKey"`
+	Uploader    *string   `json:"uploader"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+func NewTodoAttachment() *TodoAttachments { return &TodoAttachments{} }
+
+func (m *TodoAttachments) TableName() string { return "todo_attachments" }
@@ -0,0 +1,21 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// TodoCalendarFeeds the iCalendar feed of a user, the token itself is not stored
+type TodoCalendarFeeds struct {
+	ID        uint      `json:"id" gorm:"primarykey"`
+	Uuid      uuid.UUID `json:"uuid"`
+	Owner     string    `json:"owner"`
+	Component string    `json:"component"`                                                  // vtodo or vevent
+	TokenHash string    `json:"-" gorm:"uniqueIndex:todo_calendar_feeds_token_hash_unique"` // SHA-256, hex
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+func NewTodoCalendarFeed() *TodoCalendarFeeds { return &TodoCalendarFeeds{} }
+
+func (m *TodoCalendarFeeds) TableName() string { return "todo_calendar_feeds" }
@@ -0,0 +1,19 @@
+package model
+
+import "github.com/google/uuid"
+
+// TodoComments the replies reference the root comment of the thread, the mentions are a JSON array of the user ids
+type TodoComments struct {
+	BaseSql
+	TodoUuid   uuid.UUID  `json:"todoUuid"`
+	ParentUuid *uuid.UUID `json:"parentUuid"`
+	Author     *string    `json:"author"`
+	Body       string     `json:"body"`
+	Mentions   string     `json:"mentions"`
+	// read-only field is filled by the list query of the root comments
+	ReplyCount int64 `json:"-" gorm:"->;-:migration"`
+}
+
+func NewTodoComment() *TodoComments { return &TodoComments{} }
+
+func (m *TodoComments) TableName() string { return "todo_comments" }
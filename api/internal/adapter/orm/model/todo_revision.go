@@ -0,0 +1,23 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// TodoRevisions immutable audit records, the snapshots and the diff are JSON documents
+type TodoRevisions struct {
+	ID        uint      `json:"id" gorm:"primarykey"`
+	TodoUuid  uuid.UUID `json:"todoUuid"`
+	Action    string    `json:"action"`
+	Actor     *string   `json:"actor"`
+	RequestId *string   `json:"requestId"`
+	Before    *string   `json:"before"`
+	After     *string   `json:"after"`
+	Diff      string    `json:"diff"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func NewTodoRevision() *TodoRevisions { return &TodoRevisions{} }
+
+func (m *TodoRevisions) TableName() string { return "todo_revisions" }
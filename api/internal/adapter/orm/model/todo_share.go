@@ -0,0 +1,39 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// TodoShares a grant of the item, a grantee has one grant per item
+type TodoShares struct {
+	ID          uint      `json:"id" gorm:"primarykey"`
+	Uuid        uuid.UUID `json:"uuid"`
+	TodoUuid    uuid.UUID `json:"todoUuid" gorm:"uniqueIndex:todo_shares_grantee_unique"`
+	GranteeType string    `json:"granteeType" gorm:"uniqueIndex:todo_shares_grantee_unique"` // user or group
+	Grantee     string    `json:"grantee" gorm:"uniqueIndex:todo_shares_grantee_unique"`
+	Permission  string    `json:"permission"`
+	GrantedBy   *string   `json:"grantedBy"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+func NewTodoShare() *TodoShares { return &TodoShares{} }
+
+func (m *TodoShares) TableName() string { return "todo_shares" }
+
+// TodoShareLinks the read-only link of the item, the token itself is not stored
+type TodoShareLinks struct {
+	ID        uint       `json:"id" gorm:"primarykey"`
+	Uuid      uuid.UUID  `json:"uuid"`
+	TodoUuid  uuid.UUID  `json:"todoUuid"`
+	TokenHash string     `json:"-" gorm:"uniqueIndex:todo_share_links_token_hash_unique"` // SHA-256, hex
+	ExpiresAt *time.Time `json:"expiresAt"`                                               // UTC, nil for the links without expiry
+	CreatedBy *string    `json:"createdBy"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt time.Time  `json:"updatedAt"`
+}
+
+func NewTodoShareLink() *TodoShareLinks { return &TodoShareLinks{} }
+
+func (m *TodoShareLinks) TableName() string { return "todo_share_links" }
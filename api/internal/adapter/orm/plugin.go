@@ -0,0 +1,42 @@
+package orm
+
+import "gorm.io/gorm"
+
+// statementCallback the callback of the statements of the operation: create, query, update, delete, row or raw
+type statementCallback func(operation string) func(*gorm.DB)
+
+// registerAround registers the callbacks of the plugin before and after the statements of every operation
+func registerAround(db *gorm.DB, plugin string, before, after statementCallback) error {
+	cb := db.Callback()
+	registrations := []error{
+		cb.Create().Before("gorm:create").Register(plugin+":before_create", before("create")),
+		cb.Create().After("gorm:create").Register(plugin+":after_create", after("create")),
+		cb.Query().Before("gorm:query").Register(plugin+":before_query", before("query")),
+		cb.Query().After("gorm:query").Register(plugin+":after_query", after("query")),
+		cb.Update().Before("gorm:update").Register(plugin+":before_update", before("update")),
+		cb.Update().After("gorm:update").Register(plugin+":after_update", after("update")),
+		cb.Delete().Before("gorm:delete").Register(plugin+":before_delete", before("delete")),
+		cb.Delete().After("gorm:delete").Register(plugin+":after_delete", after("delete")),
+		cb.Row().Before("gorm:row").Register(plugin+":before_row", before("row")),
+		cb.Row().After("gorm:row").Register(plugin+":after_row", after("row")),
+		cb.Raw().Before("gorm:raw").Register(plugin+":before_raw", before("raw")),
+		cb.Raw().After("gorm:raw").Register(plugin+":after_raw", after("raw")),
+	}
+
+	for _, err := range registrations {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// statementTable the table of the statement, raw for the raw statements
+func statementTable(tx *gorm.DB) string {
+	if len(tx.Statement.Table) == 0 {
+		return "raw"
+	}
+
+	return tx.Statement.Table
+}
@@ -0,0 +1,84 @@
+package orm
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+// tracingSpanKey the instance key of the span of the statement
+const tracingSpanKey = "tracing:span"
+
+// tracedStatement the span of the statement with the context it replaced
+type tracedStatement struct {
+	span   trace.Span
+	parent context.Context
+}
+
+// tracingPlugin traces the statements as the client spans of the span of the statement context
+type tracingPlugin struct {
+	tracer trace.Tracer
+	system string
+}
+
+// NewTracingPlugin the gorm plugin tracing the statements, the system is the db.system attribute like postgresql.
+// the statements are traced with their placeholders, the bound values are not recorded
+func NewTracingPlugin(tracer trace.Tracer, system string) gorm.Plugin {
+	return &tracingPlugin{tracer: tracer, system: system}
+}
+
+func (p *tracingPlugin) Name() string { return "tracing" }
+
+func (p *tracingPlugin) Initialize(db *gorm.DB) error {
+	before := func(operation string) func(*gorm.DB) {
+		return func(tx *gorm.DB) {
+			ctx := tx.Statement.Context
+			if ctx == nil {
+				ctx = context.Background()
+			}
+
+			// NOTE: the statements without a traced parent are not traced, like the migrations and the jobs
+			if trace.SpanFromContext(ctx).SpanContext().IsValid() == false {
+				return
+			}
+
+			spanCtx, span := p.tracer.Start(ctx, "gorm."+operation, trace.WithSpanKind(trace.SpanKindClient))
+			tx.Statement.Context = spanCtx
+			tx.InstanceSet(tracingSpanKey, &tracedStatement{span: span, parent: ctx})
+		}
+	}
+
+	after := func(operation string) func(*gorm.DB) {
+		return func(tx *gorm.DB) {
+			value, ok := tx.InstanceGet(tracingSpanKey)
+			if !ok {
+				return
+			}
+
+			// NOTE: the chained statements of the same session are the siblings of the statement, not its children
+			traced := value.(*tracedStatement)
+			tx.Statement.Context = traced.parent
+			span := traced.span
+			defer span.End()
+
+			span.SetAttributes(
+				semconv.DBSystemKey.String(p.system),
+				semconv.DBOperationNameKey.String(operation),
+				semconv.DBCollectionNameKey.String(statementTable(tx)),
+				semconv.DBQueryTextKey.String(tx.Statement.SQL.String()),
+				attribute.Int64("db.rows_affected", tx.Statement.RowsAffected),
+			)
+
+			if tx.Error != nil && tx.Error != gorm.ErrRecordNotFound {
+				span.RecordError(tx.Error)
+				span.SetStatus(codes.Error, tx.Error.Error())
+			}
+		}
+	}
+
+	return registerAround(db, p.Name(), before, after)
+}
@@ -3,4 +3,6 @@ package registry
 const (
 	EnvFormat = ".env"
 	EnvMime   = "env"
+	// SecretFileSuffix the value of a key is read from the file of the key with that suffix, like DB_PASSWORD_FILE
+	SecretFileSuffix = "_FILE"
 )
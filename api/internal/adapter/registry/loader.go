@@ -0,0 +1,159 @@
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"microservice/config"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// key a config key with its default value
+type key struct {
+	name  string
+	value string
+}
+
+// load resolves all the config keys and validates them, the problems of all the keys are returned together.
+// a key is resolved by the first non-empty of: the environment, the secret file of the environment, the .env file,
+// the secret file of the .env file and its default
+func (v *registry) load(lookupEnv func(string) (string, bool)) error {
+	problems := make([]string, 0)
+
+	for _, k := range configKeys(reflect.TypeOf(config.Config{})) {
+		value, err := v.resolve(k, lookupEnv)
+		if err != nil {
+			problems = append(problems, err.Error())
+			continue
+		}
+
+		if len(value) > 0 {
+			v.Set(k.name, value)
+		}
+	}
+
+	var conf config.Config
+	if err := v.Unmarshal(&conf); err != nil {
+		problems = append(problems, decodeProblems(err)...)
+	}
+
+	// NOTE: the keys of the type errors are zero, their rules are not listed again
+	decoded := strings.Join(problems, "\n")
+	for _, problem := range validationProblems(conf) {
+		if name, _, _ := strings.Cut(problem, ":"); !strings.Contains(decoded, "'"+name+"'") {
+			problems = append(problems, problem)
+		}
+	}
+
+	if len(problems) > 0 {
+		return errors.New(strings.Join(problems, "\n"))
+	}
+
+	return nil
+}
+
+func (v *registry) resolve(k key, lookupEnv func(string) (string, bool)) (string, error) {
+	if value, _ := lookupEnv(k.name); len(value) > 0 {
+		return value, nil
+	}
+
+	if path, _ := lookupEnv(k.name + SecretFileSuffix); len(path) > 0 {
+		return readSecret(k.name, path)
+	}
+
+	if value := v.GetString(k.name); len(value) > 0 {
+		return value, nil
+	}
+
+	if path := v.GetString(k.name + SecretFileSuffix); len(path) > 0 {
+		return readSecret(k.name, path)
+	}
+
+	return k.value, nil
+}
+
+// HELPERS
+
+// configKeys the keys of the mapstructure tags with the values of the default tags, the nested structs are walked
+func configKeys(t reflect.Type) []key {
+	keys := make([]key, 0)
+	seen := make(map[string]bool)
+
+	var walk func(reflect.Type)
+	walk = func(t reflect.Type) {
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			name, _, _ := strings.Cut(field.Tag.Get("mapstructure"), ",")
+
+			switch {
+			case field.Type.Kind() == reflect.Struct:
+				walk(field.Type)
+			case len(name) > 0 && !seen[name]:
+				seen[name] = true
+				keys = append(keys, key{name: name, value: field.Tag.Get("default")})
+			}
+		}
+	}
+
+	walk(t)
+	return keys
+}
+
+// readSecret the content of the secret file without the trailing line break
+func readSecret(name, path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("%s%s: the secret file is not readable: %s", name, SecretFileSuffix, err)
+	}
+
+	return strings.TrimRight(string(content), "\r\n"), nil
+}
+
+// decodeProblems the type errors of the keys, one per line
+func decodeProblems(err error) []string {
+	problems := make([]string, 0)
+
+	for _, line := range strings.Split(err.Error(), "\n") {
+		if line = strings.TrimSpace(line); len(line) > 0 && !strings.HasPrefix(line, "decoding failed") {
+			problems = append(problems, line)
+		}
+	}
+
+	return problems
+}
+
+// validationProblems the failed rules of the validate tags, the keys are named by their mapstructure tags.
+// NOTE: the values are not listed, they may be secrets
+func validationProblems(conf config.Config) []string {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("mapstructure"), ",")
+		return name
+	})
+
+	var validationErrs validator.ValidationErrors
+	if err := validate.Struct(conf); !errors.As(err, &validationErrs) {
+		return nil
+	}
+
+	// NOTE: the structs embedded by the others like Locale are validated twice, their problems are listed once
+	problems := make([]string, 0, len(validationErrs))
+	seen := make(map[string]bool)
+	for _, fe := range validationErrs {
+		rule := fe.Tag()
+		if len(fe.Param()) > 0 {
+			rule += "=" + fe.Param()
+		}
+
+		problem := fmt.Sprintf("%s: the value does not satisfy the %s rule", fe.Field(), rule)
+		if !seen[problem] {
+			seen[problem] = true
+			problems = append(problems, problem)
+		}
+	}
+
+	return problems
+}
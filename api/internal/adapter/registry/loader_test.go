@@ -0,0 +1,139 @@
+package registry
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"microservice/config"
+)
+
+func TestRegistry(t *testing.T) {
+	// required keys without the defaults
+	required := map[string]string{
+		"DB_HOST":     "localhost",
+		"DB_USERNAME": "todo",
+		"DB_DATABASE": "todo",
+	}
+
+	newRegistry := func(t *testing.T, file string, env map[string]string) (*registry, error) {
+		v := &registry{viper.New()}
+		v.SetConfigType(EnvMime)
+		require.NoError(t, v.ReadConfig(strings.NewReader(file)))
+
+		err := v.load(func(name string) (string, bool) {
+			value, ok := env[name]
+			return value, ok
+		})
+
+		return v, err
+	}
+
+	t.Run("the defaults of the keys without a value", func(t *testing.T) {
+		v, err := newRegistry(t, "APP_NAME=todo\nHTTP_PORT=\"\"", required)
+		require.NoError(t, err)
+
+		var service config.Service
+		var server config.Http
+		var logger config.Logger
+		v.Parse(&service)
+		v.Parse(&server)
+		v.Parse(&logger)
+
+		assert.Equal(t, "todo", service.Name)
+		assert.Equal(t, "en-US", service.Lang)
+		assert.Equal(t, 30, service.StopTimeout)
+		assert.Equal(t, "8080", server.Port)
+		assert.Equal(t, time.Minute, server.WriteTimeout)
+		assert.Equal(t, []string{"password", "secret", "token", "authorization", "cookie", "email", "dsn"}, logger.RedactKeys)
+	})
+
+	t.Run("the environment and the secret files win over the .env file", func(t *testing.T) {
+		secret := filepath.Join(t.TempDir(), "db_password")
+		require.NoError(t, os.WriteFile(secret, []byte("s3cret\n"), 0600))
+
+		env := map[string]string{"DB_PASSWORD_FILE": secret, "DB_PORT": "6432"}
+		for name, value := range required {
+			env[name] = value
+		}
+
+		v, err := newRegistry(t, "DB_PORT=5433\nDB_PASSWORD=example", env)
+		require.NoError(t, err)
+
+		var database config.Database
+		v.Parse(&database)
+
+		assert.Equal(t, "s3cret", database.Password)
+		assert.Equal(t, "6432", database.Port)
+		assert.Equal(t, "localhost", database.Host)
+	})
+
+	t.Run("all the problems at once", func(t *testing.T) {
+		_, err := newRegistry(t, "APP_ENV=qa\nAPP_STOP_TIMEOUT=soon\nHTTP_TLS=true\nSTORAGE_DRIVER=s3", map[string]string{
+			"DB_PASSWORD_FILE": filepath.Join(t.TempDir(), "missing"),
+		})
+		require.Error(t, err)
+
+		problems := strings.Split(err.Error(), "\n")
+		for _, name := range []string{"DB_PASSWORD_FILE", "APP_STOP_TIMEOUT", "APP_ENV", "DB_HOST", "DB_USERNAME", "DB_DATABASE",
+			"HTTP_TLS_CERT", "HTTP_TLS_KEY", "STORAGE_S3_BUCKET"} {
+			assert.Condition(t, func() bool {
+				for _, problem := range problems {
+					if strings.Contains(problem, name) {
+						return true
+					}
+				}
+
+				return false
+			}, "%s is not listed in:\n%s", name, err)
+		}
+	})
+
+	t.Run("the config covers every config struct", func(t *testing.T) {
+		// the structs of the config package are parsed with the registry, their keys are loaded only when the config reaches them
+		pkgs, err := parser.ParseDir(token.NewFileSet(), filepath.Join("..", "..", "..", "config"), nil, 0)
+		require.NoError(t, err)
+
+		declared := make([]string, 0)
+		for _, pkg := range pkgs {
+			for _, file := range pkg.Files {
+				for _, decl := range file.Decls {
+					gen, ok := decl.(*ast.GenDecl)
+					if !ok || gen.Tok != token.TYPE {
+						continue
+					}
+
+					for _, spec := range gen.Specs {
+						if ts := spec.(*ast.TypeSpec); ts.Name.Name != "Config" {
+							if _, ok = ts.Type.(*ast.StructType); ok {
+								declared = append(declared, ts.Name.Name)
+							}
+						}
+					}
+				}
+			}
+		}
+
+		require.NotEmpty(t, declared)
+
+		covered := make(map[string]bool)
+		for i, conf := 0, reflect.TypeOf(config.Config{}); i < conf.NumField(); i++ {
+			field := conf.Field(i)
+			assert.Equal(t, ",squash", field.Tag.Get("mapstructure"), "%s is not squashed", field.Name)
+			covered[field.Type.Name()] = true
+		}
+
+		for _, name := range declared {
+			assert.True(t, covered[name], "config.%s is not a field of config.Config", name)
+		}
+	})
+}
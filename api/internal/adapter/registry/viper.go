@@ -1,10 +1,12 @@
 package registry
 
 import (
+	"errors"
 	"github.com/spf13/viper"
 	_ "github.com/spf13/viper/remote"
-	src "microservice"
 	"log"
+	src "microservice"
+	"os"
 )
 
 type registry struct {
@@ -21,12 +23,16 @@ func (v *registry) Init() {
 	v.AddConfigPath(src.Root())
 	v.SetConfigName(EnvFormat)
 	v.SetConfigType(EnvMime)
-	v.AutomaticEnv()
 
+	// NOTE: the file is optional, the containers set the keys by the environment
 	err := v.ReadInConfig()
-	if err != nil {
+	if err != nil && !errors.As(err, new(viper.ConfigFileNotFoundError)) {
 		log.Fatal("[registry] init failure: ", err)
 	}
+
+	if err = v.load(os.LookupEnv); err != nil {
+		log.Fatalf("[registry] invalid config:\n%s\n", err)
+	}
 }
 
 func (v *registry) Parse(item interface{}) {
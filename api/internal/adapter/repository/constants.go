@@ -0,0 +1,22 @@
+package repository
+
+import "microservice/internal/core/domain"
+
+const (
+	// createBatchSize rows of every insert statement of the bulk create
+	createBatchSize = 100
+
+	// fullTextSearchQry matches the search vector against the phrase in web-search syntax: "quoted", or, -excluded
+	fullTextSearchQry = "search_vector @@ websearch_to_tsquery(?::regconfig, ?)"
+	// fullTextSearchSelect ranks the matched items and highlights the matched words of the description
+	fullTextSearchSelect = "todos.*, " +
+		"ts_rank(search_vector, websearch_to_tsquery(?::regconfig, ?)) AS rank, " +
+		"ts_headline(search_language, description, websearch_to_tsquery(?::regconfig, ?), ?) AS highlight"
+	// highlightOptions the matched words are selected by the sentinels, the domain escapes the snippet and marks them
+	highlightOptions = "StartSel=" + domain.HighlightStart + ", StopSel=" + domain.HighlightStop +
+		", MaxWords=20, MinWords=5, MaxFragments=2, FragmentDelimiter=\" ... \""
+
+	// fuzzySearchQry trigram fallback to tolerate typos, it uses the `word_similarity_threshold` of PostgreSQL(0.6)
+	fuzzySearchQry    = "? <% description"
+	fuzzySearchSelect = "todos.*, word_similarity(?, description) AS rank"
+)
@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"microservice/internal/adapter/locale"
+	"microservice/internal/adapter/logger"
+	"microservice/internal/adapter/orm"
+	"microservice/internal/adapter/orm/model"
+	"microservice/internal/core/domain"
+	"microservice/internal/core/port"
+	"microservice/internal/server/http/status"
+	"microservice/pkg/meta"
+	"time"
+)
+
+type IdempotencyRepository struct {
+	lgr logger.ILogger
+	l   locale.ILocale
+	db  orm.ISql
+}
+
+func NewIdempotency(l locale.ILocale, lgr logger.ILogger, db orm.ISql) port.IIdempotencyRepository {
+	return &IdempotencyRepository{l: l, lgr: lgr, db: db}
+}
+
+func (ir *IdempotencyRepository) Tx(db orm.ISql) { ir.db = db }
+
+//
+
+// Acquire inserts the key as in-progress, the unique (key, scope) pair serialises the concurrent duplicates:
+// only one of them inserts(or takes over the expired record), the others receive the existing record
+func (ir *IdempotencyRepository) Acquire(ctx context.Context, ent *domain.IdempotencyKey) (existing *domain.IdempotencyKey, acquired bool, err error) {
+	now := time.Now().UTC()
+
+	m := ent.ToDB()
+	m.StatusCode, m.Response, m.Etag, m.Completed = 0, "", "", false
+
+	tx := ir.db.C().WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "idempotency_key"}, {Name: "scope"}},
+		Where: clause.Where{Exprs: []clause.Expression{
+			gorm.Expr("idempotency_keys.expires_at < ? OR (idempotency_keys.completed = ? AND idempotency_keys.locked_until < ?)", now, false, now),
+		}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"request_hash", "status_code", "response", "etag", "completed", "locked_until", "expires_at", "updated_at",
+		}),
+	}).Create(&m)
+
+	if tx.Error != nil {
+		ir.lgr.Error("idempotency.repo.acquire", zap.Error(tx.Error), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	if tx.RowsAffected > 0 {
+		acquired = true
+		return
+	}
+
+	found := model.NewIdempotencyKey()
+	qry := ir.db.C().WithContext(ctx).Model(&model.IdempotencyKeys{})
+	if txErr := qry.First(&found, "idempotency_key = ? AND scope = ?", ent.Key(), ent.Scope()).Error; txErr != nil {
+		// the record is released between the two queries, the caller retries
+		if errors.Is(txErr, gorm.ErrRecordNotFound) {
+			return
+		}
+
+		ir.lgr.Error("idempotency.repo.acquire", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	existing = domain.NewIdempotencyKey().FromDB(found)
+	return
+}
+
+func (ir *IdempotencyRepository) Complete(ctx context.Context, ent *domain.IdempotencyKey) (err error) {
+	m := ent.ToDB()
+	tx := ir.db.C().WithContext(ctx).Model(&model.IdempotencyKeys{}).
+		Where("idempotency_key = ? AND scope = ?", ent.Key(), ent.Scope())
+
+	if txErr := tx.Updates(map[string]any{
+		"status_code": m.StatusCode,
+		"response":    m.Response,
+		"etag":        m.Etag,
+		"completed":   true,
+	}).Error; txErr != nil {
+		ir.lgr.Error("idempotency.repo.complete", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	return
+}
+
+func (ir *IdempotencyRepository) Release(ctx context.Context, ent *domain.IdempotencyKey) (err error) {
+	tx := ir.db.C().WithContext(ctx).
+		Where("idempotency_key = ? AND scope = ? AND completed = ?", ent.Key(), ent.Scope(), false)
+
+	if txErr := tx.Delete(&model.IdempotencyKeys{}).Error; txErr != nil {
+		ir.lgr.Error("idempotency.repo.release", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	return
+}
+
+func (ir *IdempotencyRepository) DeleteExpired(ctx context.Context, before time.Time) (err error) {
+	tx := ir.db.C().WithContext(ctx).Where("expires_at < ?", before.UTC())
+
+	if txErr := tx.Delete(&model.IdempotencyKeys{}).Error; txErr != nil {
+		ir.lgr.Error("idempotency.repo.delete_expired", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	return
+}
@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	gormLogger "gorm.io/gorm/logger"
+	localeMock "microservice/internal/adapter/locale/mocks"
+	loggerMock "microservice/internal/adapter/logger/mocks"
+	ormMock "microservice/internal/adapter/orm/mocks"
+	"microservice/internal/adapter/orm/model"
+	"microservice/internal/core/domain"
+	"testing"
+	"time"
+)
+
+func TestIdempotencyRepository_Acquire(t *testing.T) {
+	t.Run("acquire, complete and expire", func(t *testing.T) {
+		dbConn, dbErr := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+			Logger: gormLogger.Default.LogMode(gormLogger.Silent),
+		})
+
+		if dbErr != nil {
+			t.Fatalf("failed to open in-memory db: %v", dbErr)
+		}
+
+		if dbErr = dbConn.AutoMigrate(&model.IdempotencyKeys{}); dbErr != nil {
+			t.Fatalf("failed to auto-migrate: %v", dbErr)
+		}
+
+		t.Cleanup(func() {
+			sql, err := dbConn.DB()
+			if err != nil {
+				t.Logf("cleanup error: %v", err)
+				return
+			}
+
+			if err = sql.Close(); err != nil {
+				t.Log("sql conn close failure: ", err)
+			}
+		})
+
+		//
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		db := ormMock.NewMockISql(ctrl)
+
+		ctx := context.Background()
+		now := time.Now()
+
+		db.EXPECT().C().Return(dbConn).AnyTimes()
+
+		repo := NewIdempotency(locale, logger, db)
+
+		ent := domain.NewIdempotencyKey()
+		ent.SetKey("retry-key")
+		ent.SetScope("user-1 POST /api/v1/todo/create")
+		ent.SetRequestHash("hash")
+		ent.SetLockedUntil(now.Add(time.Minute))
+		ent.SetExpiresAt(now.Add(time.Hour))
+
+		// the first request takes the key
+		existing, acquired, err := repo.Acquire(ctx, ent)
+		assert.Nil(t, err)
+		assert.True(t, acquired)
+		assert.Nil(t, existing)
+
+		// the duplicate receives the in-progress record
+		existing, acquired, err = repo.Acquire(ctx, ent)
+		assert.Nil(t, err)
+		assert.False(t, acquired)
+		assert.False(t, existing.Completed())
+
+		ent.SetStatusCode(201)
+		ent.SetResponse([]byte(`{"status":201}`))
+		ent.SetETag(`"1"`)
+		assert.Nil(t, repo.Complete(ctx, ent))
+
+		// the retry receives the stored response
+		existing, acquired, err = repo.Acquire(ctx, ent)
+		assert.Nil(t, err)
+		assert.False(t, acquired)
+		assert.True(t, existing.Completed())
+		assert.Equal(t, 201, existing.StatusCode())
+		assert.Equal(t, `{"status":201}`, string(existing.Response()))
+		assert.Equal(t, `"1"`, existing.ETag())
+
+		// the expired record is taken over
+		dbConn.Model(&model.IdempotencyKeys{}).Where("idempotency_key = ?", ent.Key()).
+			Update("expires_at", now.Add(-time.Minute).UTC())
+
+		existing, acquired, err = repo.Acquire(ctx, ent)
+		assert.Nil(t, err)
+		assert.True(t, acquired)
+		assert.Nil(t, existing)
+	})
+}
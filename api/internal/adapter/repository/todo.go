@@ -16,6 +16,7 @@ import (
 	"microservice/internal/core/port"
 	"microservice/internal/server/http/status"
 	"microservice/pkg/meta"
+	"time"
 )
 
 type TodoRepository struct {
@@ -36,15 +37,21 @@ func (tr *TodoRepository) Create(ctx context.Context, ent *domain.Todo) (res *do
 	tx := tr.db.C().WithContext(ctx).Model(model.Todos{})
 
 	m := ent.ToDB()
+	if rankErr := rankAtEnd(tx, m, make(map[string]string)); rankErr != nil {
+		tr.lgr.Error("todo.repo.create.rank", zap.Error(rankErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
 	if txErr := tx.Omit("uuid", "deleted_at").Clauses(clause.Returning{}).Create(&m).Error; txErr != nil {
-		tr.lgr.Error("todo.repo.create", zap.Error(txErr))
+		tr.lgr.Error("todo.repo.create", zap.Error(txErr), logger.Context(ctx))
 
 		if errors.Is(txErr, gorm.ErrDuplicatedKey) {
 			err = meta.ServiceErr(status.ItemExist)
 			return
 		}
 
-		err = meta.ServiceErr(status.Failed, txErr)
+		err = meta.InternalErr(status.Failed, txErr)
 		return
 	}
 
@@ -52,13 +59,55 @@ func (tr *TodoRepository) Create(ctx context.Context, ent *domain.Todo) (res *do
 	return
 }
 
+// CreateInBatches inserts the items with multi-row statements of createBatchSize rows
+func (tr *TodoRepository) CreateInBatches(ctx context.Context, ents []*domain.Todo) (res []*domain.Todo, err error) {
+	tx := tr.db.C().WithContext(ctx).Model(model.Todos{})
+
+	// NOTE: the uuids are generated here, as the batches can not return the database defaults into the sub-slices
+	models := make([]*model.Todos, 0, len(ents))
+	last := make(map[string]string)
+	for _, ent := range ents {
+		m := ent.ToDB()
+		if m.Uuid == uuid.Nil {
+			m.Uuid = uuid.New()
+		}
+
+		if rankErr := rankAtEnd(tx, m, last); rankErr != nil {
+			tr.lgr.Error("todo.repo.create.batch.rank", zap.Error(rankErr), logger.Context(ctx))
+			err = meta.ServiceErr(status.Failed)
+			return
+		}
+
+		models = append(models, m)
+	}
+
+	if txErr := tx.Omit("deleted_at").CreateInBatches(&models, createBatchSize).Error; txErr != nil {
+		tr.lgr.Error("todo.repo.create.batch", zap.Error(txErr), logger.Context(ctx))
+
+		if errors.Is(txErr, gorm.ErrDuplicatedKey) {
+			err = meta.ServiceErr(status.ItemExist)
+			return
+		}
+
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	res = make([]*domain.Todo, 0, len(models))
+	for _, m := range models {
+		res = append(res, domain.NewTodo().FromDB(m))
+	}
+
+	return
+}
+
 func (tr *TodoRepository) GetByUUID(ctx context.Context, id *uuid.UUID) (res *domain.Todo, err error) {
 	m := model.NewTodo()
-	tx := tr.db.C().WithContext(ctx).Model(&model.Todos{})
+	tx := tr.db.C().WithContext(ctx).Model(&model.Todos{}).Scopes(withAssignees)
 
 	tx.First(&m, "uuid = ?", id)
 	if tx.Error != nil {
-		tr.lgr.Error("burrow.repo.detail", zap.Error(tx.Error))
+		tr.lgr.Error("burrow.repo.detail", zap.Error(tx.Error), logger.Context(ctx))
 
 		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
 			err = meta.ServiceErr(status.NotFound)
@@ -78,6 +127,182 @@ func (tr *TodoRepository) GetByUUID(ctx context.Context, id *uuid.UUID) (res *do
 	return
 }
 
+// GetWithDeleted retrieves the item, including the deleted one
+func (tr *TodoRepository) GetWithDeleted(ctx context.Context, id *uuid.UUID) (res *domain.Todo, err error) {
+	m := model.NewTodo()
+	tx := tr.db.C().WithContext(ctx).Unscoped().Model(&model.Todos{})
+
+	if txErr := tx.First(&m, "uuid = ?", id).Error; txErr != nil {
+		if errors.Is(txErr, gorm.ErrRecordNotFound) {
+			err = meta.ServiceErr(status.NotFound)
+			return
+		}
+
+		tr.lgr.Error("todo.repo.detail.deleted", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	res = domain.NewTodo().FromDB(m)
+	return
+}
+
+// Update replaces the fields of the item, the write is rejected when the expected version of the entity is outdated
+func (tr *TodoRepository) Update(ctx context.Context, ent *domain.Todo) (res *domain.Todo, err error) {
+	m := ent.ToDB()
+	changes := map[string]any{
+		"description":   m.Description,
+		"due_date":      m.DueDate,
+		"all_day":       m.AllDay,
+		"due_time_zone": m.DueTimeZone,
+		"notes":         m.Notes,
+		"recurrence":    m.Recurrence,
+		"version":       gorm.Expr("version + 1"),
+	}
+
+	if len(m.SearchLanguage) > 0 {
+		changes["search_language"] = m.SearchLanguage
+	}
+
+	updated := model.NewTodo()
+	tx := tr.db.C().WithContext(ctx).Model(updated).Clauses(clause.Returning{}).Where("uuid = ?", ent.UUID())
+
+	if versions := ent.ExpectedVersions(); len(versions) > 0 {
+		tx.Where("version IN ?", versions)
+	}
+
+	if txErr := tx.Updates(changes).Error; txErr != nil {
+		tr.lgr.Error("todo.repo.update", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	if tx.RowsAffected == 0 {
+		err = tr.writeMissErr(ctx, ent)
+		return
+	}
+
+	res = domain.NewTodo().FromDB(updated)
+	return
+}
+
+// Delete soft-deletes the item, the write is rejected when the expected version of the entity is outdated
+func (tr *TodoRepository) Delete(ctx context.Context, ent *domain.Todo) (err error) {
+	tx := tr.db.C().WithContext(ctx).Where("uuid = ?", ent.UUID())
+
+	if versions := ent.ExpectedVersions(); len(versions) > 0 {
+		tx.Where("version IN ?", versions)
+	}
+
+	if txErr := tx.Delete(&model.Todos{}).Error; txErr != nil {
+		tr.lgr.Error("todo.repo.delete", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	if tx.RowsAffected == 0 {
+		err = tr.writeMissErr(ctx, ent)
+		return
+	}
+
+	return
+}
+
+// GetDeletedBefore the uuids of the items soft-deleted before the time, the oldest first
+func (tr *TodoRepository) GetDeletedBefore(ctx context.Context, before time.Time, limit int) (res []uuid.UUID, err error) {
+	res = make([]uuid.UUID, 0)
+	tx := tr.db.C().WithContext(ctx).Unscoped().Model(&model.Todos{}).
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", before).Order("deleted_at asc").Limit(limit)
+
+	if txErr := tx.Pluck("uuid", &res).Error; txErr != nil {
+		tr.lgr.Error("todo.repo.deleted.before", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	return
+}
+
+// Purge hard-deletes the soft-deleted items with their assignees and comments, the live items are never matched
+func (tr *TodoRepository) Purge(ctx context.Context, ids []uuid.UUID) (err error) {
+	if len(ids) == 0 {
+		return
+	}
+
+	// the assignees and the comments(including the deleted ones) belong to the item, they are removed with it
+	purged := tr.db.C().WithContext(ctx).Unscoped().Model(&model.Todos{}).Select("uuid").
+		Where("uuid IN ? AND deleted_at IS NOT NULL", ids)
+
+	if txErr := tr.db.C().WithContext(ctx).Where("todo_uuid IN (?)", purged).Delete(&model.TodoAssignees{}).Error; txErr != nil {
+		tr.lgr.Error("todo.repo.purge.assignees", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	if txErr := tr.db.C().WithContext(ctx).Unscoped().Where("todo_uuid IN (?)", purged).Delete(&model.TodoComments{}).Error; txErr != nil {
+		tr.lgr.Error("todo.repo.purge.comments", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	tx := tr.db.C().WithContext(ctx).Unscoped().Where("uuid IN ? AND deleted_at IS NOT NULL", ids)
+
+	if txErr := tx.Delete(&model.Todos{}).Error; txErr != nil {
+		tr.lgr.Error("todo.repo.purge", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	return
+}
+
+// Complete marks the item as completed, the completion time of the completed items is kept
+func (tr *TodoRepository) Complete(ctx context.Context, ent *domain.Todo) (res *domain.Todo, err error) {
+	changes := map[string]any{
+		"completed_at": gorm.Expr("COALESCE(completed_at, ?)", time.Now().UTC()),
+		"status":       domain.StatusDone,
+		"version":      gorm.Expr("version + 1"),
+	}
+
+	updated := model.NewTodo()
+	tx := tr.db.C().WithContext(ctx).Model(updated).Clauses(clause.Returning{}).Where("uuid = ?", ent.UUID())
+
+	if versions := ent.ExpectedVersions(); len(versions) > 0 {
+		tx.Where("version IN ?", versions)
+	}
+
+	if txErr := tx.Updates(changes).Error; txErr != nil {
+		tr.lgr.Error("todo.repo.complete", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	if tx.RowsAffected == 0 {
+		err = tr.writeMissErr(ctx, ent)
+		return
+	}
+
+	res = domain.NewTodo().FromDB(updated)
+	return
+}
+
+// Transaction runs the fn with a repository bound to a new transaction, the errors of fn are returned as is
+func (tr *TodoRepository) Transaction(ctx context.Context, fn func(repo port.ITodoRepository) error) (err error) {
+	txErr := tr.db.Transaction(ctx, func(tx orm.ISql) error {
+		return fn(&TodoRepository{l: tr.l, lgr: tr.lgr, db: tx})
+	})
+
+	var se *meta.Error
+	if txErr != nil && !errors.As(txErr, &se) {
+		tr.lgr.Error("todo.repo.transaction", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	err = txErr
+	return
+}
+
 func (tr *TodoRepository) GetList(ctx context.Context, qp *domain.TodoListReqQryParam) (res *domain.TodoList, err error) {
 	list := domain.NewTodoList()
 
@@ -90,24 +315,34 @@ func (tr *TodoRepository) GetList(ctx context.Context, qp *domain.TodoListReqQry
 
 	tx := tr.db.C().WithContext(ctx).Model(&model.Todos{})
 
+	tx.Scopes(accessFilter(qp), dueDateFilter(qp), assigneeFilter(qp), statusFilter(qp))
+
 	if len(qp.Search()) > 0 {
-		searchVal := fmt.Sprintf("%%%s%%", qp.Search()) // this returns %search_value%
-		tx.Where("description LIKE ?", searchVal)
+		tx.Where(fullTextSearchQry, qp.Language(), qp.Search())
 	}
 
 	//
 
 	count := tx.Count(&total)
 	if txErr := count.Error; txErr != nil {
-		tr.lgr.Error("todo.repo.list.count.total", zap.Error(txErr))
+		tr.lgr.Error("todo.repo.list.count.total", zap.Error(txErr), logger.Context(ctx))
 		err = meta.ServiceErr(status.Failed)
 		return
 	}
 
-	items := tx.Order(sort).Offset(offset).Limit(qp.Limit()).Find(&models)
+	if len(qp.Search()) > 0 {
+		if total == 0 {
+			return tr.fuzzySearch(ctx, qp)
+		}
+
+		tx.Select(fullTextSearchSelect, qp.Language(), qp.Search(), qp.Language(), qp.Search(), highlightOptions)
+	}
+
+	items := tx.Scopes(withAssignees).Order(sort).Offset(offset).Limit(qp.Limit()).Find(&models)
 
-	if err = items.Error; err != nil {
-		tr.lgr.Error("todo.repo.list", zap.Error(err))
+	if txErr := items.Error; txErr != nil {
+		tr.lgr.Error("todo.repo.list", zap.Error(txErr), logger.Context(ctx))
+		err = meta.InternalErr(status.Failed, txErr)
 		return
 	}
 
@@ -116,3 +351,109 @@ func (tr *TodoRepository) GetList(ctx context.Context, qp *domain.TodoListReqQry
 	res = list
 	return
 }
+
+// fuzzySearch retrieves the items similar to the searched phrase when the full-text search has no result
+func (tr *TodoRepository) fuzzySearch(ctx context.Context, qp *domain.TodoListReqQryParam) (res *domain.TodoList, err error) {
+	list := domain.NewTodoList()
+
+	var (
+		offset = (qp.Page() - 1) * qp.Limit()
+		sort   = fmt.Sprintf("%s %s", qp.Sort(), qp.Order())
+		models []*model.Todos
+		total  int64
+	)
+
+	tx := tr.db.C().WithContext(ctx).Model(&model.Todos{}).Scopes(accessFilter(qp), dueDateFilter(qp), assigneeFilter(qp), statusFilter(qp)).Where(fuzzySearchQry, qp.Search())
+
+	count := tx.Count(&total)
+	if txErr := count.Error; txErr != nil {
+		tr.lgr.Error("todo.repo.list.fuzzy.count.total", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	items := tx.Scopes(withAssignees).Select(fuzzySearchSelect, qp.Search()).Order(sort).Offset(offset).Limit(qp.Limit()).Find(&models)
+
+	if txErr := items.Error; txErr != nil {
+		tr.lgr.Error("todo.repo.list.fuzzy", zap.Error(txErr), logger.Context(ctx))
+		err = meta.InternalErr(status.Failed, txErr)
+		return
+	}
+
+	list.ListFromDB(models)
+	list.SetTotal(total)
+	list.SetFuzzy(true)
+	res = list
+	return
+}
+
+// HELPERS
+
+// writeMissErr distinguishes the missing item from the outdated version when no row is written
+func (tr *TodoRepository) writeMissErr(ctx context.Context, ent *domain.Todo) error {
+	var count int64
+
+	tx := tr.db.C().WithContext(ctx).Model(&model.Todos{}).Where("uuid = ?", ent.UUID()).Count(&count)
+	if tx.Error != nil {
+		tr.lgr.Error("todo.repo.write.miss", zap.Error(tx.Error), logger.Context(ctx))
+		return meta.ServiceErr(status.Failed)
+	}
+
+	if count == 0 {
+		return meta.ServiceErr(status.NotFound)
+	}
+
+	return meta.ServiceErr(status.PreconditionFailed)
+}
+
+// dueDateFilter filters the items by the due date range of the query params
+func dueDateFilter(qp *domain.TodoListReqQryParam) func(*gorm.DB) *gorm.DB {
+	return func(tx *gorm.DB) *gorm.DB {
+		if qp.NoDueDate() {
+			tx = tx.Where("due_date IS NULL")
+		}
+
+		if qp.HasDueDate() {
+			tx = tx.Where("due_date IS NOT NULL")
+		}
+
+		if qp.DueAfter() != nil {
+			tx = tx.Where("due_date >= ?", qp.DueAfter().UTC())
+		}
+
+		if qp.DueBefore() != nil {
+			tx = tx.Where("due_date < ?", qp.DueBefore().UTC())
+		}
+
+		if qp.Overdue() {
+			tx = tx.Where("completed_at IS NULL")
+		}
+
+		return tx
+	}
+}
+
+// accessFilter filters the items readable by the viewer of the query params, or the items shared with the viewer by the others
+func accessFilter(qp *domain.TodoListReqQryParam) func(*gorm.DB) *gorm.DB {
+	return func(tx *gorm.DB) *gorm.DB {
+		viewer := qp.Viewer()
+		if viewer.Anonymous() {
+			if qp.Shared() {
+				return tx.Where("1 = 0") // nothing is shared with the anonymous viewer
+			}
+
+			return tx.Where("owner IS NULL")
+		}
+
+		granted := tx.Session(&gorm.Session{NewDB: true}).Model(&model.TodoShares{}).Select("todo_uuid").Where(
+			"(grantee_type = ? AND grantee = ?) OR (grantee_type = ? AND grantee IN ?)",
+			domain.GranteeUser, viewer.Actor(), domain.GranteeGroup, viewer.Groups(),
+		)
+
+		if qp.Shared() {
+			return tx.Where("uuid IN (?) AND owner IS NOT NULL AND owner <> ?", granted, viewer.Actor())
+		}
+
+		return tx.Where("owner IS NULL OR owner = ? OR uuid IN (?)", viewer.Actor(), granted)
+	}
+}
@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"microservice/internal/adapter/logger"
+	"microservice/internal/adapter/orm/model"
+	"microservice/internal/core/domain"
+	"microservice/internal/server/http/status"
+	"microservice/pkg/meta"
+	"time"
+)
+
+// Assign replaces the assignees of the item, the kept assignees keep their assignment
+func (tr *TodoRepository) Assign(ctx context.Context, id *uuid.UUID, assignees []string, assignedBy *string) (err error) {
+	// the assignees are a part of the item, so its version is bumped like by the other writes
+	bump := tr.db.C().WithContext(ctx).Model(&model.Todos{}).Where("uuid = ?", id)
+	if txErr := bump.Update("version", gorm.Expr("version + 1")).Error; txErr != nil {
+		tr.lgr.Error("todo.repo.assign.version", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	tx := tr.db.C().WithContext(ctx).Where("todo_uuid = ?", id)
+	if len(assignees) > 0 {
+		tx = tx.Where("assignee NOT IN ?", assignees)
+	}
+
+	if txErr := tx.Delete(&model.TodoAssignees{}).Error; txErr != nil {
+		tr.lgr.Error("todo.repo.assign.delete", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	if len(assignees) == 0 {
+		return
+	}
+
+	models := make([]*model.TodoAssignees, 0, len(assignees))
+	for _, assignee := range assignees {
+		models = append(models, &model.TodoAssignees{TodoUuid: *id, Assignee: assignee, AssignedBy: assignedBy})
+	}
+
+	insert := tr.db.C().WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true})
+	if txErr := insert.Create(&models).Error; txErr != nil {
+		tr.lgr.Error("todo.repo.assign.create", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	return
+}
+
+// Workload counts the open items readable by the viewer of the query params per assignee, the unassigned items are counted without assignee
+func (tr *TodoRepository) Workload(ctx context.Context, qp *domain.TodoListReqQryParam, now time.Time) (res []*domain.TodoWorkload, err error) {
+	var models []*model.TodoWorkloads
+
+	tx := tr.db.C().WithContext(ctx).Model(&model.Todos{}).
+		Select("todo_assignees.assignee AS assignee, COUNT(*) AS open, "+
+			"COALESCE(SUM(CASE WHEN todos.due_date < ? THEN 1 ELSE 0 END), 0) AS overdue", now.UTC()).
+		Joins("LEFT JOIN todo_assignees ON todo_assignees.todo_uuid = todos.uuid").
+		Scopes(accessFilter(qp)).
+		Where("todos.completed_at IS NULL").
+		Group("todo_assignees.assignee").
+		Order("open desc, assignee IS NULL, assignee asc") // the unassigned items follow the assignees of the same count
+
+	if txErr := tx.Scan(&models).Error; txErr != nil {
+		tr.lgr.Error("todo.repo.workload", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	res = domain.WorkloadsFromDB(models)
+	return
+}
+
+func (tr *TodoRepository) Stats(ctx context.Context, now time.Time) (res *domain.TodoWorkload, err error) {
+	m := new(model.TodoWorkloads)
+
+	tx := tr.db.C().WithContext(ctx).Model(&model.Todos{}).
+		Select("COUNT(*) AS open, COALESCE(SUM(CASE WHEN due_date < ? THEN 1 ELSE 0 END), 0) AS overdue", now.UTC()).
+		Where("completed_at IS NULL")
+
+	if txErr := tx.Scan(m).Error; txErr != nil {
+		tr.lgr.Error("todo.repo.stats", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	res = domain.NewTodoWorkload().FromDB(m)
+	return
+}
+
+// HELPERS
+
+// withAssignees preloads the assignees of the items in the order of the assignment
+func withAssignees(tx *gorm.DB) *gorm.DB {
+	return tx.Preload("Assignees", func(tx *gorm.DB) *gorm.DB { return tx.Order("id asc") })
+}
+
+// assigneeFilter filters the items assigned to the assignee of the query params, or the unassigned items
+func assigneeFilter(qp *domain.TodoListReqQryParam) func(*gorm.DB) *gorm.DB {
+	return func(tx *gorm.DB) *gorm.DB {
+		assigned := tx.Session(&gorm.Session{NewDB: true}).Model(&model.TodoAssignees{}).Select("todo_uuid")
+
+		if qp.Assignee() != nil {
+			tx = tx.Where("uuid IN (?)", assigned.Where("assignee = ?", *qp.Assignee()))
+		}
+
+		if qp.Unassigned() {
+			tx = tx.Where("uuid NOT IN (?)", assigned)
+		}
+
+		return tx
+	}
+}
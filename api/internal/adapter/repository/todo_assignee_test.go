@@ -0,0 +1,199 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	gormLogger "gorm.io/gorm/logger"
+	localeMock "microservice/internal/adapter/locale/mocks"
+	loggerMock "microservice/internal/adapter/logger/mocks"
+	ormMock "microservice/internal/adapter/orm/mocks"
+	"microservice/internal/adapter/orm/model"
+	"microservice/internal/core/domain"
+	"testing"
+	"time"
+)
+
+func TestTodoRepository_Assignees(t *testing.T) {
+	yesterday := time.Now().UTC().Add(-24 * time.Hour)
+	lead := "7"
+	firstID, secondID, thirdID, doneID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	seed := func(t *testing.T) *gorm.DB {
+		dbConn, dbErr := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+			Logger: gormLogger.Default.LogMode(gormLogger.Silent),
+		})
+
+		if dbErr != nil {
+			t.Fatalf("failed to open in-memory db: %v", dbErr)
+		}
+
+		if dbErr = dbConn.AutoMigrate(&model.Todos{}, &model.TodoAssignees{}); dbErr != nil {
+			t.Fatalf("failed to auto-migrate: %v", dbErr)
+		}
+
+		t.Cleanup(func() {
+			sql, err := dbConn.DB()
+			if err != nil {
+				t.Logf("cleanup error: %v", err)
+				return
+			}
+
+			if err = sql.Close(); err != nil {
+				t.Log("sql conn close failure: ", err)
+			}
+		})
+
+		items := []*model.Todos{
+			{BaseSql: model.BaseSql{Uuid: firstID}, Description: "overdue item", DueDate: &yesterday},
+			{BaseSql: model.BaseSql{Uuid: secondID}, Description: "someday item"},
+			{BaseSql: model.BaseSql{Uuid: thirdID}, Description: "unassigned item"},
+			{BaseSql: model.BaseSql{Uuid: doneID}, Description: "completed item", CompletedAt: &yesterday},
+		}
+
+		if dbErr = dbConn.Create(&items).Error; dbErr != nil {
+			t.Fatalf("failed to seed: %v", dbErr)
+		}
+
+		return dbConn
+	}
+
+	t.Run("the assignees are replaced", func(t *testing.T) {
+		dbConn := seed(t)
+
+		//
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		db := ormMock.NewMockISql(ctrl)
+
+		ctx := context.Background()
+
+		db.EXPECT().C().Return(dbConn).AnyTimes()
+
+		repo := NewTodo(locale, logger, db)
+
+		seeded, err := repo.GetByUUID(ctx, &firstID)
+		assert.Nil(t, err)
+
+		assert.Nil(t, repo.Assign(ctx, &firstID, []string{"42", "43"}, &lead))
+		assert.Nil(t, repo.Assign(ctx, &firstID, []string{"43", "44"}, &lead))
+
+		res, err := repo.GetByUUID(ctx, &firstID)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"43", "44"}, res.Assignees())
+		assert.Equal(t, seeded.Version()+2, res.Version()) // the assignees are a part of the ETag of the item
+
+		assert.Nil(t, repo.Assign(ctx, &firstID, nil, &lead))
+
+		res, err = repo.GetByUUID(ctx, &firstID)
+		assert.Nil(t, err)
+		assert.Empty(t, res.Assignees())
+	})
+
+	t.Run("the list of the assignee and the unassigned items", func(t *testing.T) {
+		dbConn := seed(t)
+
+		//
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		db := ormMock.NewMockISql(ctrl)
+
+		ctx := context.Background()
+
+		db.EXPECT().C().Return(dbConn).AnyTimes()
+
+		repo := NewTodo(locale, logger, db)
+
+		assert.Nil(t, repo.Assign(ctx, &firstID, []string{"42"}, &lead))
+		assert.Nil(t, repo.Assign(ctx, &secondID, []string{"42", "43"}, &lead))
+
+		assignee := "43"
+		qp := domain.NewTodoListReqQryParam()
+		qp.SetAssignee(&assignee)
+
+		res, err := repo.GetList(ctx, qp)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), res.Total())
+		assert.Equal(t, []string{"42", "43"}, res.List()[0].Assignees())
+
+		qp = domain.NewTodoListReqQryParam()
+		qp.SetUnassigned(true)
+
+		res, err = repo.GetList(ctx, qp)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2), res.Total())
+	})
+
+	t.Run("the workload of the open items", func(t *testing.T) {
+		dbConn := seed(t)
+
+		//
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		db := ormMock.NewMockISql(ctrl)
+
+		ctx := context.Background()
+
+		db.EXPECT().C().Return(dbConn).AnyTimes()
+
+		repo := NewTodo(locale, logger, db)
+
+		assert.Nil(t, repo.Assign(ctx, &firstID, []string{"42"}, &lead))
+		assert.Nil(t, repo.Assign(ctx, &secondID, []string{"42", "43"}, &lead))
+		assert.Nil(t, repo.Assign(ctx, &doneID, []string{"43"}, &lead))
+
+		res, err := repo.Workload(ctx, domain.NewTodoListReqQryParam(), time.Now())
+		assert.Nil(t, err)
+		assert.Len(t, res, 3)
+
+		assert.Equal(t, "42", *res[0].Assignee())
+		assert.Equal(t, int64(2), res[0].Open())
+		assert.Equal(t, int64(1), res[0].Overdue())
+
+		assert.Equal(t, "43", *res[1].Assignee())
+		assert.Equal(t, int64(1), res[1].Open())
+		assert.Equal(t, int64(0), res[1].Overdue())
+
+		assert.Nil(t, res[2].Assignee())
+		assert.Equal(t, int64(1), res[2].Open())
+	})
+
+	t.Run("the stats of all the items", func(t *testing.T) {
+		dbConn := seed(t)
+
+		//
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		db := ormMock.NewMockISql(ctrl)
+
+		ctx := context.Background()
+
+		db.EXPECT().C().Return(dbConn).AnyTimes()
+
+		repo := NewTodo(locale, logger, db)
+
+		res, err := repo.Stats(ctx, time.Now())
+		assert.Nil(t, err)
+		assert.Equal(t, int64(3), res.Open())
+		assert.Equal(t, int64(1), res.Overdue())
+	})
+}
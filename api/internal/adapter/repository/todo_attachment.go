@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"microservice/internal/adapter/locale"
+	"microservice/internal/adapter/logger"
+	"microservice/internal/adapter/orm"
+	"microservice/internal/adapter/orm/model"
+	"microservice/internal/core/domain"
+	"microservice/internal/core/port"
+	"microservice/internal/server/http/status"
+	"microservice/pkg/meta"
+)
+
+type TodoAttachmentRepository struct {
+	lgr logger.ILogger
+	l   locale.ILocale
+	db  orm.ISql
+}
+
+func NewTodoAttachment(l locale.ILocale, lgr logger.ILogger, db orm.ISql) port.ITodoAttachmentRepository {
+	return &TodoAttachmentRepository{l: l, lgr: lgr, db: db}
+}
+
+func (ar *TodoAttachmentRepository) Tx(db orm.ISql) { ar.db = db }
+
+//
+
+func (ar *TodoAttachmentRepository) Create(ctx context.Context, ent *domain.TodoAttachment) (res *domain.TodoAttachment, err error) {
+	tx := ar.db.C().WithContext(ctx).Model(model.TodoAttachments{})
+
+	m := ent.ToDB()
+	if txErr := tx.Create(&m).Error; txErr != nil {
+		ar.lgr.Error("todo.attachment.repo.create", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	res = domain.NewTodoAttachment().FromDB(m)
+	return
+}
+
+func (ar *TodoAttachmentRepository) GetByUUID(ctx context.Context, todoID, id *uuid.UUID) (res *domain.TodoAttachment, err error) {
+	m := model.NewTodoAttachment()
+	tx := ar.db.C().WithContext(ctx).Model(&model.TodoAttachments{})
+
+	if txErr := tx.First(&m, "uuid = ? AND todo_uuid = ?", id, todoID).Error; txErr != nil {
+		if errors.Is(txErr, gorm.ErrRecordNotFound) {
+			err = meta.ServiceErr(status.NotFound)
+			return
+		}
+
+		ar.lgr.Error("todo.attachment.repo.detail", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	res = domain.NewTodoAttachment().FromDB(m)
+	return
+}
+
+func (ar *TodoAttachmentRepository) GetList(ctx context.Context, todoID *uuid.UUID) (res []*domain.TodoAttachment, err error) {
+	var models []*model.TodoAttachments
+	tx := ar.db.C().WithContext(ctx).Model(&model.TodoAttachments{})
+
+	if txErr := tx.Where("todo_uuid = ?", todoID).Order("id asc").Find(&models).Error; txErr != nil {
+		ar.lgr.Error("todo.attachment.repo.list", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	res = domain.AttachmentsFromDB(models)
+	return
+}
+
+func (ar *TodoAttachmentRepository) Delete(ctx context.Context, ent *domain.TodoAttachment) (err error) {
+	tx := ar.db.C().WithContext(ctx).Where("uuid = ? AND todo_uuid = ?", ent.UUID(), ent.TodoUUID())
+
+	if txErr := tx.Delete(&model.TodoAttachments{}).Error; txErr != nil {
+		ar.lgr.Error("todo.attachment.repo.delete", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	if tx.RowsAffected == 0 {
+		err = meta.ServiceErr(status.NotFound)
+		return
+	}
+
+	return
+}
+
+func (ar *TodoAttachmentRepository) DeleteByTodos(ctx context.Context, todoIDs []uuid.UUID) (res []*domain.TodoAttachment, err error) {
+	if len(todoIDs) == 0 {
+		return
+	}
+
+	var models []*model.TodoAttachments
+	if txErr := ar.db.C().WithContext(ctx).Where("todo_uuid IN ?", todoIDs).Find(&models).Error; txErr != nil {
+		ar.lgr.Error("todo.attachment.repo.delete.todos.list", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	if len(models) == 0 {
+		return
+	}
+
+	ids := make([]uint, 0, len(models))
+	for _, m := range models {
+		ids = append(ids, m.ID)
+	}
+
+	if txErr := ar.db.C().WithContext(ctx).Where("id IN ?", ids).Delete(&model.TodoAttachments{}).Error; txErr != nil {
+		ar.lgr.Error("todo.attachment.repo.delete.todos", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	res = domain.AttachmentsFromDB(models)
+	return
+}
+
+func (ar *TodoAttachmentRepository) ReferencedKeys(ctx context.Context, keys []string) (res []string, err error) {
+	res = make([]string, 0)
+	if len(keys) == 0 {
+		return
+	}
+
+	tx := ar.db.C().WithContext(ctx).Model(&model.TodoAttachments{})
+	if txErr := tx.Where("storage_key IN ?", keys).Pluck("storage_key", &res).Error; txErr != nil {
+		ar.lgr.Error("todo.attachment.repo.keys", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	return
+}
@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	gormLogger "gorm.io/gorm/logger"
+	localeMock "microservice/internal/adapter/locale/mocks"
+	loggerMock "microservice/internal/adapter/logger/mocks"
+	ormMock "microservice/internal/adapter/orm/mocks"
+	"microservice/internal/adapter/orm/model"
+	"microservice/internal/core/domain"
+	"microservice/internal/server/http/status"
+	"microservice/pkg/meta"
+	"testing"
+	"time"
+)
+
+func TestTodoAttachmentRepository_Purge(t *testing.T) {
+	description := "attached mock item"
+
+	t.Run("the attachments of the purged items", func(t *testing.T) {
+		dbConn, dbErr := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+			Logger: gormLogger.Default.LogMode(gormLogger.Silent),
+		})
+
+		if dbErr != nil {
+			t.Fatalf("failed to open in-memory db: %v", dbErr)
+		}
+
+		if dbErr = dbConn.AutoMigrate(&model.Todos{}, &model.TodoAssignees{}, &model.TodoAttachments{}, &model.TodoComments{}); dbErr != nil {
+			t.Fatalf("failed to auto-migrate: %v", dbErr)
+		}
+
+		t.Cleanup(func() {
+			sql, err := dbConn.DB()
+			if err != nil {
+				t.Logf("cleanup error: %v", err)
+				return
+			}
+
+			if err = sql.Close(); err != nil {
+				t.Log("sql conn close failure: ", err)
+			}
+		})
+
+		//
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		db := ormMock.NewMockISql(ctrl)
+
+		ctx := context.Background()
+
+		db.EXPECT().C().Return(dbConn).AnyTimes()
+
+		repo := NewTodoAttachment(locale, logger, db)
+		todoRepo := NewTodo(locale, logger, db)
+
+		deleted, kept := domain.NewTodo(), domain.NewTodo()
+		deleted.SetDescription(&description)
+		kept.SetDescription(&description)
+
+		items, err := todoRepo.CreateInBatches(ctx, []*domain.Todo{deleted, kept})
+		assert.Nil(t, err)
+
+		deletedID, keptID := items[0].UUID(), items[1].UUID()
+
+		attachments := make([]*domain.TodoAttachment, 0)
+		for _, todoID := range []uuid.UUID{deletedID, keptID} {
+			id := uuid.New()
+
+			ent := domain.NewTodoAttachment()
+			ent.SetUUID(&id)
+			ent.SetTodoUUID(todoID)
+			ent.SetFileName("outline.pdf")
+			ent.SetStorageKey(domain.AttachmentStorageKey(todoID, id))
+
+			created, err := repo.Create(ctx, ent)
+			assert.Nil(t, err)
+
+			attachments = append(attachments, created)
+
+			comment := &model.TodoComments{TodoUuid: todoID, Body: "mock comment"}
+			comment.Uuid = uuid.New()
+			assert.Nil(t, dbConn.Create(comment).Error)
+		}
+
+		list, err := repo.GetList(ctx, &deletedID)
+		assert.Nil(t, err)
+		assert.Len(t, list, 1)
+		assert.Equal(t, "outline.pdf", list[0].FileName())
+
+		// only the soft-deleted items are listed for the purge
+		assert.Nil(t, todoRepo.Delete(ctx, items[0]))
+
+		ids, err := todoRepo.GetDeletedBefore(ctx, time.Now().Add(time.Minute), 10)
+		assert.Nil(t, err)
+		assert.Equal(t, []uuid.UUID{deletedID}, ids)
+
+		ids, err = todoRepo.GetDeletedBefore(ctx, time.Now().Add(-time.Minute), 10)
+		assert.Nil(t, err)
+		assert.Empty(t, ids)
+
+		removed, err := repo.DeleteByTodos(ctx, []uuid.UUID{deletedID})
+		assert.Nil(t, err)
+		assert.Len(t, removed, 1)
+		assert.Equal(t, attachments[0].StorageKey(), removed[0].StorageKey())
+
+		referenced, err := repo.ReferencedKeys(ctx, []string{attachments[0].StorageKey(), attachments[1].StorageKey()})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{attachments[1].StorageKey()}, referenced)
+
+		// the live items are never purged
+		assert.Nil(t, todoRepo.Purge(ctx, []uuid.UUID{deletedID, keptID}))
+
+		var total int64
+		assert.Nil(t, dbConn.Unscoped().Model(&model.Todos{}).Count(&total).Error)
+		assert.Equal(t, int64(1), total)
+
+		// the comments of the purged items are removed in the same batch
+		var comments []uuid.UUID
+		assert.Nil(t, dbConn.Unscoped().Model(&model.TodoComments{}).Pluck("todo_uuid", &comments).Error)
+		assert.Equal(t, []uuid.UUID{keptID}, comments)
+
+		keptAttachmentID := attachments[1].UUID()
+		_, err = repo.GetByUUID(ctx, &deletedID, &keptAttachmentID)
+		assert.Equal(t, status.NotFound, err.(*meta.Error).Msg)
+	})
+}
@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"microservice/internal/adapter/logger"
+	"microservice/internal/adapter/orm"
+	"microservice/internal/adapter/orm/model"
+	"microservice/internal/core/domain"
+	"microservice/internal/server/http/status"
+	"microservice/pkg/fracindex"
+	"microservice/pkg/meta"
+	"time"
+)
+
+// Move writes the column and the rank of the item, the item of the done column is completed and the others are reopened.
+// the write is rejected when the expected version of the entity is outdated
+func (tr *TodoRepository) Move(ctx context.Context, ent *domain.Todo) (res *domain.Todo, err error) {
+	changes := map[string]any{
+		"status":       ent.Status(),
+		"board_rank":   ent.BoardRank(),
+		"completed_at": nil,
+		"version":      gorm.Expr("version + 1"),
+	}
+
+	if ent.Status() == domain.StatusDone {
+		changes["completed_at"] = gorm.Expr("COALESCE(completed_at, ?)", time.Now().UTC())
+	}
+
+	updated := model.NewTodo()
+	tx := tr.db.C().WithContext(ctx).Model(updated).Clauses(clause.Returning{}).Where("uuid = ?", ent.UUID())
+
+	if versions := ent.ExpectedVersions(); len(versions) > 0 {
+		tx.Where("version IN ?", versions)
+	}
+
+	if txErr := tx.Updates(changes).Error; txErr != nil {
+		tr.lgr.Error("todo.repo.move", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	if tx.RowsAffected == 0 {
+		err = tr.writeMissErr(ctx, ent)
+		return
+	}
+
+	res = domain.NewTodo().FromDB(updated)
+	return
+}
+
+// NeighbourRank the closest rank of the column after(next) or before the rank, empty when there is none.
+// the empty rank is the end of the column, so its previous rank is the last rank of the column
+func (tr *TodoRepository) NeighbourRank(ctx context.Context, column, rank string, next bool) (res string, err error) {
+	neighbour, txErr := neighbourRank(tr.db.C().WithContext(ctx), column, rank, next)
+	if txErr != nil {
+		tr.lgr.Error("todo.repo.rank.neighbour", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	res = neighbour
+	return
+}
+
+// Rebalance spreads the ranks of the column evenly when a rank is longer than the max length or missing.
+// the versions are bumped, as the rank is a part of the item and its ETag must change
+func (tr *TodoRepository) Rebalance(ctx context.Context, column string, maxLength int) (rebalanced int, err error) {
+	var stale int64
+
+	tx := tr.db.C().WithContext(ctx).Model(&model.Todos{}).
+		Where("status = ? AND (board_rank IS NULL OR LENGTH(board_rank) > ?)", column, maxLength)
+
+	if txErr := tx.Count(&stale).Error; txErr != nil {
+		tr.lgr.Error("todo.repo.rebalance.count", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	if stale == 0 {
+		return
+	}
+
+	var ids []uint
+	order := tr.db.C().WithContext(ctx).Model(&model.Todos{}).Where("status = ?", column).
+		Clauses(clause.Locking{Strength: orm.DbLockUpdate}).Order("board_rank IS NULL, board_rank asc, id asc")
+
+	if txErr := order.Pluck("id", &ids).Error; txErr != nil {
+		tr.lgr.Error("todo.repo.rebalance.list", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	for i, rank := range fracindex.Spread(len(ids)) {
+		update := tr.db.C().WithContext(ctx).Model(&model.Todos{}).Where("id = ?", ids[i])
+		changes := map[string]any{"board_rank": rank, "version": gorm.Expr("version + 1")}
+		if txErr := update.UpdateColumns(changes).Error; txErr != nil {
+			tr.lgr.Error("todo.repo.rebalance.update", zap.Error(txErr), logger.Context(ctx))
+			err = meta.ServiceErr(status.Failed)
+			return
+		}
+	}
+
+	rebalanced = len(ids)
+	return
+}
+
+// HELPERS
+
+// rankAtEnd ranks the model at the end of its column when it has no rank, the last ranks of the columns are cached in last.
+// NOTE: the concurrent creates may get the same rank, the ties are ordered by the id and split by the next move
+func rankAtEnd(tx *gorm.DB, m *model.Todos, last map[string]string) error {
+	if m.BoardRank != nil {
+		return nil
+	}
+
+	prev, ok := last[m.Status]
+	if !ok {
+		var err error
+		if prev, err = neighbourRank(tx.Session(&gorm.Session{NewDB: true}), m.Status, "", false); err != nil {
+			return err
+		}
+	}
+
+	rank, err := fracindex.Between(prev, "")
+	if err != nil {
+		return err
+	}
+
+	last[m.Status] = rank
+	m.BoardRank = &rank
+	return nil
+}
+
+// statusFilter filters the items of the column
+func statusFilter(qp *domain.TodoListReqQryParam) func(*gorm.DB) *gorm.DB {
+	return func(tx *gorm.DB) *gorm.DB {
+		if qp.Status() != nil {
+			tx = tx.Where("status = ?", *qp.Status())
+		}
+
+		return tx
+	}
+}
+
+func neighbourRank(tx *gorm.DB, column, rank string, next bool) (res string, err error) {
+	var ranks []string
+
+	tx = tx.Model(&model.Todos{}).Where("status = ? AND board_rank IS NOT NULL", column)
+
+	switch {
+	case next:
+		tx = tx.Where("board_rank > ?", rank).Order("board_rank asc")
+	case len(rank) > 0:
+		tx = tx.Where("board_rank < ?", rank).Order("board_rank desc")
+	default:
+		tx = tx.Order("board_rank desc")
+	}
+
+	if err = tx.Limit(1).Pluck("board_rank", &ranks).Error; err != nil {
+		return
+	}
+
+	if len(ranks) > 0 {
+		res = ranks[0]
+	}
+
+	return
+}
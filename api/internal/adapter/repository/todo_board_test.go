@@ -0,0 +1,175 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	gormLogger "gorm.io/gorm/logger"
+	localeMock "microservice/internal/adapter/locale/mocks"
+	loggerMock "microservice/internal/adapter/logger/mocks"
+	ormMock "microservice/internal/adapter/orm/mocks"
+	"microservice/internal/adapter/orm/model"
+	"microservice/internal/core/domain"
+	"microservice/internal/core/port"
+	"strings"
+	"testing"
+)
+
+func TestTodoRepository_Board(t *testing.T) {
+	firstID, secondID, thirdID := uuid.New(), uuid.New(), uuid.New()
+
+	seed := func(t *testing.T, ranks ...string) *gorm.DB {
+		dbConn, dbErr := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+			Logger: gormLogger.Default.LogMode(gormLogger.Silent),
+		})
+
+		if dbErr != nil {
+			t.Fatalf("failed to open in-memory db: %v", dbErr)
+		}
+
+		if dbErr = dbConn.AutoMigrate(&model.Todos{}, &model.TodoAssignees{}); dbErr != nil {
+			t.Fatalf("failed to auto-migrate: %v", dbErr)
+		}
+
+		t.Cleanup(func() {
+			sql, err := dbConn.DB()
+			if err != nil {
+				t.Logf("cleanup error: %v", err)
+				return
+			}
+
+			if err = sql.Close(); err != nil {
+				t.Log("sql conn close failure: ", err)
+			}
+		})
+
+		if len(ranks) == 0 {
+			return dbConn
+		}
+
+		items := make([]*model.Todos, 0, len(ranks))
+		for i, id := range []uuid.UUID{firstID, secondID, thirdID}[:len(ranks)] {
+			items = append(items, &model.Todos{
+				BaseSql: model.BaseSql{Uuid: id}, Description: "board item", Status: domain.StatusTodo, BoardRank: &ranks[i],
+			})
+		}
+
+		if dbErr = dbConn.Create(&items).Error; dbErr != nil {
+			t.Fatalf("failed to seed: %v", dbErr)
+		}
+
+		return dbConn
+	}
+
+	newRepo := func(t *testing.T, dbConn *gorm.DB) port.ITodoRepository {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		db := ormMock.NewMockISql(ctrl)
+
+		db.EXPECT().C().Return(dbConn).AnyTimes()
+
+		return NewTodo(locale, logger, db)
+	}
+
+	t.Run("the created items are ranked at the end of the todo column", func(t *testing.T) {
+		repo := newRepo(t, seed(t))
+		ctx := context.Background()
+
+		var last string
+		for range 3 {
+			description := "created item"
+			ent := domain.NewTodo()
+			ent.SetDescription(&description)
+
+			res, err := repo.Create(ctx, ent)
+
+			assert.NoError(t, err)
+			assert.Equal(t, domain.StatusTodo, res.Status())
+			assert.Greater(t, res.BoardRank(), last)
+			last = res.BoardRank()
+		}
+	})
+
+	t.Run("the item moved to the done column is completed and reopened when moved back", func(t *testing.T) {
+		repo := newRepo(t, seed(t, "V", "k", "t"))
+		ctx := context.Background()
+
+		done, rank := domain.StatusDone, "V"
+		ent := domain.NewTodo()
+		ent.SetUUID(&firstID)
+		ent.SetStatus(&done)
+		ent.SetBoardRank(&rank)
+		ent.SetVersion(1)
+
+		res, err := repo.Move(ctx, ent)
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.StatusDone, res.Status())
+		assert.NotNil(t, res.CompletedAt())
+		assert.Equal(t, uint(2), res.Version())
+
+		todo := domain.StatusTodo
+		ent.SetStatus(&todo)
+		ent.SetVersion(2)
+
+		res, err = repo.Move(ctx, ent)
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.StatusTodo, res.Status())
+		assert.Nil(t, res.CompletedAt())
+	})
+
+	t.Run("the neighbour ranks of the column", func(t *testing.T) {
+		repo := newRepo(t, seed(t, "V", "k", "t"))
+		ctx := context.Background()
+
+		next, err := repo.NeighbourRank(ctx, domain.StatusTodo, "V", true)
+		assert.NoError(t, err)
+		assert.Equal(t, "k", next)
+
+		prev, err := repo.NeighbourRank(ctx, domain.StatusTodo, "t", false)
+		assert.NoError(t, err)
+		assert.Equal(t, "k", prev)
+
+		last, err := repo.NeighbourRank(ctx, domain.StatusTodo, "", false)
+		assert.NoError(t, err)
+		assert.Equal(t, "t", last)
+
+		none, err := repo.NeighbourRank(ctx, domain.StatusDone, "", false)
+		assert.NoError(t, err)
+		assert.Empty(t, none)
+	})
+
+	t.Run("the long ranks are spread without changing the order", func(t *testing.T) {
+		long := strings.Repeat("V", 40)
+		dbConn := seed(t, long+"1", long+"2", "W")
+		repo := newRepo(t, dbConn)
+		ctx := context.Background()
+
+		rebalanced, err := repo.Rebalance(ctx, domain.StatusTodo, 32)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, rebalanced)
+
+		var items []*model.Todos
+		dbConn.Order("board_rank asc").Find(&items)
+
+		assert.Len(t, items, 3)
+		for i, id := range []uuid.UUID{firstID, secondID, thirdID} {
+			assert.Equal(t, id, items[i].Uuid)
+			assert.LessOrEqual(t, len(*items[i].BoardRank), 32)
+			assert.Equal(t, uint(2), items[i].Version)
+		}
+
+		rebalanced, err = repo.Rebalance(ctx, domain.StatusTodo, 32)
+
+		assert.NoError(t, err)
+		assert.Zero(t, rebalanced)
+	})
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"microservice/internal/adapter/locale"
+	"microservice/internal/adapter/logger"
+	"microservice/internal/adapter/orm"
+	"microservice/internal/adapter/orm/model"
+	"microservice/internal/core/domain"
+	"microservice/internal/core/port"
+	"microservice/internal/server/http/status"
+	"microservice/pkg/meta"
+)
+
+type TodoCalendarRepository struct {
+	lgr logger.ILogger
+	l   locale.ILocale
+	db  orm.ISql
+}
+
+func NewTodoCalendar(l locale.ILocale, lgr logger.ILogger, db orm.ISql) port.ITodoCalendarRepository {
+	return &TodoCalendarRepository{l: l, lgr: lgr, db: db}
+}
+
+func (cr *TodoCalendarRepository) Tx(db orm.ISql) { cr.db = db }
+
+//
+
+func (cr *TodoCalendarRepository) Create(ctx context.Context, ent *domain.TodoCalendarFeed) (res *domain.TodoCalendarFeed, err error) {
+	tx := cr.db.C().WithContext(ctx).Model(model.TodoCalendarFeeds{})
+
+	m := ent.ToDB()
+	if m.Uuid == uuid.Nil {
+		m.Uuid = uuid.New()
+	}
+
+	if txErr := tx.Create(&m).Error; txErr != nil {
+		cr.lgr.Error("todo.calendar.repo.create", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	res = domain.NewTodoCalendarFeed().FromDB(m)
+	return
+}
+
+func (cr *TodoCalendarRepository) GetList(ctx context.Context, owner string) (res []*domain.TodoCalendarFeed, err error) {
+	var models []*model.TodoCalendarFeeds
+	tx := cr.db.C().WithContext(ctx).Model(&model.TodoCalendarFeeds{})
+
+	if txErr := tx.Where("owner = ?", owner).Order("id asc").Find(&models).Error; txErr != nil {
+		cr.lgr.Error("todo.calendar.repo.list", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	res = domain.CalendarFeedsFromDB(models)
+	return
+}
+
+func (cr *TodoCalendarRepository) GetByToken(ctx context.Context, tokenHash string) (res *domain.TodoCalendarFeed, err error) {
+	m := model.NewTodoCalendarFeed()
+	tx := cr.db.C().WithContext(ctx).Model(&model.TodoCalendarFeeds{})
+
+	if txErr := tx.First(&m, "token_hash = ?", tokenHash).Error; txErr != nil {
+		if errors.Is(txErr, gorm.ErrRecordNotFound) {
+			err = meta.ServiceErr(status.NotFound)
+			return
+		}
+
+		cr.lgr.Error("todo.calendar.repo.detail", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	res = domain.NewTodoCalendarFeed().FromDB(m)
+	return
+}
+
+func (cr *TodoCalendarRepository) Delete(ctx context.Context, owner string, id *uuid.UUID) (err error) {
+	tx := cr.db.C().WithContext(ctx).Where("uuid = ? AND owner = ?", id, owner)
+
+	if txErr := tx.Delete(&model.TodoCalendarFeeds{}).Error; txErr != nil {
+		cr.lgr.Error("todo.calendar.repo.delete", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	if tx.RowsAffected == 0 {
+		err = meta.ServiceErr(status.NotFound)
+		return
+	}
+
+	return
+}
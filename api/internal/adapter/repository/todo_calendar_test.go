@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	gormLogger "gorm.io/gorm/logger"
+	localeMock "microservice/internal/adapter/locale/mocks"
+	loggerMock "microservice/internal/adapter/logger/mocks"
+	ormMock "microservice/internal/adapter/orm/mocks"
+	"microservice/internal/adapter/orm/model"
+	"microservice/internal/core/domain"
+	"microservice/internal/server/http/status"
+	"microservice/pkg/meta"
+	"testing"
+	"time"
+)
+
+func TestTodoCalendarRepository(t *testing.T) {
+	owner, other := "7", "42"
+
+	seed := func(t *testing.T) *gorm.DB {
+		dbConn, dbErr := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+			Logger: gormLogger.Default.LogMode(gormLogger.Silent),
+		})
+
+		if dbErr != nil {
+			t.Fatalf("failed to open in-memory db: %v", dbErr)
+		}
+
+		if dbErr = dbConn.AutoMigrate(&model.Todos{}, &model.TodoAssignees{}, &model.TodoShares{}, &model.TodoCalendarFeeds{}); dbErr != nil {
+			t.Fatalf("failed to auto-migrate: %v", dbErr)
+		}
+
+		t.Cleanup(func() {
+			sql, err := dbConn.DB()
+			if err != nil {
+				t.Logf("cleanup error: %v", err)
+				return
+			}
+
+			if err = sql.Close(); err != nil {
+				t.Log("sql conn close failure: ", err)
+			}
+		})
+
+		return dbConn
+	}
+
+	feed := func(owner, token string) *domain.TodoCalendarFeed {
+		ent := domain.NewTodoCalendarFeed()
+		ent.SetOwner(owner)
+		ent.SetComponent(domain.CalendarTodo)
+		ent.SetToken(token)
+		return ent
+	}
+
+	t.Run("the feed of the token", func(t *testing.T) {
+		dbConn := seed(t)
+
+		//
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		db := ormMock.NewMockISql(ctrl)
+
+		ctx := context.Background()
+
+		db.EXPECT().C().Return(dbConn).AnyTimes()
+
+		repo := NewTodoCalendar(locale, logger, db)
+
+		created, err := repo.Create(ctx, feed(owner, "first-token"))
+		assert.Nil(t, err)
+		_, err = repo.Create(ctx, feed(other, "second-token"))
+		assert.Nil(t, err)
+
+		res, err := repo.GetByToken(ctx, domain.HashShareToken("first-token"))
+		assert.Nil(t, err)
+		assert.Equal(t, created.UUID(), res.UUID())
+		assert.Equal(t, owner, res.Owner())
+		assert.Empty(t, res.Token())
+
+		_, err = repo.GetByToken(ctx, domain.HashShareToken("unknown"))
+		assert.Equal(t, status.NotFound, meta.ErrStatus(err))
+
+		list, err := repo.GetList(ctx, owner)
+		assert.Nil(t, err)
+		assert.Len(t, list, 1)
+	})
+
+	t.Run("the feed is deleted by its owner", func(t *testing.T) {
+		dbConn := seed(t)
+
+		//
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		db := ormMock.NewMockISql(ctrl)
+
+		ctx := context.Background()
+
+		db.EXPECT().C().Return(dbConn).AnyTimes()
+
+		repo := NewTodoCalendar(locale, logger, db)
+
+		created, err := repo.Create(ctx, feed(owner, "first-token"))
+		assert.Nil(t, err)
+
+		id := created.UUID()
+		assert.Equal(t, status.NotFound, meta.ErrStatus(repo.Delete(ctx, other, &id)))
+		assert.Nil(t, repo.Delete(ctx, owner, &id))
+
+		_, err = repo.GetByToken(ctx, domain.HashShareToken("first-token"))
+		assert.Equal(t, status.NotFound, meta.ErrStatus(err))
+	})
+
+	t.Run("the items with due date", func(t *testing.T) {
+		dbConn := seed(t)
+
+		due := time.Date(2025, 8, 7, 9, 0, 0, 0, time.UTC)
+		items := []*model.Todos{
+			{BaseSql: model.BaseSql{Uuid: uuid.New()}, Description: "dated item", Owner: &owner, DueDate: &due},
+			{BaseSql: model.BaseSql{Uuid: uuid.New()}, Description: "undated item", Owner: &owner},
+		}
+
+		if dbErr := dbConn.Create(&items).Error; dbErr != nil {
+			t.Fatalf("failed to seed: %v", dbErr)
+		}
+
+		//
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		db := ormMock.NewMockISql(ctrl)
+
+		ctx := context.Background()
+
+		db.EXPECT().C().Return(dbConn).AnyTimes()
+
+		repo := NewTodo(locale, logger, db)
+
+		qp := domain.NewTodoListReqQryParam()
+		qp.SetViewer(domain.NewViewer(owner, nil))
+		qp.SetHasDueDate(true)
+
+		var exported []string
+		err := repo.Export(ctx, qp, func(item *domain.Todo) error {
+			exported = append(exported, *item.Description())
+			return nil
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"dated item"}, exported)
+	})
+}
@@ -0,0 +1,250 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"microservice/internal/adapter/locale"
+	"microservice/internal/adapter/logger"
+	"microservice/internal/adapter/orm"
+	"microservice/internal/adapter/orm/model"
+	"microservice/internal/core/domain"
+	"microservice/internal/core/port"
+	"microservice/internal/server/http/status"
+	"microservice/pkg/meta"
+	"time"
+)
+
+type TodoCommentRepository struct {
+	lgr logger.ILogger
+	l   locale.ILocale
+	db  orm.ISql
+}
+
+func NewTodoComment(l locale.ILocale, lgr logger.ILogger, db orm.ISql) port.ITodoCommentRepository {
+	return &TodoCommentRepository{l: l, lgr: lgr, db: db}
+}
+
+func (cr *TodoCommentRepository) Tx(db orm.ISql) { cr.db = db }
+
+//
+
+func (cr *TodoCommentRepository) Create(ctx context.Context, ent *domain.TodoComment) (res *domain.TodoComment, err error) {
+	tx := cr.db.C().WithContext(ctx).Model(model.TodoComments{})
+
+	// NOTE: the uuid is generated here, so the comment is addressable without reading the database default back
+	m := ent.ToDB()
+	if m.Uuid == uuid.Nil {
+		m.Uuid = uuid.New()
+	}
+
+	if txErr := tx.Omit("deleted_at").Create(&m).Error; txErr != nil {
+		cr.lgr.Error("todo.comment.repo.create", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	res = domain.NewTodoComment().FromDB(m)
+	return
+}
+
+func (cr *TodoCommentRepository) GetByUUID(ctx context.Context, todoID, id *uuid.UUID) (res *domain.TodoComment, err error) {
+	m := model.NewTodoComment()
+	tx := cr.db.C().WithContext(ctx).Model(&model.TodoComments{})
+
+	if txErr := tx.First(&m, "uuid = ? AND todo_uuid = ?", id, todoID).Error; txErr != nil {
+		if errors.Is(txErr, gorm.ErrRecordNotFound) {
+			err = meta.ServiceErr(status.NotFound)
+			return
+		}
+
+		cr.lgr.Error("todo.comment.repo.detail", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	res = domain.NewTodoComment().FromDB(m)
+	return
+}
+
+func (cr *TodoCommentRepository) GetList(ctx context.Context, todoID *uuid.UUID, qp *domain.TodoCommentListReqQryParam) (res *domain.TodoCommentList, err error) {
+	list := domain.NewTodoCommentList()
+
+	var (
+		offset = (qp.Page() - 1) * qp.Limit()
+		sort   = fmt.Sprintf("id %s", qp.Order())
+		models []*model.TodoComments
+		total  int64
+	)
+
+	tx := cr.db.C().WithContext(ctx).Model(&model.TodoComments{}).Where("todo_uuid = ?", todoID)
+
+	if qp.Parent() != nil {
+		tx.Where("parent_uuid = ?", qp.Parent())
+	} else {
+		tx.Where("parent_uuid IS NULL")
+	}
+
+	if txErr := tx.Count(&total).Error; txErr != nil {
+		cr.lgr.Error("todo.comment.repo.list.count.total", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	replies := "(SELECT COUNT(*) FROM todo_comments AS replies " +
+		"WHERE replies.parent_uuid = todo_comments.uuid AND replies.deleted_at IS NULL) AS reply_count"
+
+	if txErr := tx.Select("todo_comments.*", replies).
+		Order(sort).Offset(offset).Limit(qp.Limit()).Find(&models).Error; txErr != nil {
+		cr.lgr.Error("todo.comment.repo.list", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	list.ListFromDB(models)
+	list.SetTotal(total)
+	res = list
+	return
+}
+
+// Update changes the body and the mentions of the comment
+func (cr *TodoCommentRepository) Update(ctx context.Context, ent *domain.TodoComment) (res *domain.TodoComment, err error) {
+	m := ent.ToDB()
+	changes := map[string]any{
+		"body":       m.Body,
+		"mentions":   m.Mentions,
+		"updated_at": time.Now(),
+	}
+
+	updated := model.NewTodoComment()
+	tx := cr.db.C().WithContext(ctx).Model(updated).Clauses(clause.Returning{}).
+		Where("uuid = ? AND todo_uuid = ?", ent.UUID(), ent.TodoUUID())
+
+	if txErr := tx.Updates(changes).Error; txErr != nil {
+		cr.lgr.Error("todo.comment.repo.update", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	if tx.RowsAffected == 0 {
+		err = meta.ServiceErr(status.NotFound)
+		return
+	}
+
+	res = domain.NewTodoComment().FromDB(updated)
+	return
+}
+
+// Delete soft-deletes the comment, the replies of the root comment are deleted with it
+func (cr *TodoCommentRepository) Delete(ctx context.Context, ent *domain.TodoComment) (err error) {
+	tx := cr.db.C().WithContext(ctx).
+		Where("todo_uuid = ? AND (uuid = ? OR parent_uuid = ?)", ent.TodoUUID(), ent.UUID(), ent.UUID())
+
+	if txErr := tx.Delete(&model.TodoComments{}).Error; txErr != nil {
+		cr.lgr.Error("todo.comment.repo.delete", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	if tx.RowsAffected == 0 {
+		err = meta.ServiceErr(status.NotFound)
+		return
+	}
+
+	return
+}
+
+// activityRow an entry of the merged comments and revisions
+type activityRow struct {
+	Kind      string
+	ID        uint
+	CreatedAt time.Time
+}
+
+// GetActivity pages the merged ids of the comments and the revisions, then loads the entries of the page
+func (cr *TodoCommentRepository) GetActivity(ctx context.Context, todoID *uuid.UUID, qp *domain.TodoActivityReqQryParam) (res *domain.TodoActivityList, err error) {
+	list := domain.NewTodoActivityList()
+
+	var (
+		offset = (qp.Page() - 1) * qp.Limit()
+		sort   = fmt.Sprintf("created_at %[1]s, kind %[1]s, id %[1]s", qp.Order())
+		rows   []*activityRow
+		total  int64
+	)
+
+	union := cr.db.C().WithContext(ctx).Raw(fmt.Sprintf(
+		"SELECT '%s' AS kind, id, created_at FROM todo_comments WHERE todo_uuid = ? AND deleted_at IS NULL "+
+			"UNION ALL SELECT '%s' AS kind, id, created_at FROM todo_revisions WHERE todo_uuid = ?",
+		domain.ActivityComment, domain.ActivityChange,
+	), todoID, todoID)
+
+	tx := cr.db.C().WithContext(ctx).Table("(?) AS activities", union)
+
+	if txErr := tx.Count(&total).Error; txErr != nil {
+		cr.lgr.Error("todo.comment.repo.activity.count.total", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	if txErr := tx.Order(sort).Offset(offset).Limit(qp.Limit()).Scan(&rows).Error; txErr != nil {
+		cr.lgr.Error("todo.comment.repo.activity", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	var commentIDs, revisionIDs []uint
+	for _, row := range rows {
+		if row.Kind == domain.ActivityComment {
+			commentIDs = append(commentIDs, row.ID)
+		} else {
+			revisionIDs = append(revisionIDs, row.ID)
+		}
+	}
+
+	comments := make(map[uint]*model.TodoComments)
+	if len(commentIDs) > 0 {
+		var models []*model.TodoComments
+		if txErr := cr.db.C().WithContext(ctx).Where("id IN ?", commentIDs).Find(&models).Error; txErr != nil {
+			cr.lgr.Error("todo.comment.repo.activity.comments", zap.Error(txErr), logger.Context(ctx))
+			err = meta.ServiceErr(status.Failed)
+			return
+		}
+
+		for _, m := range models {
+			comments[m.ID] = m
+		}
+	}
+
+	revisions := make(map[uint]*model.TodoRevisions)
+	if len(revisionIDs) > 0 {
+		var models []*model.TodoRevisions
+		if txErr := cr.db.C().WithContext(ctx).Where("id IN ?", revisionIDs).Find(&models).Error; txErr != nil {
+			cr.lgr.Error("todo.comment.repo.activity.revisions", zap.Error(txErr), logger.Context(ctx))
+			err = meta.ServiceErr(status.Failed)
+			return
+		}
+
+		for _, m := range models {
+			revisions[m.ID] = m
+		}
+	}
+
+	activities := make([]*domain.TodoActivity, 0, len(rows))
+	for _, row := range rows {
+		switch {
+		case row.Kind == domain.ActivityComment && comments[row.ID] != nil:
+			activities = append(activities, domain.NewCommentActivity(domain.NewTodoComment().FromDB(comments[row.ID])))
+		case row.Kind == domain.ActivityChange && revisions[row.ID] != nil:
+			activities = append(activities, domain.NewChangeActivity(new(domain.TodoRevision).FromDB(revisions[row.ID])))
+		}
+	}
+
+	list.SetList(activities)
+	list.SetTotal(total)
+	res = list
+	return
+}
@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	gormLogger "gorm.io/gorm/logger"
+	localeMock "microservice/internal/adapter/locale/mocks"
+	loggerMock "microservice/internal/adapter/logger/mocks"
+	ormMock "microservice/internal/adapter/orm/mocks"
+	"microservice/internal/adapter/orm/model"
+	"microservice/internal/core/domain"
+	"microservice/internal/server/http/status"
+	"microservice/pkg/meta"
+	"testing"
+)
+
+func TestTodoCommentRepository_GetActivity(t *testing.T) {
+	description := "commented mock item"
+
+	t.Run("threads and the merged activity feed", func(t *testing.T) {
+		dbConn, dbErr := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+			Logger: gormLogger.Default.LogMode(gormLogger.Silent),
+		})
+
+		if dbErr != nil {
+			t.Fatalf("failed to open in-memory db: %v", dbErr)
+		}
+
+		if dbErr = dbConn.AutoMigrate(&model.TodoComments{}, &model.TodoRevisions{}); dbErr != nil {
+			t.Fatalf("failed to auto-migrate: %v", dbErr)
+		}
+
+		t.Cleanup(func() {
+			sql, err := dbConn.DB()
+			if err != nil {
+				t.Logf("cleanup error: %v", err)
+				return
+			}
+
+			if err = sql.Close(); err != nil {
+				t.Log("sql conn close failure: ", err)
+			}
+		})
+
+		//
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		db := ormMock.NewMockISql(ctrl)
+
+		ctx := context.Background()
+
+		db.EXPECT().C().Return(dbConn).AnyTimes()
+
+		repo := NewTodoComment(locale, logger, db)
+		todoRepo := NewTodo(locale, logger, db)
+
+		id := uuid.New()
+		item := domain.NewTodo()
+		item.SetUUID(&id)
+		item.SetDescription(&description)
+
+		// change, comment, reply, change in the order of creation
+		assert.Nil(t, todoRepo.CreateRevisions(ctx, domain.NewTodoRevision(domain.RevisionCreate, nil, item)))
+
+		root := domain.NewTodoComment()
+		root.SetTodoUUID(id)
+		root.SetBody("@alice please review")
+		root.SetMentions([]string{"alice"})
+
+		root, err := repo.Create(ctx, root)
+		assert.Nil(t, err)
+
+		rootID := root.UUID()
+		reply := domain.NewTodoComment()
+		reply.SetTodoUUID(id)
+		reply.SetParentUUID(&rootID)
+		reply.SetBody("done")
+
+		reply, err = repo.Create(ctx, reply)
+		assert.Nil(t, err)
+
+		assert.Nil(t, todoRepo.CreateRevisions(ctx, domain.NewTodoRevision(domain.RevisionComplete, item, item)))
+
+		// the root comments with their reply counts
+		roots, err := repo.GetList(ctx, &id, domain.NewTodoCommentListReqQryParam())
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), roots.Total())
+		assert.Equal(t, int64(1), roots.List()[0].ReplyCount())
+		assert.Equal(t, []string{"alice"}, roots.List()[0].Mentions())
+
+		qp := domain.NewTodoCommentListReqQryParam()
+		qp.SetParent(&rootID)
+
+		replies, err := repo.GetList(ctx, &id, qp)
+		assert.Nil(t, err)
+		assert.Len(t, replies.List(), 1)
+		assert.Equal(t, reply.UUID(), replies.List()[0].UUID())
+
+		// the feed merges the comments and the changes chronologically
+		feed, err := repo.GetActivity(ctx, &id, domain.NewTodoActivityReqQryParam())
+		assert.Nil(t, err)
+		assert.Equal(t, int64(4), feed.Total())
+
+		kinds := make([]string, 0)
+		for _, activity := range feed.List() {
+			kinds = append(kinds, activity.Kind())
+		}
+
+		assert.Equal(t, []string{domain.ActivityChange, domain.ActivityComment, domain.ActivityComment, domain.ActivityChange}, kinds)
+		assert.Equal(t, rootID, feed.List()[1].Comment().UUID())
+		assert.Equal(t, domain.RevisionComplete, feed.List()[3].Revision().Action())
+
+		// the edit changes the body only
+		root.SetBody("@bob please review")
+		root.SetMentions([]string{"bob"})
+
+		edited, err := repo.Update(ctx, root)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"bob"}, edited.Mentions())
+		assert.Equal(t, rootID, edited.UUID())
+
+		// the root comment is deleted with its replies
+		assert.Nil(t, repo.Delete(ctx, root))
+
+		replyID := reply.UUID()
+		_, err = repo.GetByUUID(ctx, &id, &replyID)
+		assert.Equal(t, status.NotFound, err.(*meta.Error).Msg)
+
+		feed, err = repo.GetActivity(ctx, &id, domain.NewTodoActivityReqQryParam())
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2), feed.Total())
+	})
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"go.uber.org/zap"
+	"microservice/internal/adapter/logger"
+	"microservice/internal/adapter/orm/model"
+	"microservice/internal/core/domain"
+	"microservice/internal/server/http/status"
+	"microservice/pkg/meta"
+)
+
+// Export streams the items of the list filters in the list order with a database cursor, so they are not held in memory.
+// the pagination and the fuzzy fallback of the search are not applied, the error of the callback stops the export
+func (tr *TodoRepository) Export(ctx context.Context, qp *domain.TodoListReqQryParam, each func(*domain.Todo) error) (err error) {
+	// NOTE: the id orders the ties, so the exports of the same data are identical
+	sort := fmt.Sprintf("%s %s, id asc", qp.Sort(), qp.Order())
+
+	tx := tr.db.C().WithContext(ctx).Model(&model.Todos{}).
+		Scopes(accessFilter(qp), dueDateFilter(qp), assigneeFilter(qp), statusFilter(qp))
+
+	if len(qp.Search()) > 0 {
+		tx.Where(fullTextSearchQry, qp.Language(), qp.Search()).
+			Select(fullTextSearchSelect, qp.Language(), qp.Search(), qp.Language(), qp.Search(), highlightOptions)
+	}
+
+	rows, txErr := tx.Order(sort).Rows()
+	if txErr != nil {
+		tr.lgr.Error("todo.repo.export", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		m := model.NewTodo()
+		if scanErr := tx.ScanRows(rows, m); scanErr != nil {
+			tr.lgr.Error("todo.repo.export.scan", zap.Error(scanErr), logger.Context(ctx))
+			err = meta.ServiceErr(status.Failed)
+			return
+		}
+
+		if err = each(domain.NewTodo().FromDB(m)); err != nil {
+			return
+		}
+	}
+
+	if rowsErr := rows.Err(); rowsErr != nil {
+		tr.lgr.Error("todo.repo.export.rows", zap.Error(rowsErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	return
+}
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	gormLogger "gorm.io/gorm/logger"
+	localeMock "microservice/internal/adapter/locale/mocks"
+	loggerMock "microservice/internal/adapter/logger/mocks"
+	ormMock "microservice/internal/adapter/orm/mocks"
+	"microservice/internal/adapter/orm/model"
+	"microservice/internal/core/domain"
+	"microservice/internal/core/port"
+	"testing"
+	"time"
+)
+
+func TestTodoRepository_Export(t *testing.T) {
+	yesterday := time.Now().UTC().Add(-24 * time.Hour)
+	firstID, secondID, thirdID := uuid.New(), uuid.New(), uuid.New()
+
+	seed := func(t *testing.T) *gorm.DB {
+		dbConn, dbErr := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+			Logger: gormLogger.Default.LogMode(gormLogger.Silent),
+		})
+
+		if dbErr != nil {
+			t.Fatalf("failed to open in-memory db: %v", dbErr)
+		}
+
+		if dbErr = dbConn.AutoMigrate(&model.Todos{}, &model.TodoAssignees{}); dbErr != nil {
+			t.Fatalf("failed to auto-migrate: %v", dbErr)
+		}
+
+		t.Cleanup(func() {
+			sql, err := dbConn.DB()
+			if err != nil {
+				t.Logf("cleanup error: %v", err)
+				return
+			}
+
+			if err = sql.Close(); err != nil {
+				t.Log("sql conn close failure: ", err)
+			}
+		})
+
+		items := []*model.Todos{
+			{BaseSql: model.BaseSql{Uuid: firstID}, Description: "a overdue item", DueDate: &yesterday, Status: domain.StatusTodo},
+			{BaseSql: model.BaseSql{Uuid: secondID}, Description: "b someday item", Status: domain.StatusTodo},
+			{BaseSql: model.BaseSql{Uuid: thirdID}, Description: "c completed item", CompletedAt: &yesterday, Status: domain.StatusDone},
+		}
+
+		if dbErr = dbConn.Create(&items).Error; dbErr != nil {
+			t.Fatalf("failed to seed: %v", dbErr)
+		}
+
+		return dbConn
+	}
+
+	newRepo := func(t *testing.T, dbConn *gorm.DB) port.ITodoRepository {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		db := ormMock.NewMockISql(ctrl)
+
+		db.EXPECT().C().Return(dbConn).AnyTimes()
+
+		return NewTodo(locale, logger, db)
+	}
+
+	t.Run("the filtered items are streamed in the list order", func(t *testing.T) {
+		repo := newRepo(t, seed(t))
+
+		sort, order, column := "description", "desc", domain.StatusTodo
+		qp := domain.NewTodoListReqQryParam()
+		qp.SetSort(&sort)
+		qp.SetOrder(&order)
+		qp.SetStatus(&column)
+
+		var exported []uuid.UUID
+		err := repo.Export(context.Background(), qp, func(item *domain.Todo) error {
+			exported = append(exported, item.UUID())
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{secondID, firstID}, exported)
+	})
+
+	t.Run("the error of the callback stops the export", func(t *testing.T) {
+		repo := newRepo(t, seed(t))
+		stop := errors.New("client is gone")
+
+		var count int
+		err := repo.Export(context.Background(), domain.NewTodoListReqQryParam(), func(item *domain.Todo) error {
+			count++
+			return stop
+		})
+
+		assert.ErrorIs(t, err, stop)
+		assert.Equal(t, 1, count)
+	})
+}
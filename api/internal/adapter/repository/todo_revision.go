@@ -0,0 +1,197 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"microservice/internal/adapter/logger"
+	"microservice/internal/adapter/orm"
+	"microservice/internal/adapter/orm/model"
+	"microservice/internal/core/domain"
+	"microservice/internal/server/http/status"
+	"microservice/pkg/meta"
+)
+
+// Lock retrieves the item(including the deleted one) and locks the row until the end of the transaction
+func (tr *TodoRepository) Lock(ctx context.Context, id *uuid.UUID) (res *domain.Todo, err error) {
+	m := model.NewTodo()
+	tx := tr.db.C().WithContext(ctx).Unscoped().Model(&model.Todos{}).
+		Clauses(clause.Locking{Strength: orm.DbLockUpdate})
+
+	if txErr := tx.First(&m, "uuid = ?", id).Error; txErr != nil {
+		if errors.Is(txErr, gorm.ErrRecordNotFound) {
+			err = meta.ServiceErr(status.NotFound)
+			return
+		}
+
+		tr.lgr.Error("todo.repo.lock", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	res = domain.NewTodo().FromDB(m)
+	return
+}
+
+// Restore undeletes the soft-deleted item(locked by the caller), the write is rejected when the expected version of the entity is outdated
+func (tr *TodoRepository) Restore(ctx context.Context, ent *domain.Todo) (res *domain.Todo, err error) {
+	changes := map[string]any{
+		"deleted_at": nil,
+		"version":    gorm.Expr("version + 1"),
+	}
+
+	updated := model.NewTodo()
+	tx := tr.db.C().WithContext(ctx).Unscoped().Model(updated).Clauses(clause.Returning{}).
+		Where("uuid = ? AND deleted_at IS NOT NULL", ent.UUID())
+
+	if versions := ent.ExpectedVersions(); len(versions) > 0 {
+		tx.Where("version IN ?", versions)
+	}
+
+	if txErr := tx.Updates(changes).Error; txErr != nil {
+		tr.lgr.Error("todo.repo.restore", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	if tx.RowsAffected == 0 {
+		err = tr.lockedMissErr(ent)
+		return
+	}
+
+	res = domain.NewTodo().FromDB(updated)
+	return
+}
+
+// Revert replaces the state of the item(locked by the caller) including the completion, the deleted item is restored
+func (tr *TodoRepository) Revert(ctx context.Context, ent *domain.Todo) (res *domain.Todo, err error) {
+	m := ent.ToDB()
+	changes := map[string]any{
+		"description":   m.Description,
+		"due_date":      m.DueDate,
+		"all_day":       m.AllDay,
+		"due_time_zone": m.DueTimeZone,
+		"notes":         m.Notes,
+		"recurrence":    m.Recurrence,
+		"completed_at":  m.CompletedAt,
+		"status":        m.Status,
+		"deleted_at":    nil,
+		"version":       gorm.Expr("version + 1"),
+	}
+
+	if len(m.SearchLanguage) > 0 {
+		changes["search_language"] = m.SearchLanguage
+	}
+
+	updated := model.NewTodo()
+	tx := tr.db.C().WithContext(ctx).Unscoped().Model(updated).Clauses(clause.Returning{}).Where("uuid = ?", ent.UUID())
+
+	if versions := ent.ExpectedVersions(); len(versions) > 0 {
+		tx.Where("version IN ?", versions)
+	}
+
+	if txErr := tx.Updates(changes).Error; txErr != nil {
+		tr.lgr.Error("todo.repo.revert", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	if tx.RowsAffected == 0 {
+		err = tr.lockedMissErr(ent)
+		return
+	}
+
+	res = domain.NewTodo().FromDB(updated)
+	return
+}
+
+// CreateRevisions appends the revisions to the audit trail
+func (tr *TodoRepository) CreateRevisions(ctx context.Context, revs ...*domain.TodoRevision) (err error) {
+	if len(revs) == 0 {
+		return
+	}
+
+	models := make([]*model.TodoRevisions, 0, len(revs))
+	for _, rev := range revs {
+		models = append(models, rev.ToDB())
+	}
+
+	tx := tr.db.C().WithContext(ctx).Model(&model.TodoRevisions{})
+	if txErr := tx.CreateInBatches(&models, createBatchSize).Error; txErr != nil {
+		tr.lgr.Error("todo.repo.revision.create", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	return
+}
+
+// GetRevisions the revisions of the item(including the deleted one), ordered by the revision number
+func (tr *TodoRepository) GetRevisions(ctx context.Context, id *uuid.UUID, qp *domain.ReqBaseQryParam) (res *domain.TodoRevisionList, err error) {
+	list := domain.NewTodoRevisionList()
+
+	var (
+		offset = (qp.Page() - 1) * qp.Limit()
+		sort   = fmt.Sprintf("id %s", qp.Order())
+		models []*model.TodoRevisions
+		total  int64
+	)
+
+	tx := tr.db.C().WithContext(ctx).Model(&model.TodoRevisions{}).Where("todo_uuid = ?", id)
+
+	if txErr := tx.Count(&total).Error; txErr != nil {
+		tr.lgr.Error("todo.repo.revision.list.count.total", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	if total == 0 {
+		err = meta.ServiceErr(status.NotFound)
+		return
+	}
+
+	if txErr := tx.Order(sort).Offset(offset).Limit(qp.Limit()).Find(&models).Error; txErr != nil {
+		tr.lgr.Error("todo.repo.revision.list", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	list.ListFromDB(models)
+	list.SetTotal(total)
+	res = list
+	return
+}
+
+func (tr *TodoRepository) GetRevision(ctx context.Context, id *uuid.UUID, revision uint) (res *domain.TodoRevision, err error) {
+	m := model.NewTodoRevision()
+	tx := tr.db.C().WithContext(ctx).Model(&model.TodoRevisions{})
+
+	if txErr := tx.First(&m, "id = ? AND todo_uuid = ?", revision, id).Error; txErr != nil {
+		if errors.Is(txErr, gorm.ErrRecordNotFound) {
+			err = meta.ServiceErr(status.NotFound)
+			return
+		}
+
+		tr.lgr.Error("todo.repo.revision.detail", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	res = new(domain.TodoRevision).FromDB(m)
+	return
+}
+
+// HELPERS
+
+// lockedMissErr the item is locked before the write by the caller, so no written row means the outdated version
+func (tr *TodoRepository) lockedMissErr(ent *domain.Todo) error {
+	if len(ent.ExpectedVersions()) > 0 {
+		return meta.ServiceErr(status.PreconditionFailed)
+	}
+
+	return meta.ServiceErr(status.NotFound)
+}
@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	gormLogger "gorm.io/gorm/logger"
+	localeMock "microservice/internal/adapter/locale/mocks"
+	loggerMock "microservice/internal/adapter/logger/mocks"
+	ormMock "microservice/internal/adapter/orm/mocks"
+	"microservice/internal/adapter/orm/model"
+	"microservice/internal/core/domain"
+	"microservice/internal/server/http/status"
+	"microservice/pkg/meta"
+	"testing"
+)
+
+func TestTodoRepository_Revisions(t *testing.T) {
+	description, changed := "revision mock item", "changed mock item"
+
+	t.Run("record, restore and revert", func(t *testing.T) {
+		dbConn, dbErr := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+			Logger: gormLogger.Default.LogMode(gormLogger.Silent),
+		})
+
+		if dbErr != nil {
+			t.Fatalf("failed to open in-memory db: %v", dbErr)
+		}
+
+		if dbErr = dbConn.AutoMigrate(&model.Todos{}, &model.TodoRevisions{}); dbErr != nil {
+			t.Fatalf("failed to auto-migrate: %v", dbErr)
+		}
+
+		t.Cleanup(func() {
+			sql, err := dbConn.DB()
+			if err != nil {
+				t.Logf("cleanup error: %v", err)
+				return
+			}
+
+			if err = sql.Close(); err != nil {
+				t.Log("sql conn close failure: ", err)
+			}
+		})
+
+		//
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		db := ormMock.NewMockISql(ctrl)
+
+		ctx := context.Background()
+
+		db.EXPECT().C().Return(dbConn).AnyTimes()
+
+		repo := NewTodo(locale, logger, db)
+
+		ent := domain.NewTodo()
+		ent.SetDescription(&description)
+
+		// the uuids of the batches are generated by the service, not by the db
+		items, err := repo.CreateInBatches(ctx, []*domain.Todo{ent})
+		assert.Nil(t, err)
+
+		created := items[0]
+
+		id := created.UUID()
+		update := domain.NewTodo()
+		update.SetUUID(&id)
+		update.SetDescription(&changed)
+
+		updated, err := repo.Update(ctx, update)
+		assert.Nil(t, err)
+
+		assert.Nil(t, repo.CreateRevisions(ctx,
+			domain.NewTodoRevision(domain.RevisionCreate, nil, created),
+			domain.NewTodoRevision(domain.RevisionUpdate, created, updated),
+		))
+
+		// the deleted item is locked and restored with its version
+		assert.Nil(t, repo.Delete(ctx, updated))
+
+		locked, err := repo.Lock(ctx, &id)
+		assert.Nil(t, err)
+		assert.False(t, locked.DeletedAt().IsZero())
+
+		outdated := domain.NewTodo()
+		outdated.SetUUID(&id)
+		outdated.SetVersion(locked.Version() + 1)
+
+		_, err = repo.Restore(ctx, outdated)
+		assert.Equal(t, status.PreconditionFailed, err.(*meta.Error).Msg)
+
+		restored, err := repo.Restore(ctx, locked)
+		assert.Nil(t, err)
+		assert.True(t, restored.DeletedAt().IsZero())
+		assert.Equal(t, locked.Version()+1, restored.Version())
+
+		// the history is paginated by the revision number
+		page, limit, order := 1, 1, "asc"
+		qp := new(domain.ReqBaseQryParam)
+		qp.SetPage(&page)
+		qp.SetLimit(&limit)
+		qp.SetOrder(&order)
+
+		history, err := repo.GetRevisions(ctx, &id, qp)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2), history.Total())
+		assert.Len(t, history.List(), 1)
+
+		first := history.List()[0]
+		assert.Equal(t, domain.RevisionCreate, first.Action())
+		assert.Nil(t, first.Before())
+		assert.Equal(t, description, first.After().Description)
+		assert.Equal(t, domain.TodoChange{From: nil, To: description}, first.Diff()["description"])
+
+		rev, err := repo.GetRevision(ctx, &id, first.ID())
+		assert.Nil(t, err)
+		assert.Equal(t, first.After(), rev.After())
+
+		reverted, err := repo.Revert(ctx, rev.After().Apply(restored))
+		assert.Nil(t, err)
+		assert.Equal(t, description, *reverted.Description())
+		assert.Equal(t, restored.Version()+1, reverted.Version())
+
+		// the revisions of the other items are not found
+		missing := domain.NewTodo().UUID()
+		_, err = repo.GetRevisions(ctx, &missing, qp)
+		assert.Equal(t, status.NotFound, err.(*meta.Error).Msg)
+	})
+}
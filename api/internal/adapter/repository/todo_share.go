@@ -0,0 +1,206 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"microservice/internal/adapter/locale"
+	"microservice/internal/adapter/logger"
+	"microservice/internal/adapter/orm"
+	"microservice/internal/adapter/orm/model"
+	"microservice/internal/core/domain"
+	"microservice/internal/core/port"
+	"microservice/internal/server/http/status"
+	"microservice/pkg/meta"
+	"time"
+)
+
+type TodoShareRepository struct {
+	lgr logger.ILogger
+	l   locale.ILocale
+	db  orm.ISql
+}
+
+func NewTodoShare(l locale.ILocale, lgr logger.ILogger, db orm.ISql) port.ITodoShareRepository {
+	return &TodoShareRepository{l: l, lgr: lgr, db: db}
+}
+
+func (sr *TodoShareRepository) Tx(db orm.ISql) { sr.db = db }
+
+//
+
+// Upsert replaces the permission of the existing grant, its uuid is kept
+func (sr *TodoShareRepository) Upsert(ctx context.Context, ent *domain.TodoShare) (res *domain.TodoShare, err error) {
+	m := ent.ToDB()
+	if m.Uuid == uuid.Nil {
+		m.Uuid = uuid.New()
+	}
+
+	tx := sr.db.C().WithContext(ctx).Model(model.TodoShares{}).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "todo_uuid"}, {Name: "grantee_type"}, {Name: "grantee"}},
+		DoUpdates: clause.Assignments(map[string]any{
+			"permission": m.Permission,
+			"granted_by": m.GrantedBy,
+			"updated_at": time.Now(),
+		}),
+	})
+
+	if txErr := tx.Create(&m).Error; txErr != nil {
+		sr.lgr.Error("todo.share.repo.upsert", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	// NOTE: the updated row is read back, as the conflicting insert does not return the existing uuid on every driver
+	stored := model.NewTodoShare()
+	if txErr := sr.db.C().WithContext(ctx).First(&stored, "todo_uuid = ? AND grantee_type = ? AND grantee = ?",
+		m.TodoUuid, m.GranteeType, m.Grantee).Error; txErr != nil {
+		sr.lgr.Error("todo.share.repo.upsert.read", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	res = domain.NewTodoShare().FromDB(stored)
+	return
+}
+
+func (sr *TodoShareRepository) GetList(ctx context.Context, todoID *uuid.UUID) (res []*domain.TodoShare, err error) {
+	var models []*model.TodoShares
+	tx := sr.db.C().WithContext(ctx).Model(&model.TodoShares{})
+
+	if txErr := tx.Where("todo_uuid = ?", todoID).Order("id asc").Find(&models).Error; txErr != nil {
+		sr.lgr.Error("todo.share.repo.list", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	res = domain.SharesFromDB(models)
+	return
+}
+
+func (sr *TodoShareRepository) Delete(ctx context.Context, todoID, id *uuid.UUID) (err error) {
+	tx := sr.db.C().WithContext(ctx).Where("uuid = ? AND todo_uuid = ?", id, todoID)
+
+	if txErr := tx.Delete(&model.TodoShares{}).Error; txErr != nil {
+		sr.lgr.Error("todo.share.repo.delete", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	if tx.RowsAffected == 0 {
+		err = meta.ServiceErr(status.NotFound)
+		return
+	}
+
+	return
+}
+
+func (sr *TodoShareRepository) Permission(ctx context.Context, todoID *uuid.UUID, viewer *domain.Viewer) (res string, err error) {
+	if viewer.Anonymous() {
+		return
+	}
+
+	var permissions []string
+	tx := sr.db.C().WithContext(ctx).Model(&model.TodoShares{}).Where("todo_uuid = ?", todoID).Where(
+		"(grantee_type = ? AND grantee = ?) OR (grantee_type = ? AND grantee IN ?)",
+		domain.GranteeUser, viewer.Actor(), domain.GranteeGroup, viewer.Groups(),
+	)
+
+	if txErr := tx.Pluck("permission", &permissions).Error; txErr != nil {
+		sr.lgr.Error("todo.share.repo.permission", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	res = domain.HighestPermission(permissions...)
+	return
+}
+
+// Links
+
+func (sr *TodoShareRepository) CreateLink(ctx context.Context, ent *domain.TodoShareLink) (res *domain.TodoShareLink, err error) {
+	tx := sr.db.C().WithContext(ctx).Model(model.TodoShareLinks{})
+
+	m := ent.ToDB()
+	if m.Uuid == uuid.Nil {
+		m.Uuid = uuid.New()
+	}
+
+	if txErr := tx.Create(&m).Error; txErr != nil {
+		sr.lgr.Error("todo.share.repo.link.create", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	res = domain.NewTodoShareLink().FromDB(m)
+	return
+}
+
+func (sr *TodoShareRepository) GetLinks(ctx context.Context, todoID *uuid.UUID) (res []*domain.TodoShareLink, err error) {
+	var models []*model.TodoShareLinks
+	tx := sr.db.C().WithContext(ctx).Model(&model.TodoShareLinks{})
+
+	if txErr := tx.Where("todo_uuid = ?", todoID).Order("id asc").Find(&models).Error; txErr != nil {
+		sr.lgr.Error("todo.share.repo.link.list", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	res = domain.ShareLinksFromDB(models)
+	return
+}
+
+func (sr *TodoShareRepository) GetLinkByToken(ctx context.Context, tokenHash string) (res *domain.TodoShareLink, err error) {
+	m := model.NewTodoShareLink()
+	tx := sr.db.C().WithContext(ctx).Model(&model.TodoShareLinks{})
+
+	if txErr := tx.First(&m, "token_hash = ?", tokenHash).Error; txErr != nil {
+		if errors.Is(txErr, gorm.ErrRecordNotFound) {
+			err = meta.ServiceErr(status.NotFound)
+			return
+		}
+
+		sr.lgr.Error("todo.share.repo.link.detail", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	res = domain.NewTodoShareLink().FromDB(m)
+	return
+}
+
+func (sr *TodoShareRepository) DeleteLink(ctx context.Context, todoID, id *uuid.UUID) (err error) {
+	tx := sr.db.C().WithContext(ctx).Where("uuid = ? AND todo_uuid = ?", id, todoID)
+
+	if txErr := tx.Delete(&model.TodoShareLinks{}).Error; txErr != nil {
+		sr.lgr.Error("todo.share.repo.link.delete", zap.Error(txErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	if tx.RowsAffected == 0 {
+		err = meta.ServiceErr(status.NotFound)
+		return
+	}
+
+	return
+}
+
+func (sr *TodoShareRepository) DeleteByTodos(ctx context.Context, todoIDs []uuid.UUID) (err error) {
+	if len(todoIDs) == 0 {
+		return
+	}
+
+	for _, m := range []any{&model.TodoShares{}, &model.TodoShareLinks{}} {
+		if txErr := sr.db.C().WithContext(ctx).Where("todo_uuid IN ?", todoIDs).Delete(m).Error; txErr != nil {
+			sr.lgr.Error("todo.share.repo.delete.todos", zap.Error(txErr), logger.Context(ctx))
+			err = meta.ServiceErr(status.Failed)
+			return
+		}
+	}
+
+	return
+}
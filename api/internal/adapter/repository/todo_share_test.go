@@ -0,0 +1,225 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	gormLogger "gorm.io/gorm/logger"
+	localeMock "microservice/internal/adapter/locale/mocks"
+	loggerMock "microservice/internal/adapter/logger/mocks"
+	ormMock "microservice/internal/adapter/orm/mocks"
+	"microservice/internal/adapter/orm/model"
+	"microservice/internal/core/domain"
+	"microservice/internal/server/http/status"
+	"microservice/pkg/meta"
+	"testing"
+)
+
+func TestTodoShareRepository(t *testing.T) {
+	owner, other := "7", "42"
+	legacyID, ownedID, sharedID := uuid.New(), uuid.New(), uuid.New()
+
+	seed := func(t *testing.T) *gorm.DB {
+		dbConn, dbErr := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+			Logger: gormLogger.Default.LogMode(gormLogger.Silent),
+		})
+
+		if dbErr != nil {
+			t.Fatalf("failed to open in-memory db: %v", dbErr)
+		}
+
+		if dbErr = dbConn.AutoMigrate(&model.Todos{}, &model.TodoAssignees{}, &model.TodoShares{}, &model.TodoShareLinks{}); dbErr != nil {
+			t.Fatalf("failed to auto-migrate: %v", dbErr)
+		}
+
+		t.Cleanup(func() {
+			sql, err := dbConn.DB()
+			if err != nil {
+				t.Logf("cleanup error: %v", err)
+				return
+			}
+
+			if err = sql.Close(); err != nil {
+				t.Log("sql conn close failure: ", err)
+			}
+		})
+
+		items := []*model.Todos{
+			{BaseSql: model.BaseSql{Uuid: legacyID}, Description: "legacy item"},
+			{BaseSql: model.BaseSql{Uuid: ownedID}, Description: "private item", Owner: &owner},
+			{BaseSql: model.BaseSql{Uuid: sharedID}, Description: "shared item", Owner: &owner},
+		}
+
+		if dbErr = dbConn.Create(&items).Error; dbErr != nil {
+			t.Fatalf("failed to seed: %v", dbErr)
+		}
+
+		return dbConn
+	}
+
+	grant := func(granteeType, grantee, permission string) *domain.TodoShare {
+		ent := domain.NewTodoShare()
+		ent.SetTodoUUID(sharedID)
+		ent.SetGranteeType(granteeType)
+		ent.SetGrantee(grantee)
+		ent.SetPermission(permission)
+		return ent
+	}
+
+	t.Run("the grant of the grantee is replaced", func(t *testing.T) {
+		dbConn := seed(t)
+
+		//
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		db := ormMock.NewMockISql(ctrl)
+
+		ctx := context.Background()
+
+		db.EXPECT().C().Return(dbConn).AnyTimes()
+
+		repo := NewTodoShare(locale, logger, db)
+
+		first, err := repo.Upsert(ctx, grant(domain.GranteeUser, other, domain.PermissionRead))
+		assert.Nil(t, err)
+
+		second, err := repo.Upsert(ctx, grant(domain.GranteeUser, other, domain.PermissionEdit))
+		assert.Nil(t, err)
+		assert.Equal(t, first.UUID(), second.UUID())
+		assert.Equal(t, domain.PermissionEdit, second.Permission())
+
+		res, err := repo.GetList(ctx, &sharedID)
+		assert.Nil(t, err)
+		assert.Len(t, res, 1)
+	})
+
+	t.Run("the highest permission of the user and its groups", func(t *testing.T) {
+		dbConn := seed(t)
+
+		//
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		db := ormMock.NewMockISql(ctrl)
+
+		ctx := context.Background()
+
+		db.EXPECT().C().Return(dbConn).AnyTimes()
+
+		repo := NewTodoShare(locale, logger, db)
+
+		_, err := repo.Upsert(ctx, grant(domain.GranteeUser, other, domain.PermissionRead))
+		assert.Nil(t, err)
+		_, err = repo.Upsert(ctx, grant(domain.GranteeGroup, "design", domain.PermissionComment))
+		assert.Nil(t, err)
+
+		permission, err := repo.Permission(ctx, &sharedID, domain.NewViewer(other, nil))
+		assert.Nil(t, err)
+		assert.Equal(t, domain.PermissionRead, permission)
+
+		permission, err = repo.Permission(ctx, &sharedID, domain.NewViewer(other, []string{"design"}))
+		assert.Nil(t, err)
+		assert.Equal(t, domain.PermissionComment, permission)
+
+		permission, err = repo.Permission(ctx, &ownedID, domain.NewViewer(other, []string{"design"}))
+		assert.Nil(t, err)
+		assert.Empty(t, permission)
+	})
+
+	t.Run("the list of the viewer", func(t *testing.T) {
+		dbConn := seed(t)
+
+		//
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		db := ormMock.NewMockISql(ctrl)
+
+		ctx := context.Background()
+
+		db.EXPECT().C().Return(dbConn).AnyTimes()
+
+		_, err := NewTodoShare(locale, logger, db).Upsert(ctx, grant(domain.GranteeGroup, "design", domain.PermissionRead))
+		assert.Nil(t, err)
+
+		repo := NewTodo(locale, logger, db)
+
+		qp := domain.NewTodoListReqQryParam()
+		res, err := repo.GetList(ctx, qp)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), res.Total(), "anonymous sees the items without owner")
+
+		qp = domain.NewTodoListReqQryParam()
+		qp.SetViewer(domain.NewViewer(owner, nil))
+		res, err = repo.GetList(ctx, qp)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(3), res.Total())
+
+		qp = domain.NewTodoListReqQryParam()
+		qp.SetViewer(domain.NewViewer(other, []string{"design"}))
+		res, err = repo.GetList(ctx, qp)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2), res.Total())
+
+		qp = domain.NewTodoListReqQryParam()
+		qp.SetViewer(domain.NewViewer(other, []string{"design"}))
+		qp.SetShared(true)
+		res, err = repo.GetList(ctx, qp)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), res.Total())
+		assert.Equal(t, "shared item", *res.List()[0].Description())
+	})
+
+	t.Run("the link by its token", func(t *testing.T) {
+		dbConn := seed(t)
+
+		//
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		db := ormMock.NewMockISql(ctrl)
+
+		ctx := context.Background()
+
+		db.EXPECT().C().Return(dbConn).AnyTimes()
+
+		repo := NewTodoShare(locale, logger, db)
+
+		ent := domain.NewTodoShareLink()
+		ent.SetTodoUUID(sharedID)
+		ent.SetToken("secret-token")
+
+		link, err := repo.CreateLink(ctx, ent)
+		assert.Nil(t, err)
+
+		res, err := repo.GetLinkByToken(ctx, domain.HashShareToken("secret-token"))
+		assert.Nil(t, err)
+		assert.Equal(t, link.UUID(), res.UUID())
+		assert.Empty(t, res.Token())
+
+		_, err = repo.GetLinkByToken(ctx, domain.HashShareToken("unknown-token"))
+		assert.Equal(t, status.NotFound, meta.ErrStatus(err))
+
+		assert.Nil(t, repo.DeleteByTodos(ctx, []uuid.UUID{sharedID}))
+
+		links, err := repo.GetLinks(ctx, &sharedID)
+		assert.Nil(t, err)
+		assert.Empty(t, links)
+	})
+}
@@ -13,6 +13,8 @@ import (
 	ormMock "microservice/internal/adapter/orm/mocks"
 	"microservice/internal/adapter/orm/model"
 	"microservice/internal/core/domain"
+	"microservice/internal/server/http/status"
+	"microservice/pkg/meta"
 	"testing"
 	"time"
 )
@@ -131,8 +133,17 @@ func TestTodoRepository_Create(t *testing.T) {
 	t.Run("create failure duplication error", func(t *testing.T) {
 		type Todos struct {
 			model.BaseSql
-			Description string    `json:"description" gorm:"unique"`
-			DueDate     time.Time `json:"dueDate"`
+			Description string     `json:"description" gorm:"unique"`
+			DueDate     time.Time  `json:"dueDate"`
+			AllDay      bool       `json:"allDay"`
+			DueTimeZone *string    `json:"dueTimeZone"`
+			Notes       *string    `json:"notes"`
+			Recurrence  *string    `json:"recurrence"`
+			Version     uint       `json:"version" gorm:"not null;default:1"`
+			CompletedAt *time.Time `json:"completedAt"`
+			Owner       *string    `json:"owner"`
+			Status      string     `json:"status" gorm:"not null;default:todo"`
+			BoardRank   *string    `json:"boardRank"`
 		}
 
 		dbConn, dbErr := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
@@ -186,5 +197,263 @@ func TestTodoRepository_Create(t *testing.T) {
 		assert.NotNil(t, err)
 		assert.Contains(t, err.Error(), "UNIQUE constraint failed: todos.description")
 
+		// the database error is logged, not returned to the clients
+		var se *meta.Error
+		assert.ErrorAs(t, err, &se)
+		assert.True(t, se.Internal())
+
+	})
+}
+
+func TestTodoRepository_GetList(t *testing.T) {
+	yesterday := time.Now().UTC().Add(-24 * time.Hour)
+	tomorrow := time.Now().UTC().Add(24 * time.Hour)
+
+	seed := func(t *testing.T) *gorm.DB {
+		dbConn, dbErr := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+			Logger: gormLogger.Default.LogMode(gormLogger.Silent),
+		})
+
+		if dbErr != nil {
+			t.Fatalf("failed to open in-memory db: %v", dbErr)
+		}
+
+		if dbErr = dbConn.AutoMigrate(&model.Todos{}); dbErr != nil {
+			t.Fatalf("failed to auto-migrate: %v", dbErr)
+		}
+
+		t.Cleanup(func() {
+			sql, err := dbConn.DB()
+			if err != nil {
+				t.Logf("cleanup error: %v", err)
+				return
+			}
+
+			if err = sql.Close(); err != nil {
+				t.Log("sql conn close failure: ", err)
+			}
+		})
+
+		items := []*model.Todos{
+			{Description: "overdue item", DueDate: &yesterday},
+			{Description: "upcoming item", DueDate: &tomorrow},
+			{Description: "someday item"},
+		}
+
+		if dbErr = dbConn.Create(&items).Error; dbErr != nil {
+			t.Fatalf("failed to seed: %v", dbErr)
+		}
+
+		return dbConn
+	}
+
+	t.Run("no due date", func(t *testing.T) {
+		dbConn := seed(t)
+
+		//
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		db := ormMock.NewMockISql(ctrl)
+
+		ctx := context.Background()
+
+		db.EXPECT().C().Return(dbConn)
+
+		qp := domain.NewTodoListReqQryParam()
+		qp.SetNoDueDate(true)
+
+		repo := NewTodo(locale, logger, db)
+		res, err := repo.GetList(ctx, qp)
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), res.Total())
+		assert.Equal(t, "someday item", *res.List()[0].Description())
+		assert.Nil(t, res.List()[0].DueDate())
+	})
+
+	t.Run("due date range", func(t *testing.T) {
+		dbConn := seed(t)
+
+		//
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		db := ormMock.NewMockISql(ctrl)
+
+		ctx := context.Background()
+
+		db.EXPECT().C().Return(dbConn)
+
+		now := time.Now()
+		qp := domain.NewTodoListReqQryParam()
+		qp.SetDueAfter(&now)
+
+		repo := NewTodo(locale, logger, db)
+		res, err := repo.GetList(ctx, qp)
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), res.Total())
+		assert.Equal(t, "upcoming item", *res.List()[0].Description())
+	})
+}
+
+func TestTodoRepository_Update(t *testing.T) {
+	description := "create mock item"
+	updatedDescription := "update mock item"
+
+	t.Run("optimistic concurrency", func(t *testing.T) {
+		dbConn, dbErr := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+			Logger: gormLogger.Default.LogMode(gormLogger.Silent),
+		})
+
+		if dbErr != nil {
+			t.Fatalf("failed to open in-memory db: %v", dbErr)
+		}
+
+		if dbErr = dbConn.AutoMigrate(&model.Todos{}); dbErr != nil {
+			t.Fatalf("failed to auto-migrate: %v", dbErr)
+		}
+
+		t.Cleanup(func() {
+			sql, err := dbConn.DB()
+			if err != nil {
+				t.Logf("cleanup error: %v", err)
+				return
+			}
+
+			if err = sql.Close(); err != nil {
+				t.Log("sql conn close failure: ", err)
+			}
+		})
+
+		id := uuid.New()
+		if dbErr = dbConn.Create(&model.Todos{BaseSql: model.BaseSql{Uuid: id}, Description: description}).Error; dbErr != nil {
+			t.Fatalf("failed to seed: %v", dbErr)
+		}
+
+		//
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		db := ormMock.NewMockISql(ctrl)
+
+		ctx := context.Background()
+
+		db.EXPECT().C().Return(dbConn).AnyTimes()
+
+		repo := NewTodo(locale, logger, db)
+
+		ent := domain.NewTodo()
+		ent.SetUUID(&id)
+		ent.SetDescription(&updatedDescription)
+		ent.SetVersion(1)
+
+		// the expected version is current
+		res, err := repo.Update(ctx, ent)
+		assert.Nil(t, err)
+		assert.Equal(t, uint(2), res.Version())
+		assert.Equal(t, updatedDescription, *res.Description())
+
+		// the expected version is outdated
+		_, err = repo.Update(ctx, ent)
+		assert.NotNil(t, err)
+		assert.Equal(t, status.PreconditionFailed, err.(*meta.Error).Msg)
+
+		// any of the expected versions is current, like the tags of an If-Match list
+		ent.SetExpectedVersions(1, 2)
+		res, err = repo.Update(ctx, ent)
+		assert.Nil(t, err)
+		assert.Equal(t, uint(3), res.Version())
+
+		// the item does not exist
+		missing := uuid.New()
+		ent.SetUUID(&missing)
+		err = repo.Delete(ctx, ent)
+		assert.NotNil(t, err)
+		assert.Equal(t, status.NotFound, err.(*meta.Error).Msg)
+	})
+}
+
+func TestTodoRepository_CreateInBatches(t *testing.T) {
+	description := "bulk mock item"
+
+	t.Run("create in batches and complete", func(t *testing.T) {
+		dbConn, dbErr := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+			Logger: gormLogger.Default.LogMode(gormLogger.Silent),
+		})
+
+		if dbErr != nil {
+			t.Fatalf("failed to open in-memory db: %v", dbErr)
+		}
+
+		if dbErr = dbConn.AutoMigrate(&model.Todos{}); dbErr != nil {
+			t.Fatalf("failed to auto-migrate: %v", dbErr)
+		}
+
+		t.Cleanup(func() {
+			sql, err := dbConn.DB()
+			if err != nil {
+				t.Logf("cleanup error: %v", err)
+				return
+			}
+
+			if err = sql.Close(); err != nil {
+				t.Log("sql conn close failure: ", err)
+			}
+		})
+
+		//
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		db := ormMock.NewMockISql(ctrl)
+
+		ctx := context.Background()
+
+		db.EXPECT().C().Return(dbConn).AnyTimes()
+
+		repo := NewTodo(locale, logger, db)
+
+		ents := make([]*domain.Todo, 0)
+		for i := 0; i < createBatchSize+1; i++ {
+			ent := domain.NewTodo()
+			ent.SetDescription(&description)
+			ents = append(ents, ent)
+		}
+
+		res, err := repo.CreateInBatches(ctx, ents)
+		assert.Nil(t, err)
+		assert.Len(t, res, createBatchSize+1)
+		assert.NotZero(t, res[createBatchSize].ID())
+
+		id := res[0].UUID()
+		assert.NotEqual(t, uuid.Nil, id)
+
+		ent := domain.NewTodo()
+		ent.SetUUID(&id)
+		ent.SetVersion(1)
+
+		completed, err := repo.Complete(ctx, ent)
+		assert.Nil(t, err)
+		assert.True(t, completed.Completed())
+		assert.Equal(t, uint(2), completed.Version())
+
+		// the outdated version is rejected
+		_, err = repo.Complete(ctx, ent)
+		assert.NotNil(t, err)
+		assert.Equal(t, status.PreconditionFailed, err.(*meta.Error).Msg)
 	})
 }
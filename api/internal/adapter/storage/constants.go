@@ -0,0 +1,12 @@
+package storage
+
+// drivers of the blob storage
+const (
+	DriverLocal = "local"
+	DriverS3    = "s3"
+)
+
+const (
+	defaultLocalDir = "storage"
+	defaultS3Region = "us-east-1"
+)
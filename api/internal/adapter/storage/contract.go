@@ -0,0 +1,32 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+)
+
+// ErrNotFound the blob of the key does not exist
+var ErrNotFound = errors.New("blob not found")
+
+type Blob struct {
+	Key        string
+	Size       int64
+	ModifiedAt time.Time
+}
+
+//go:generate mockgen -source=./contract.go -destination=./mocks/storage_mock.go -package=storage_mock
+type IStorage interface {
+	Init()
+	// Put streams the content of the given size to the key, the existing blob of the key is replaced
+	Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error
+	// Get streams the blob, the caller closes the reader. ErrNotFound is returned for the missing blobs
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the blob, the missing blobs are ignored
+	Delete(ctx context.Context, key string) error
+	// List the blobs of the keys starting with the prefix
+	List(ctx context.Context, prefix string) ([]Blob, error)
+	// Ping checks the storage is reachable, for the readiness of the service
+	Ping(ctx context.Context) error
+}
@@ -0,0 +1,188 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// tempPrefix of the partially written blobs, they are renamed to the key when completed
+const tempPrefix = ".upload-"
+
+type local struct {
+	root string
+}
+
+// NewLocal the blobs are the files of the root directory, the keys are their slash separated relative paths
+func NewLocal(root string) IStorage {
+	return &local{root: root}
+}
+
+func (s *local) Init() {
+	if err := os.MkdirAll(s.root, 0o750); err != nil {
+		log.Fatalf("[storage] local root err: %s", err)
+	}
+}
+
+// Put writes to a temporary file first, so the readers never see a partial blob
+func (s *local) Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error {
+	target, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(target), 0o750); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(target), tempPrefix+"*")
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = os.Remove(tmp.Name()) }() // no-op after the rename
+
+	written, err := io.Copy(tmp, &ctxReader{ctx: ctx, r: content})
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if size >= 0 && written != size {
+		return fmt.Errorf("blob size mismatch: %d of %d bytes", written, size)
+	}
+
+	return os.Rename(tmp.Name(), target)
+}
+
+func (s *local) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	target, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(target)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+
+	return file, err
+}
+
+func (s *local) Delete(ctx context.Context, key string) error {
+	target, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err = os.Remove(target); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+func (s *local) List(ctx context.Context, prefix string) ([]Blob, error) {
+	blobs := make([]Blob, 0)
+
+	// only the directory of the prefix is walked
+	dir := s.root
+	if i := strings.LastIndex(prefix, "/"); i > 0 {
+		var err error
+		if dir, err = s.path(prefix[:i]); err != nil {
+			return nil, err
+		}
+	}
+
+	err := filepath.WalkDir(dir, func(file string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+
+			return err
+		}
+
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), tempPrefix) {
+			return nil
+		}
+
+		rel, err := filepath.Rel(s.root, file)
+		if err != nil {
+			return err
+		}
+
+		key := filepath.ToSlash(rel)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+
+		blobs = append(blobs, Blob{Key: key, Size: info.Size(), ModifiedAt: info.ModTime()})
+		return nil
+	})
+
+	return blobs, err
+}
+
+// HELPERS
+
+// path the file of the key, the keys escaping the root are rejected
+func (s *local) path(key string) (string, error) {
+	clean := path.Clean("/" + key)
+	if len(key) == 0 || clean != "/"+key {
+		return "", fmt.Errorf("invalid blob key: %q", key)
+	}
+
+	return filepath.Join(s.root, filepath.FromSlash(clean[1:])), nil
+}
+
+// Ping the root is an existing directory, the writes are not checked
+func (s *local) Ping(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	info, err := os.Stat(s.root)
+	if err != nil {
+		return err
+	}
+
+	if info.IsDir() == false {
+		return fmt.Errorf("storage root %q is not a directory", s.root)
+	}
+
+	return nil
+}
+
+// ctxReader stops the copy of the content when the context is done
+type ctxReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (r *ctxReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	return r.r.Read(p)
+}
@@ -0,0 +1,147 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"microservice/config"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+)
+
+type s3Storage struct {
+	config config.Storage
+	client *s3.Client
+}
+
+// NewS3 the blobs are the objects of the bucket, any S3-compatible service(MinIO, Ceph, R2...) is supported by the endpoint
+func NewS3(cfg config.Storage) IStorage {
+	return &s3Storage{config: cfg}
+}
+
+func (s *s3Storage) Init() {
+	region := s.config.S3Region
+	if len(region) == 0 {
+		region = defaultS3Region
+	}
+
+	options := s3.Options{
+		Region:       region,
+		UsePathStyle: s.config.S3PathStyle,
+		// the trailing checksums of the SDK are not supported by all of the S3-compatible services
+		RequestChecksumCalculation: aws.RequestChecksumCalculationWhenRequired,
+		ResponseChecksumValidation: aws.ResponseChecksumValidationWhenRequired,
+	}
+
+	if len(s.config.S3AccessKey) > 0 {
+		options.Credentials = credentials.NewStaticCredentialsProvider(s.config.S3AccessKey, s.config.S3SecretKey, "")
+	}
+
+	if len(s.config.S3Endpoint) > 0 {
+		options.BaseEndpoint = aws.String(s.config.S3Endpoint)
+	}
+
+	s.client = s3.New(options)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if _, err := s.client.HeadBucket(ctx, &s3.HeadBucketInput{Bucket: aws.String(s.config.S3Bucket)}); err != nil {
+		log.Fatalf("[storage] s3 bucket err: %s", err)
+	}
+}
+
+func (s *s3Storage) Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error {
+	input := &s3.PutObjectInput{
+		Bucket:      aws.String(s.config.S3Bucket),
+		Key:         aws.String(key),
+		Body:        content,
+		ContentType: aws.String(contentType),
+	}
+
+	if size >= 0 {
+		input.ContentLength = aws.Int64(size)
+	}
+
+	_, err := s.client.PutObject(ctx, input)
+	return err
+}
+
+func (s *s3Storage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	out, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.config.S3Bucket),
+		Key:    aws.String(key),
+	})
+
+	if err != nil {
+		if notFound(err) {
+			return nil, ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	return out.Body, nil
+}
+
+func (s *s3Storage) Delete(ctx context.Context, key string) error {
+	_, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.config.S3Bucket),
+		Key:    aws.String(key),
+	})
+
+	if err != nil && !notFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (s *s3Storage) List(ctx context.Context, prefix string) ([]Blob, error) {
+	blobs := make([]Blob, 0)
+
+	pages := s3.NewListObjectsV2Paginator(s.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(s.config.S3Bucket),
+		Prefix: aws.String(prefix),
+	})
+
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, object := range page.Contents {
+			blobs = append(blobs, Blob{
+				Key:        aws.ToString(object.Key),
+				Size:       aws.ToInt64(object.Size),
+				ModifiedAt: aws.ToTime(object.LastModified),
+			})
+		}
+	}
+
+	return blobs, nil
+}
+
+// HELPERS
+
+func notFound(err error) bool {
+	var noSuchKey *types.NoSuchKey
+	if errors.As(err, &noSuchKey) {
+		return true
+	}
+
+	var apiErr smithy.APIError
+	return errors.As(err, &apiErr) && (apiErr.ErrorCode() == "NotFound" || apiErr.ErrorCode() == "NoSuchKey")
+}
+
+func (s *s3Storage) Ping(ctx context.Context) error {
+	_, err := s.client.HeadBucket(ctx, &s3.HeadBucketInput{Bucket: aws.String(s.config.S3Bucket)})
+	return err
+}
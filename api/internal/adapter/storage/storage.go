@@ -0,0 +1,26 @@
+package storage
+
+import (
+	"fmt"
+	src "microservice"
+	"microservice/config"
+	"microservice/internal/adapter/registry"
+)
+
+// New the blob storage of the configured driver
+func New(registry registry.IRegistry) IStorage {
+	var cfg config.Storage
+	registry.Parse(&cfg)
+
+	switch cfg.Driver {
+	case DriverS3:
+		return NewS3(cfg)
+	default:
+		root := cfg.LocalPath
+		if len(root) == 0 {
+			root = fmt.Sprintf("%s/%s", src.Root(), defaultLocalDir)
+		}
+
+		return NewLocal(root)
+	}
+}
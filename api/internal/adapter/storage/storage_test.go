@@ -0,0 +1,87 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"microservice/config"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/johannesboyne/gofakes3"
+	"github.com/johannesboyne/gofakes3/backend/s3mem"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStorage(t *testing.T) {
+	drivers := map[string]func(t *testing.T) IStorage{
+		DriverLocal: func(t *testing.T) IStorage {
+			return NewLocal(t.TempDir())
+		},
+		// S3-compatible stand-in of MinIO
+		DriverS3: func(t *testing.T) IStorage {
+			backend := s3mem.New()
+			if err := backend.CreateBucket("attachments"); err != nil {
+				t.Fatalf("failed to create bucket: %v", err)
+			}
+
+			server := httptest.NewServer(gofakes3.New(backend).Server())
+			t.Cleanup(server.Close)
+
+			return NewS3(config.Storage{
+				S3Endpoint:  server.URL,
+				S3Bucket:    "attachments",
+				S3AccessKey: "access",
+				S3SecretKey: "secret",
+				S3PathStyle: true,
+			})
+		},
+	}
+
+	for driver, newStorage := range drivers {
+		t.Run(driver, func(t *testing.T) {
+			st := newStorage(t)
+			st.Init()
+
+			ctx := context.Background()
+			content := []byte("screenshot bytes")
+
+			assert.NoError(t, st.Ping(ctx))
+
+			assert.NoError(t, st.Put(ctx, "todos/a/1", bytes.NewReader(content), int64(len(content)), "image/png"))
+			assert.NoError(t, st.Put(ctx, "todos/b/2", bytes.NewReader(content), int64(len(content)), "image/png"))
+
+			reader, err := st.Get(ctx, "todos/a/1")
+			assert.NoError(t, err)
+
+			stored, err := io.ReadAll(reader)
+			assert.NoError(t, reader.Close())
+			assert.NoError(t, err)
+			assert.Equal(t, content, stored)
+
+			blobs, err := st.List(ctx, "todos/a/")
+			assert.NoError(t, err)
+			assert.Len(t, blobs, 1)
+			assert.Equal(t, "todos/a/1", blobs[0].Key)
+			assert.Equal(t, int64(len(content)), blobs[0].Size)
+
+			// the deleted and the missing blobs
+			assert.NoError(t, st.Delete(ctx, "todos/a/1"))
+			assert.NoError(t, st.Delete(ctx, "todos/a/1"))
+
+			_, err = st.Get(ctx, "todos/a/1")
+			assert.ErrorIs(t, err, ErrNotFound)
+
+			blobs, err = st.List(ctx, "todos/")
+			assert.NoError(t, err)
+			assert.Len(t, blobs, 1)
+		})
+	}
+
+	t.Run("local keys can not escape the root", func(t *testing.T) {
+		st := NewLocal(t.TempDir())
+
+		err := st.Put(context.Background(), "../outside", bytes.NewReader(nil), 0, "text/plain")
+		assert.Error(t, err)
+	})
+}
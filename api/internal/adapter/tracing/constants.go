@@ -0,0 +1,8 @@
+package tracing
+
+const (
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+
+	defaultEndpoint = "localhost:4318"
+)
@@ -0,0 +1,19 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+//go:generate mockgen -source=./contract.go -destination=./mocks/tracing_mock.go -package=tracing_mock
+type ITracing interface {
+	Init()
+	// Stop flushes the pending spans
+	Stop(ctx context.Context)
+	// Tracer the tracer of the instrumentation scope, its spans are not recorded when the tracing is disabled
+	Tracer(name string) trace.Tracer
+	// Propagator the W3C trace context and baggage propagator
+	Propagator() propagation.TextMapPropagator
+}
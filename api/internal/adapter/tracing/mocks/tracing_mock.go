@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./contract.go -destination=./mocks/tracing_mock.go -package=tracing_mock
+//
+
+// Package tracing_mock is a generated GoMock package.
+package tracing_mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	propagation "go.opentelemetry.io/otel/propagation"
+	trace "go.opentelemetry.io/otel/trace"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockITracing is a mock of ITracing interface.
+type MockITracing struct {
+	ctrl     *gomock.Controller
+	recorder *MockITracingMockRecorder
+	isgomock struct{}
+}
+
+// MockITracingMockRecorder is the mock recorder for MockITracing.
+type MockITracingMockRecorder struct {
+	mock *MockITracing
+}
+
+// NewMockITracing creates a new mock instance.
+func NewMockITracing(ctrl *gomock.Controller) *MockITracing {
+	mock := &MockITracing{ctrl: ctrl}
+	mock.recorder = &MockITracingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITracing) EXPECT() *MockITracingMockRecorder {
+	return m.recorder
+}
+
+// Init mocks base method.
+func (m *MockITracing) Init() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Init")
+}
+
+// Init indicates an expected call of Init.
+func (mr *MockITracingMockRecorder) Init() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Init", reflect.TypeOf((*MockITracing)(nil).Init))
+}
+
+// Propagator mocks base method.
+func (m *MockITracing) Propagator() propagation.TextMapPropagator {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Propagator")
+	ret0, _ := ret[0].(propagation.TextMapPropagator)
+	return ret0
+}
+
+// Propagator indicates an expected call of Propagator.
+func (mr *MockITracingMockRecorder) Propagator() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Propagator", reflect.TypeOf((*MockITracing)(nil).Propagator))
+}
+
+// Stop mocks base method.
+func (m *MockITracing) Stop(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Stop", ctx)
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockITracingMockRecorder) Stop(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockITracing)(nil).Stop), ctx)
+}
+
+// Tracer mocks base method.
+func (m *MockITracing) Tracer(name string) trace.Tracer {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Tracer", name)
+	ret0, _ := ret[0].(trace.Tracer)
+	return ret0
+}
+
+// Tracer indicates an expected call of Tracer.
+func (mr *MockITracingMockRecorder) Tracer(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tracer", reflect.TypeOf((*MockITracing)(nil).Tracer), name)
+}
@@ -0,0 +1,137 @@
+package tracing
+
+import (
+	"context"
+	"log"
+	"microservice/config"
+	"microservice/internal/adapter/registry"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+type tracing struct {
+	service    config.Service
+	config     config.Tracing
+	exporter   sdktrace.SpanExporter
+	sync       bool
+	provider   trace.TracerProvider
+	sdk        *sdktrace.TracerProvider
+	propagator propagation.TextMapPropagator
+}
+
+func New(registry registry.IRegistry) ITracing {
+	client := new(tracing)
+	registry.Parse(&client.service)
+	registry.Parse(&client.config)
+
+	return client
+}
+
+// NewWithExporter the tracing of the exporter, like the in-memory exporter of the tests.
+// the spans are exported synchronously when they end, and all of them are sampled
+func NewWithExporter(service config.Service, exporter sdktrace.SpanExporter) ITracing {
+	return &tracing{
+		service:  service,
+		config:   config.Tracing{Enable: true, SampleRatio: 1},
+		exporter: exporter,
+		sync:     true,
+	}
+}
+
+func (t *tracing) Init() {
+	t.propagator = propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+	otel.SetTextMapPropagator(t.propagator)
+
+	if t.config.Enable == false {
+		// NOTE: the no-op spans keep the incoming trace context, so the trace ids are still logged and propagated
+		t.provider = noop.NewTracerProvider()
+		return
+	}
+
+	if t.exporter == nil {
+		t.exporter = t.newExporter()
+	}
+
+	ratio := t.config.SampleRatio
+	if ratio <= 0 || ratio > 1 {
+		ratio = 1
+	}
+
+	processor := sdktrace.NewBatchSpanProcessor(t.exporter)
+	if t.sync {
+		processor = sdktrace.NewSimpleSpanProcessor(t.exporter)
+	}
+
+	t.sdk = sdktrace.NewTracerProvider(
+		sdktrace.WithSpanProcessor(processor),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))),
+		sdktrace.WithResource(resource.NewSchemaless(
+			semconv.ServiceNameKey.String(t.service.Name),
+			semconv.DeploymentEnvironmentKey.String(t.service.Env),
+		)),
+	)
+
+	t.provider = t.sdk
+	otel.SetTracerProvider(t.sdk)
+}
+
+func (t *tracing) Stop(ctx context.Context) {
+	if t.sdk == nil {
+		return
+	}
+
+	if err := t.sdk.Shutdown(ctx); err != nil {
+		log.Printf("[tracing] shutdown err: %s", err)
+		return
+	}
+
+	log.Printf("[tracing] stopped successfully")
+}
+
+func (t *tracing) Tracer(name string) trace.Tracer {
+	return t.provider.Tracer(name)
+}
+
+func (t *tracing) Propagator() propagation.TextMapPropagator {
+	return t.propagator
+}
+
+// HELPERS
+
+func (t *tracing) newExporter() sdktrace.SpanExporter {
+	if t.config.Exporter == ExporterStdout {
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+		if err != nil {
+			log.Fatalf("[tracing] stdout exporter err: %s", err)
+		}
+
+		return exporter
+	}
+
+	endpoint := t.config.Endpoint
+	if len(endpoint) == 0 {
+		endpoint = defaultEndpoint
+	}
+
+	opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(endpoint)}
+	if t.config.Insecure {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+
+	// NOTE: the exporter connects lazily, an unreachable collector does not stop the service
+	exporter, err := otlptracehttp.New(context.Background(), opts...)
+	if err != nil {
+		log.Fatalf("[tracing] otlp exporter err: %s", err)
+	}
+
+	return exporter
+}
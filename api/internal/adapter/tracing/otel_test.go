@@ -0,0 +1,136 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	gormLogger "gorm.io/gorm/logger"
+	"microservice/config"
+	"microservice/internal/adapter/logger"
+	"microservice/internal/adapter/orm"
+	"microservice/internal/adapter/orm/model"
+	"microservice/pkg/reqctx"
+	"net/http"
+	"testing"
+)
+
+const traceparent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+func TestTracing(t *testing.T) {
+	service := config.Service{Name: "microservice", Env: "test"}
+
+	t.Run("the span is the child of the traceparent", func(t *testing.T) {
+		exporter := tracetest.NewInMemoryExporter()
+		tr := NewWithExporter(service, exporter)
+		tr.Init()
+
+		header := http.Header{}
+		header.Set("traceparent", traceparent)
+		ctx := tr.Propagator().Extract(context.Background(), propagation.HeaderCarrier(header))
+
+		_, span := tr.Tracer("test").Start(ctx, "GET /api/v1/todo/:uuid")
+		span.End()
+
+		spans := exporter.GetSpans()
+		assert.Len(t, spans, 1)
+		assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", spans[0].SpanContext.TraceID().String())
+		assert.Equal(t, "00f067aa0ba902b7", spans[0].Parent.SpanID().String())
+		assert.True(t, spans[0].Parent.IsRemote())
+
+		out := http.Header{}
+		tr.Propagator().Inject(trace.ContextWithSpan(context.Background(), span), propagation.HeaderCarrier(out))
+		assert.Contains(t, out.Get("traceparent"), "4bf92f3577b34da6a3ce929d0e0e4736")
+	})
+
+	t.Run("the disabled tracing keeps the incoming trace", func(t *testing.T) {
+		tr := &tracing{service: service}
+		tr.Init()
+
+		header := http.Header{}
+		header.Set("traceparent", traceparent)
+		ctx := tr.Propagator().Extract(context.Background(), propagation.HeaderCarrier(header))
+
+		ctx, span := tr.Tracer("test").Start(ctx, "GET /handshake")
+		defer span.End()
+
+		assert.False(t, span.IsRecording())
+		assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", trace.SpanContextFromContext(ctx).TraceID().String())
+	})
+
+	t.Run("the queries are the children of the span of the context", func(t *testing.T) {
+		dbConn, dbErr := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{
+			Logger: gormLogger.Default.LogMode(gormLogger.Silent),
+		})
+
+		if dbErr != nil {
+			t.Fatalf("failed to open in-memory db: %v", dbErr)
+		}
+
+		sqlDatabase, dbErr := dbConn.DB()
+		if dbErr != nil {
+			t.Fatalf("failed to get the sql db: %v", dbErr)
+		}
+
+		t.Cleanup(func() { _ = sqlDatabase.Close() })
+
+		exporter := tracetest.NewInMemoryExporter()
+		tr := NewWithExporter(service, exporter)
+		tr.Init()
+
+		assert.Nil(t, dbConn.Use(orm.NewTracingPlugin(tr.Tracer("orm"), "sqlite")))
+		assert.Nil(t, dbConn.AutoMigrate(&model.Todos{}))
+		assert.Len(t, exporter.GetSpans(), 0) // without a parent
+
+		ctx, parent := tr.Tracer("test").Start(context.Background(), "todo.uc.Create")
+		tx := dbConn.WithContext(ctx)
+		assert.Nil(t, tx.Create(&model.Todos{Description: "traced item"}).Error)
+		assert.Nil(t, tx.Find(&[]*model.Todos{}).Error)
+		parent.End()
+
+		spans := exporter.GetSpans()
+		assert.Len(t, spans, 3)
+		assert.Equal(t, "gorm.create", spans[0].Name)
+		assert.Equal(t, "gorm.query", spans[1].Name)
+
+		for _, span := range spans[:2] {
+			assert.Equal(t, parent.SpanContext().SpanID(), span.Parent.SpanID())
+
+			attrs := make(map[string]string)
+			for _, attr := range span.Attributes {
+				attrs[string(attr.Key)] = attr.Value.Emit()
+			}
+
+			assert.Equal(t, "todos", attrs["db.collection.name"])
+			assert.Equal(t, "sqlite", attrs["db.system"])
+			assert.NotContains(t, attrs["db.query.text"], "traced item")
+		}
+	})
+
+	t.Run("the logs have the request and the trace ids", func(t *testing.T) {
+		exporter := tracetest.NewInMemoryExporter()
+		tr := NewWithExporter(service, exporter)
+		tr.Init()
+
+		buf := new(bytes.Buffer)
+		lgr := zap.New(zapcore.NewCore(zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig()), zapcore.AddSync(buf), zap.DebugLevel))
+
+		ctx, span := tr.Tracer("test").Start(reqctx.WithRequestID(context.Background(), "req-1"), "todo.uc.Detail")
+		lgr.Error("todo.repo.detail", logger.Context(ctx))
+		span.End()
+
+		assert.Contains(t, buf.String(), `"request_id":"req-1"`)
+		assert.Contains(t, buf.String(), `"trace_id":"`+span.SpanContext().TraceID().String()+`"`)
+		assert.Contains(t, buf.String(), `"span_id":"`+span.SpanContext().SpanID().String()+`"`)
+
+		buf.Reset()
+		lgr.Error("todo.repo.detail", logger.Context(context.Background()))
+		assert.NotContains(t, buf.String(), "trace_id")
+	})
+}
@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"microservice/internal/adapter/orm/model"
+	"time"
+)
+
+// IdempotencyKey the stored response of a mutating request, it is replayed for the retries with the same key
+type IdempotencyKey struct {
+	key         string
+	scope       string
+	requestHash string
+	statusCode  int
+	response    []byte
+	etag        string
+	completed   bool
+	lockedUntil time.Time
+	expiresAt   time.Time
+}
+
+func NewIdempotencyKey() *IdempotencyKey {
+	return &IdempotencyKey{}
+}
+
+// Key the client generated `Idempotency-Key` header
+func (d *IdempotencyKey) Key() string { return d.key }
+
+func (d *IdempotencyKey) SetKey(key string) { d.key = key }
+
+// Scope the actor, the method and the route of the request, the same key is independent for the other users and endpoints
+func (d *IdempotencyKey) Scope() string { return d.scope }
+
+func (d *IdempotencyKey) SetScope(scope string) { d.scope = scope }
+
+// RequestHash fingerprint of the request body, a retry with a different body is rejected
+func (d *IdempotencyKey) RequestHash() string { return d.requestHash }
+
+func (d *IdempotencyKey) SetRequestHash(hash string) { d.requestHash = hash }
+
+func (d *IdempotencyKey) StatusCode() int { return d.statusCode }
+
+func (d *IdempotencyKey) SetStatusCode(code int) { d.statusCode = code }
+
+// Response the serialized response body(meta.Result)
+func (d *IdempotencyKey) Response() []byte { return d.response }
+
+func (d *IdempotencyKey) SetResponse(response []byte) { d.response = response }
+
+func (d *IdempotencyKey) ETag() string { return d.etag }
+
+func (d *IdempotencyKey) SetETag(etag string) { d.etag = etag }
+
+// Completed the response is stored, otherwise the first request is still in progress
+func (d *IdempotencyKey) Completed() bool { return d.completed }
+
+func (d *IdempotencyKey) SetCompleted(completed bool) { d.completed = completed }
+
+// LockedUntil the in-progress lock is released after that, so a crashed request does not block the key until expiry
+func (d *IdempotencyKey) LockedUntil() time.Time { return d.lockedUntil }
+
+func (d *IdempotencyKey) SetLockedUntil(t time.Time) { d.lockedUntil = t }
+
+func (d *IdempotencyKey) ExpiresAt() time.Time { return d.expiresAt }
+
+func (d *IdempotencyKey) SetExpiresAt(t time.Time) { d.expiresAt = t }
+
+func (d *IdempotencyKey) FromDB(src *model.IdempotencyKeys) *IdempotencyKey {
+	if src == nil {
+		return nil
+	}
+
+	d.SetKey(src.IdempotencyKey)
+	d.SetScope(src.Scope)
+	d.SetRequestHash(src.RequestHash)
+	d.SetStatusCode(src.StatusCode)
+	d.SetResponse([]byte(src.Response))
+	d.SetETag(src.Etag)
+	d.SetCompleted(src.Completed)
+	d.SetLockedUntil(src.LockedUntil)
+	d.SetExpiresAt(src.ExpiresAt)
+
+	return d
+}
+
+func (d *IdempotencyKey) ToDB() *model.IdempotencyKeys {
+	return &model.IdempotencyKeys{
+		IdempotencyKey: d.Key(),
+		Scope:          d.Scope(),
+		RequestHash:    d.RequestHash(),
+		StatusCode:     d.StatusCode(),
+		Response:       string(d.Response()),
+		Etag:           d.ETag(),
+		Completed:      d.Completed(),
+		LockedUntil:    d.LockedUntil().UTC(),
+		ExpiresAt:      d.ExpiresAt().UTC(),
+	}
+}
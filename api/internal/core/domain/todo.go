@@ -1,19 +1,58 @@
 package domain
 
 import (
+	"html"
 	"microservice/internal/adapter/orm/model"
+	"strings"
 	"time"
 )
 
+const (
+	DefaultSearchLanguage = "english"
+	// SortByRank sorts the searched items by relevance
+	SortByRank = "rank"
+	// AssigneeMe filters the items assigned to the caller
+	AssigneeMe = "me"
+	// HighlightStart and HighlightStop the selectors of the matched words in the search snippet, from the private use area
+	// of Unicode, so the snippet is escaped before they are replaced with the <mark> tags
+	HighlightStart = "\ue000"
+	HighlightStop  = "\ue001"
+)
+
+// smart views of the due date, computed relative to the time zone of the caller
+const (
+	ViewToday     = "today"
+	ViewTomorrow  = "tomorrow"
+	ViewThisWeek  = "this_week"
+	ViewNoDueDate = "no_due_date"
+)
+
 type (
 	Todo struct {
 		Base
 		description *string
 		dueDate     *time.Time
+		allDay      bool
+		dueTimeZone *string
+		notes       *string
+		recurrence  *string
+		language    *string
+		version     uint
+		completedAt *time.Time
+		owner       *string
+		assignees   []string
+		status      *string
+		boardRank   *string
+		// expectedVersions the versions of the conditional update
+		expectedVersions []uint
+		// search results
+		rank      *float64
+		highlight *string
 	}
 
 	TodoList struct {
 		total int64
+		fuzzy bool
 		list  []*Todo
 	}
 )
@@ -42,6 +81,174 @@ func (d *Todo) SetDueDate(dueDate *time.Time) {
 	d.dueDate = dueDate
 }
 
+// AllDay the todo is due on the date of the due date, regardless of the time
+func (d *Todo) AllDay() bool {
+	return d.allDay
+}
+
+func (d *Todo) SetAllDay(allDay bool) {
+	d.allDay = allDay
+}
+
+// DueTimeZone IANA time zone of the due date, like "Europe/Berlin"
+func (d *Todo) DueTimeZone() *string {
+	return d.dueTimeZone
+}
+
+func (d *Todo) SetDueTimeZone(zone *string) {
+	d.dueTimeZone = zone
+}
+
+// DueLocation the location of the due date, default: UTC
+func (d *Todo) DueLocation() *time.Location {
+	if d.dueTimeZone != nil {
+		if location, err := time.LoadLocation(*d.dueTimeZone); err == nil {
+			return location
+		}
+	}
+
+	return time.UTC
+}
+
+// Notes long-form Markdown notes
+func (d *Todo) Notes() *string {
+	return d.notes
+}
+
+func (d *Todo) SetNotes(notes *string) {
+	d.notes = notes
+}
+
+// Recurrence the RFC 5545 RRULE value of the due date, like FREQ=WEEKLY;BYDAY=MO
+func (d *Todo) Recurrence() *string {
+	return d.recurrence
+}
+
+func (d *Todo) SetRecurrence(recurrence *string) {
+	d.recurrence = recurrence
+}
+
+// Version optimistic concurrency version, it is incremented on every write
+func (d *Todo) Version() uint {
+	return d.version
+}
+
+// SetVersion sets the current version, or the expected version of the updates
+func (d *Todo) SetVersion(version uint) {
+	d.version = version
+}
+
+// SetExpectedVersions the update is applied when any of the versions is current, like the versions of an If-Match list
+func (d *Todo) SetExpectedVersions(versions ...uint) {
+	d.expectedVersions = versions
+}
+
+// ExpectedVersions the versions accepted by the update, the version of the entity when they are not set. empty for the
+// unconditional updates
+func (d *Todo) ExpectedVersions() []uint {
+	if len(d.expectedVersions) > 0 {
+		return d.expectedVersions
+	}
+
+	if d.version > 0 {
+		return []uint{d.version}
+	}
+
+	return nil
+}
+
+// CompletedAt the time of the completion, nil for the open items
+func (d *Todo) CompletedAt() *time.Time {
+	return d.completedAt
+}
+
+func (d *Todo) SetCompletedAt(t *time.Time) {
+	d.completedAt = t
+}
+
+func (d *Todo) Completed() bool {
+	return d.completedAt != nil
+}
+
+// Owner the id of the user who created the item, nil for the items accessible by everyone
+func (d *Todo) Owner() *string {
+	return d.owner
+}
+
+func (d *Todo) SetOwner(owner *string) {
+	d.owner = owner
+}
+
+// Assignees the ids of the users responsible for the item, in the order of the assignment
+func (d *Todo) Assignees() []string {
+	return d.assignees
+}
+
+func (d *Todo) SetAssignees(assignees []string) {
+	d.assignees = assignees
+}
+
+// Status the column of the item on the board, default: todo
+func (d *Todo) Status() string {
+	if d.status != nil {
+		return *d.status
+	}
+
+	return StatusTodo
+}
+
+func (d *Todo) SetStatus(status *string) {
+	d.status = status
+}
+
+// BoardRank the fractional index of the item in its column, empty for the unranked items
+func (d *Todo) BoardRank() string {
+	if d.boardRank != nil {
+		return *d.boardRank
+	}
+
+	return ""
+}
+
+func (d *Todo) SetBoardRank(rank *string) {
+	d.boardRank = rank
+}
+
+// Language the full-text search configuration of the description, like "english"
+func (d *Todo) Language() string {
+	if d.language != nil {
+		return *d.language
+	}
+
+	return ""
+}
+
+func (d *Todo) SetLanguage(language *string) {
+	d.language = language
+}
+
+// Rank relevance of the item to the searched phrase
+func (d *Todo) Rank() float64 {
+	if d.rank != nil {
+		return *d.rank
+	}
+
+	return 0
+}
+
+func (d *Todo) SetRank(rank *float64) {
+	d.rank = rank
+}
+
+// Highlight description snippet with the matched words wrapped in <mark> tags
+func (d *Todo) Highlight() *string {
+	return d.highlight
+}
+
+func (d *Todo) SetHighlight(highlight *string) {
+	d.highlight = highlight
+}
+
 //
 
 func (d *Todo) FromDB(src *model.Todos) *Todo {
@@ -57,7 +264,35 @@ func (d *Todo) FromDB(src *model.Todos) *Todo {
 	d.SetDeletedAt(&src.DeletedAt.Time)
 	//fields
 	d.SetDescription(&src.Description)
-	d.SetDueDate(&src.DueDate)
+	d.SetDueDate(src.DueDate)
+	d.SetAllDay(src.AllDay)
+	d.SetDueTimeZone(src.DueTimeZone)
+	d.SetNotes(src.Notes)
+	d.SetRecurrence(src.Recurrence)
+	d.SetLanguage(&src.SearchLanguage)
+	d.SetVersion(src.Version)
+	d.SetCompletedAt(src.CompletedAt)
+	d.SetOwner(src.Owner)
+	d.SetStatus(&src.Status)
+	d.SetBoardRank(src.BoardRank)
+	d.SetAssignees(func() []string {
+		assignees := make([]string, 0, len(src.Assignees))
+		for _, a := range src.Assignees {
+			assignees = append(assignees, a.Assignee)
+		}
+
+		return assignees
+	}())
+	//search
+	if src.Rank != 0 {
+		d.SetRank(&src.Rank)
+	}
+
+	if len(src.Highlight) > 0 {
+		highlight := highlightHTML(src.Highlight)
+		d.SetHighlight(&highlight)
+	}
+
 	return d
 }
 
@@ -67,7 +302,30 @@ func (d *Todo) ToDB() *model.Todos {
 			Uuid: d.UUID(),
 		},
 		Description: *d.Description(),
-		DueDate:     *d.DueDate(),
+		DueDate: func() *time.Time {
+			if d.DueDate() == nil {
+				return nil
+			}
+
+			utc := d.DueDate().UTC() // the TIMESTAMP column keeps the wall clock only
+			return &utc
+		}(),
+		AllDay:         d.AllDay(),
+		DueTimeZone:    d.DueTimeZone(),
+		Notes:          d.Notes(),
+		Recurrence:     d.Recurrence(),
+		SearchLanguage: d.Language(),
+		CompletedAt: func() *time.Time {
+			if d.CompletedAt() == nil {
+				return nil
+			}
+
+			utc := d.CompletedAt().UTC()
+			return &utc
+		}(),
+		Owner:     d.Owner(),
+		Status:    d.Status(),
+		BoardRank: d.boardRank,
 	}
 }
 
@@ -79,6 +337,11 @@ func (ul *TodoList) SetTotal(total int64) { ul.total = total }
 
 func (ul *TodoList) Total() int64 { return ul.total }
 
+// SetFuzzy marks the list as the result of the trigram (typo tolerant) fallback search
+func (ul *TodoList) SetFuzzy(fuzzy bool) { ul.fuzzy = fuzzy }
+
+func (ul *TodoList) Fuzzy() bool { return ul.fuzzy }
+
 func (ul *TodoList) SetList(list []*Todo) { ul.list = list }
 
 func (ul *TodoList) List() []*Todo { return ul.list }
@@ -101,10 +364,195 @@ func (ul *TodoList) ListFromDB(src []*model.Todos) []*Todo {
 
 // Query Params
 
+type TodoDetailReqQryParam struct {
+	renderNotes bool
+}
+
+func NewTodoDetailReqQryParam() *TodoDetailReqQryParam {
+	return &TodoDetailReqQryParam{}
+}
+
+func (qp *TodoDetailReqQryParam) SetRenderNotes(render bool) { qp.renderNotes = render }
+
+// RenderNotes the notes are rendered to HTML in the response
+func (qp *TodoDetailReqQryParam) RenderNotes() bool { return qp.renderNotes }
+
 type TodoListReqQryParam struct {
 	ReqBaseQryParam
+	language   *string
+	dueAfter   *time.Time // inclusive
+	dueBefore  *time.Time // exclusive
+	noDueDate  bool
+	hasDueDate bool
+	overdue    bool
+	view       *string
+	location   *time.Location
+	viewer     *Viewer
+	shared     bool
+	assignee   *string
+	unassigned bool
+	status     *string
 }
 
 func NewTodoListReqQryParam() *TodoListReqQryParam {
 	return &TodoListReqQryParam{}
 }
+
+func (qp *TodoListReqQryParam) SetLanguage(language *string) { qp.language = language }
+
+// Language the full-text search configuration of the searched phrase, default: english
+func (qp *TodoListReqQryParam) Language() string {
+	if qp.language != nil {
+		return *qp.language
+	}
+
+	return DefaultSearchLanguage
+}
+
+// Sort the search results are sorted by relevance unless another field is requested
+func (qp *TodoListReqQryParam) Sort() string {
+	if len(qp.Search()) == 0 {
+		if qp.sort != nil && *qp.sort == SortByRank {
+			return new(ReqBaseQryParam).Sort() // relevance is meaningless without a searched phrase
+		}
+
+		return qp.ReqBaseQryParam.Sort()
+	}
+
+	if qp.sort == nil {
+		return SortByRank
+	}
+
+	return *qp.sort
+}
+
+func (qp *TodoListReqQryParam) SetDueAfter(t *time.Time) { qp.dueAfter = t }
+
+// DueAfter the items due at or after the time
+func (qp *TodoListReqQryParam) DueAfter() *time.Time { return qp.dueAfter }
+
+func (qp *TodoListReqQryParam) SetDueBefore(t *time.Time) { qp.dueBefore = t }
+
+// DueBefore the items due before the time
+func (qp *TodoListReqQryParam) DueBefore() *time.Time { return qp.dueBefore }
+
+func (qp *TodoListReqQryParam) SetNoDueDate(noDueDate bool) { qp.noDueDate = noDueDate }
+
+// NoDueDate the items without due date
+func (qp *TodoListReqQryParam) NoDueDate() bool { return qp.noDueDate }
+
+func (qp *TodoListReqQryParam) SetHasDueDate(hasDueDate bool) { qp.hasDueDate = hasDueDate }
+
+// HasDueDate the items with due date, like the events of a calendar
+func (qp *TodoListReqQryParam) HasDueDate() bool { return qp.hasDueDate }
+
+func (qp *TodoListReqQryParam) SetOverdue(overdue bool) { qp.overdue = overdue }
+
+func (qp *TodoListReqQryParam) Overdue() bool { return qp.overdue }
+
+func (qp *TodoListReqQryParam) SetView(view *string) { qp.view = view }
+
+func (qp *TodoListReqQryParam) View() string {
+	if qp.view != nil {
+		return *qp.view
+	}
+
+	return ""
+}
+
+func (qp *TodoListReqQryParam) SetLocation(location *time.Location) { qp.location = location }
+
+// Location the time zone of the caller, default: UTC, so the views do not depend on the zone of the server
+func (qp *TodoListReqQryParam) Location() *time.Location {
+	if qp.location != nil {
+		return qp.location
+	}
+
+	return time.UTC
+}
+
+// SetViewer the items are listed as far as they are readable by the viewer
+func (qp *TodoListReqQryParam) SetViewer(viewer *Viewer) { qp.viewer = viewer }
+
+// Viewer the anonymous viewer by default, it reads the items without owner only
+func (qp *TodoListReqQryParam) Viewer() *Viewer {
+	if qp.viewer != nil {
+		return qp.viewer
+	}
+
+	return new(Viewer)
+}
+
+func (qp *TodoListReqQryParam) SetShared(shared bool) { qp.shared = shared }
+
+// Shared the items shared with the viewer by the other users only
+func (qp *TodoListReqQryParam) Shared() bool { return qp.shared }
+
+func (qp *TodoListReqQryParam) SetAssignee(assignee *string) { qp.assignee = assignee }
+
+// Assignee the items assigned to the user, AssigneeMe is resolved to the viewer by ResolveAssignee
+func (qp *TodoListReqQryParam) Assignee() *string { return qp.assignee }
+
+func (qp *TodoListReqQryParam) SetUnassigned(unassigned bool) { qp.unassigned = unassigned }
+
+// Unassigned the items without assignee
+func (qp *TodoListReqQryParam) Unassigned() bool { return qp.unassigned }
+
+func (qp *TodoListReqQryParam) SetStatus(status *string) { qp.status = status }
+
+// Status the items of the column
+func (qp *TodoListReqQryParam) Status() *string { return qp.status }
+
+// ResolveAssignee replaces AssigneeMe by the viewer, it is false when the anonymous viewer asks for its items
+func (qp *TodoListReqQryParam) ResolveAssignee() bool {
+	if qp.assignee == nil || *qp.assignee != AssigneeMe {
+		return true
+	}
+
+	if qp.Viewer().Anonymous() {
+		return false
+	}
+
+	actor := qp.Viewer().Actor()
+	qp.SetAssignee(&actor)
+	return true
+}
+
+// ApplyView narrows the due date range by the smart view and the overdue filter relative to the current time
+func (qp *TodoListReqQryParam) ApplyView(now time.Time) {
+	now = now.In(qp.Location())
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	switch qp.View() {
+	case ViewToday:
+		qp.narrowDueRange(today, today.AddDate(0, 0, 1))
+	case ViewTomorrow:
+		qp.narrowDueRange(today.AddDate(0, 0, 1), today.AddDate(0, 0, 2))
+	case ViewThisWeek:
+		// weeks start on Monday
+		monday := today.AddDate(0, 0, -((int(today.Weekday()) + 6) % 7))
+		qp.narrowDueRange(monday, monday.AddDate(0, 0, 7))
+	case ViewNoDueDate:
+		qp.SetNoDueDate(true)
+	}
+
+	if qp.Overdue() {
+		qp.narrowDueRange(time.Time{}, now)
+	}
+}
+
+// narrowDueRange intersects the requested due date range with the given one, zero times are unbounded
+func (qp *TodoListReqQryParam) narrowDueRange(after, before time.Time) {
+	if !after.IsZero() && (qp.dueAfter == nil || after.After(*qp.dueAfter)) {
+		qp.SetDueAfter(&after)
+	}
+
+	if !before.IsZero() && (qp.dueBefore == nil || before.Before(*qp.dueBefore)) {
+		qp.SetDueBefore(&before)
+	}
+}
+
+// highlightHTML the escaped snippet with the matched words wrapped in <mark> tags, the description is never returned as markup
+func highlightHTML(snippet string) string {
+	return strings.NewReplacer(HighlightStart, "<mark>", HighlightStop, "</mark>").Replace(html.EscapeString(snippet))
+}
@@ -0,0 +1,73 @@
+package domain
+
+import "time"
+
+// kinds of the activities
+const (
+	ActivityComment = "comment"
+	ActivityChange  = "change"
+)
+
+type (
+	// TodoActivity an entry of the activity feed, either a comment or a change(revision) of the item
+	TodoActivity struct {
+		kind      string
+		createdAt time.Time
+		comment   *TodoComment
+		revision  *TodoRevision
+	}
+
+	TodoActivityList struct {
+		total int64
+		list  []*TodoActivity
+	}
+
+	TodoActivityReqQryParam struct {
+		ReqBaseQryParam
+	}
+)
+
+func NewCommentActivity(comment *TodoComment) *TodoActivity {
+	return &TodoActivity{kind: ActivityComment, createdAt: comment.CreatedAt(), comment: comment}
+}
+
+func NewChangeActivity(revision *TodoRevision) *TodoActivity {
+	return &TodoActivity{kind: ActivityChange, createdAt: revision.CreatedAt(), revision: revision}
+}
+
+func (d *TodoActivity) Kind() string { return d.kind }
+
+func (d *TodoActivity) CreatedAt() time.Time { return d.createdAt }
+
+// Comment the comment of the comment activities, nil for the others
+func (d *TodoActivity) Comment() *TodoComment { return d.comment }
+
+// Revision the revision of the change activities, nil for the others
+func (d *TodoActivity) Revision() *TodoRevision { return d.revision }
+
+//
+
+func NewTodoActivityList() *TodoActivityList { return &TodoActivityList{} }
+
+func (al *TodoActivityList) SetTotal(total int64) { al.total = total }
+
+func (al *TodoActivityList) Total() int64 { return al.total }
+
+func (al *TodoActivityList) SetList(list []*TodoActivity) { al.list = list }
+
+func (al *TodoActivityList) List() []*TodoActivity { return al.list }
+
+//
+
+func NewTodoActivityReqQryParam() *TodoActivityReqQryParam {
+	return &TodoActivityReqQryParam{}
+}
+
+// Order default order: asc, the feed is read in the chronological order
+func (qp *TodoActivityReqQryParam) Order() string {
+	if qp.order != nil {
+		return *qp.order
+	}
+
+	return "asc"
+}
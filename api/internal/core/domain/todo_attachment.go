@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"fmt"
+	"io"
+	"microservice/internal/adapter/orm/model"
+
+	"github.com/google/uuid"
+)
+
+// AttachmentStoragePrefix of the blob keys of the attachments
+const AttachmentStoragePrefix = "todos/"
+
+type TodoAttachment struct {
+	Base
+	todoUUID    uuid.UUID
+	fileName    string
+	contentType string
+	size        int64
+	checksum    string
+	storageKey  string
+	uploader    *string
+	// content the uploaded or the downloaded stream, it is not stored in the database
+	content io.ReadCloser
+}
+
+func NewTodoAttachment() *TodoAttachment {
+	return &TodoAttachment{}
+}
+
+// AttachmentStorageKey the key of the blob, the attachments of an item share its prefix
+func AttachmentStorageKey(todoID, id uuid.UUID) string {
+	return fmt.Sprintf("%s%s/%s", AttachmentStoragePrefix, todoID, id)
+}
+
+func (d *TodoAttachment) TodoUUID() uuid.UUID { return d.todoUUID }
+
+func (d *TodoAttachment) SetTodoUUID(id uuid.UUID) { d.todoUUID = id }
+
+// FileName the client side name of the file, it is used by the downloads only
+func (d *TodoAttachment) FileName() string { return d.fileName }
+
+func (d *TodoAttachment) SetFileName(name string) { d.fileName = name }
+
+// ContentType the sniffed MIME type of the content, the type declared by the client is not trusted
+func (d *TodoAttachment) ContentType() string { return d.contentType }
+
+func (d *TodoAttachment) SetContentType(contentType string) { d.contentType = contentType }
+
+// Size in bytes
+func (d *TodoAttachment) Size() int64 { return d.size }
+
+func (d *TodoAttachment) SetSize(size int64) { d.size = size }
+
+// Checksum the SHA-256 of the content, hex
+func (d *TodoAttachment) Checksum() string { return d.checksum }
+
+func (d *TodoAttachment) SetChecksum(checksum string) { d.checksum = checksum }
+
+func (d *TodoAttachment) StorageKey() string { return d.storageKey }
+
+func (d *TodoAttachment) SetStorageKey(key string) { d.storageKey = key }
+
+// Uploader the id of the user who uploaded the file, nil for the anonymous uploads
+func (d *TodoAttachment) Uploader() *string { return d.uploader }
+
+func (d *TodoAttachment) SetUploader(uploader *string) { d.uploader = uploader }
+
+// Content the stream of the upload or the download, the consumer closes it
+func (d *TodoAttachment) Content() io.ReadCloser { return d.content }
+
+func (d *TodoAttachment) SetContent(content io.ReadCloser) { d.content = content }
+
+func (d *TodoAttachment) FromDB(src *model.TodoAttachments) *TodoAttachment {
+	if src == nil {
+		return nil
+	}
+
+	// base
+	d.SetID(&src.ID)
+	d.SetUUID(&src.Uuid)
+	d.SetCreatedAt(&src.CreatedAt)
+	d.SetUpdatedAt(&src.UpdatedAt)
+	//fields
+	d.todoUUID = src.TodoUuid
+	d.fileName = src.FileName
+	d.contentType = src.ContentType
+	d.size = src.Size
+	d.checksum = src.Checksum
+	d.storageKey = src.StorageKey
+	d.uploader = src.Uploader
+
+	return d
+}
+
+func (d *TodoAttachment) ToDB() *model.TodoAttachments {
+	return &model.TodoAttachments{
+		Uuid:        d.UUID(),
+		TodoUuid:    d.todoUUID,
+		FileName:    d.fileName,
+		ContentType: d.contentType,
+		Size:        d.size,
+		Checksum:    d.checksum,
+		StorageKey:  d.storageKey,
+		Uploader:    d.uploader,
+	}
+}
+
+func AttachmentsFromDB(src []*model.TodoAttachments) []*TodoAttachment {
+	list := make([]*TodoAttachment, 0, len(src))
+
+	for _, m := range src {
+		list = append(list, NewTodoAttachment().FromDB(m))
+	}
+
+	return list
+}
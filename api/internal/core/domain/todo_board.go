@@ -0,0 +1,41 @@
+package domain
+
+import "github.com/google/uuid"
+
+// statuses of the items, they are the columns of the board
+const (
+	StatusTodo       = "todo"
+	StatusInProgress = "in_progress"
+	// StatusDone the items of the column are completed, the completion moves the item to it
+	StatusDone = "done"
+)
+
+// TodoMove places the item into the column between the neighbours, the missing neighbours are the ends of the column
+type TodoMove struct {
+	todo   *Todo
+	status *string
+	before *uuid.UUID
+	after  *uuid.UUID
+}
+
+func NewTodoMove(todo *Todo) *TodoMove {
+	return &TodoMove{todo: todo}
+}
+
+// Todo the moved item with its expected version
+func (d *TodoMove) Todo() *Todo { return d.todo }
+
+func (d *TodoMove) SetStatus(status *string) { d.status = status }
+
+// Status the target column, nil keeps the column of the item
+func (d *TodoMove) Status() *string { return d.status }
+
+func (d *TodoMove) SetBefore(id *uuid.UUID) { d.before = id }
+
+// Before the item placed right below the moved item
+func (d *TodoMove) Before() *uuid.UUID { return d.before }
+
+func (d *TodoMove) SetAfter(id *uuid.UUID) { d.after = id }
+
+// After the item placed right above the moved item
+func (d *TodoMove) After() *uuid.UUID { return d.after }
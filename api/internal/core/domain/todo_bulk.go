@@ -0,0 +1,102 @@
+package domain
+
+// operations of the bulk request
+const (
+	BulkOpCreate   = "create"
+	BulkOpUpdate   = "update"
+	BulkOpComplete = "complete"
+	BulkOpDelete   = "delete"
+)
+
+// modes of the bulk request
+const (
+	// BulkModeAtomic all operations are applied in a single transaction, the first failure rolls back all of them
+	BulkModeAtomic = "atomic"
+	// BulkModeBestEffort every operation is applied independently, the failures are reported per item
+	BulkModeBestEffort = "best_effort"
+)
+
+type (
+	TodoBulk struct {
+		mode       string
+		operations []*TodoBulkOperation
+	}
+
+	// TodoBulkOperation the todo of the update, complete and delete operations carries the uuid and the expected version
+	TodoBulkOperation struct {
+		op   string
+		todo *Todo
+	}
+
+	TodoBulkResult struct {
+		index   int
+		op      string
+		todo    *Todo
+		err     error
+		applied bool
+	}
+)
+
+func NewTodoBulk() *TodoBulk {
+	return &TodoBulk{}
+}
+
+func (d *TodoBulk) SetMode(mode string) { d.mode = mode }
+
+// Mode default: atomic
+func (d *TodoBulk) Mode() string {
+	if len(d.mode) > 0 {
+		return d.mode
+	}
+
+	return BulkModeAtomic
+}
+
+func (d *TodoBulk) Atomic() bool { return d.Mode() == BulkModeAtomic }
+
+func (d *TodoBulk) SetOperations(operations []*TodoBulkOperation) { d.operations = operations }
+
+func (d *TodoBulk) Operations() []*TodoBulkOperation { return d.operations }
+
+//
+
+func NewTodoBulkOperation(op string, todo *Todo) *TodoBulkOperation {
+	return &TodoBulkOperation{op: op, todo: todo}
+}
+
+func (d *TodoBulkOperation) Op() string { return d.op }
+
+func (d *TodoBulkOperation) Todo() *Todo { return d.todo }
+
+//
+
+func NewTodoBulkResult(index int, op string) *TodoBulkResult {
+	return &TodoBulkResult{index: index, op: op}
+}
+
+// Index position of the operation in the request
+func (d *TodoBulkResult) Index() int { return d.index }
+
+func (d *TodoBulkResult) Op() string { return d.op }
+
+// Todo the written item, nil for the delete operation and the failures
+func (d *TodoBulkResult) Todo() *Todo { return d.todo }
+
+func (d *TodoBulkResult) Err() error { return d.err }
+
+// Applied the operation is persisted, the successful operations of a rolled back atomic request are not applied
+func (d *TodoBulkResult) Applied() bool { return d.applied }
+
+// Succeed marks the operation as applied
+func (d *TodoBulkResult) Succeed(todo *Todo) {
+	d.todo, d.err, d.applied = todo, nil, true
+}
+
+func (d *TodoBulkResult) Fail(err error) {
+	d.todo, d.err, d.applied = nil, err, false
+}
+
+// RollBack marks the successful operation of a failed atomic request as not applied
+func (d *TodoBulkResult) RollBack() {
+	d.applied = false
+}
@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"microservice/internal/adapter/orm/model"
+)
+
+// the calendar components of the items
+const (
+	// CalendarTodo the items are the tasks(VTODO) of the calendar
+	CalendarTodo = "vtodo"
+	// CalendarEvent the items with due date are the events(VEVENT), for the calendars without task support
+	CalendarEvent = "vevent"
+)
+
+// TodoCalendarFeed the subscribed iCalendar feed of the items readable by its owner
+type TodoCalendarFeed struct {
+	Base
+	owner     string
+	component string
+	token     string
+	tokenHash string
+}
+
+func NewTodoCalendarFeed() *TodoCalendarFeed {
+	return &TodoCalendarFeed{}
+}
+
+// Owner the id of the user, the feed lists the items readable by the user
+func (d *TodoCalendarFeed) Owner() string { return d.owner }
+
+func (d *TodoCalendarFeed) SetOwner(owner string) { d.owner = owner }
+
+// Component `vtodo` or `vevent`
+func (d *TodoCalendarFeed) Component() string { return d.component }
+
+func (d *TodoCalendarFeed) SetComponent(component string) { d.component = component }
+
+// Token the plain token, it is known only when the feed is created
+func (d *TodoCalendarFeed) Token() string { return d.token }
+
+// SetToken sets the token with its hash
+func (d *TodoCalendarFeed) SetToken(token string) {
+	d.token = token
+	d.tokenHash = HashShareToken(token)
+}
+
+func (d *TodoCalendarFeed) TokenHash() string { return d.tokenHash }
+
+func (d *TodoCalendarFeed) FromDB(src *model.TodoCalendarFeeds) *TodoCalendarFeed {
+	if src == nil {
+		return nil
+	}
+
+	// base
+	d.SetID(&src.ID)
+	d.SetUUID(&src.Uuid)
+	d.SetCreatedAt(&src.CreatedAt)
+	d.SetUpdatedAt(&src.UpdatedAt)
+	//fields
+	d.owner = src.Owner
+	d.component = src.Component
+	d.tokenHash = src.TokenHash
+
+	return d
+}
+
+func (d *TodoCalendarFeed) ToDB() *model.TodoCalendarFeeds {
+	return &model.TodoCalendarFeeds{
+		Uuid:      d.UUID(),
+		Owner:     d.owner,
+		Component: d.component,
+		TokenHash: d.tokenHash,
+	}
+}
+
+func CalendarFeedsFromDB(src []*model.TodoCalendarFeeds) []*TodoCalendarFeed {
+	list := make([]*TodoCalendarFeed, 0, len(src))
+
+	for _, m := range src {
+		list = append(list, NewTodoCalendarFeed().FromDB(m))
+	}
+
+	return list
+}
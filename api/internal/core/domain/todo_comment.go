@@ -0,0 +1,148 @@
+package domain
+
+import (
+	"encoding/json"
+	"microservice/internal/adapter/orm/model"
+
+	"github.com/google/uuid"
+)
+
+type (
+	TodoComment struct {
+		Base
+		todoUUID   uuid.UUID
+		parentUUID *uuid.UUID
+		author     *string
+		body       string
+		mentions   []string
+		replyCount int64
+	}
+
+	TodoCommentList struct {
+		total int64
+		list  []*TodoComment
+	}
+
+	// TodoCommentListReqQryParam the root comments are listed without the parent
+	TodoCommentListReqQryParam struct {
+		ReqBaseQryParam
+		parent *uuid.UUID
+	}
+)
+
+func NewTodoComment() *TodoComment {
+	return &TodoComment{}
+}
+
+func (d *TodoComment) TodoUUID() uuid.UUID { return d.todoUUID }
+
+func (d *TodoComment) SetTodoUUID(id uuid.UUID) { d.todoUUID = id }
+
+// ParentUUID the root comment of the thread, nil for the root comments
+func (d *TodoComment) ParentUUID() *uuid.UUID { return d.parentUUID }
+
+func (d *TodoComment) SetParentUUID(id *uuid.UUID) { d.parentUUID = id }
+
+// Author the id of the user who wrote the comment, nil for the anonymous comments
+func (d *TodoComment) Author() *string { return d.author }
+
+func (d *TodoComment) SetAuthor(author *string) { d.author = author }
+
+func (d *TodoComment) Body() string { return d.body }
+
+func (d *TodoComment) SetBody(body string) { d.body = body }
+
+// Mentions the ids of the users mentioned by the body
+func (d *TodoComment) Mentions() []string { return d.mentions }
+
+func (d *TodoComment) SetMentions(mentions []string) { d.mentions = mentions }
+
+// ReplyCount the count of the replies of the root comment, it is filled by the lists only
+func (d *TodoComment) ReplyCount() int64 { return d.replyCount }
+
+// Edited the body is changed after the creation
+func (d *TodoComment) Edited() bool { return d.UpdatedAt().After(d.CreatedAt()) }
+
+func (d *TodoComment) FromDB(src *model.TodoComments) *TodoComment {
+	if src == nil {
+		return nil
+	}
+
+	// base
+	d.SetID(&src.ID)
+	d.SetUUID(&src.Uuid)
+	d.SetCreatedAt(&src.CreatedAt)
+	d.SetUpdatedAt(&src.UpdatedAt)
+	d.SetDeletedAt(&src.DeletedAt.Time)
+	//fields
+	d.todoUUID = src.TodoUuid
+	d.parentUUID = src.ParentUuid
+	d.author = src.Author
+	d.body = src.Body
+	d.replyCount = src.ReplyCount
+
+	d.mentions = make([]string, 0)
+	_ = json.Unmarshal([]byte(src.Mentions), &d.mentions)
+
+	return d
+}
+
+func (d *TodoComment) ToDB() *model.TodoComments {
+	mentions := d.mentions
+	if mentions == nil {
+		mentions = make([]string, 0)
+	}
+
+	encoded, _ := json.Marshal(mentions)
+
+	return &model.TodoComments{
+		BaseSql: model.BaseSql{
+			Uuid: d.UUID(),
+		},
+		TodoUuid:   d.todoUUID,
+		ParentUuid: d.parentUUID,
+		Author:     d.author,
+		Body:       d.body,
+		Mentions:   string(encoded),
+	}
+}
+
+//
+
+func NewTodoCommentList() *TodoCommentList { return &TodoCommentList{} }
+
+func (cl *TodoCommentList) SetTotal(total int64) { cl.total = total }
+
+func (cl *TodoCommentList) Total() int64 { return cl.total }
+
+func (cl *TodoCommentList) List() []*TodoComment { return cl.list }
+
+func (cl *TodoCommentList) ListFromDB(src []*model.TodoComments) []*TodoComment {
+	cl.list = make([]*TodoComment, 0, len(src))
+
+	for _, m := range src {
+		cl.list = append(cl.list, NewTodoComment().FromDB(m))
+	}
+
+	return cl.list
+}
+
+//
+
+func NewTodoCommentListReqQryParam() *TodoCommentListReqQryParam {
+	return &TodoCommentListReqQryParam{}
+}
+
+func (qp *TodoCommentListReqQryParam) SetParent(id *uuid.UUID) { qp.parent = id }
+
+// Parent the root comment of the listed replies, nil lists the root comments
+func (qp *TodoCommentListReqQryParam) Parent() *uuid.UUID { return qp.parent }
+
+// Order default order: asc, the conversation is read in the chronological order
+func (qp *TodoCommentListReqQryParam) Order() string {
+	if qp.order != nil {
+		return *qp.order
+	}
+
+	return "asc"
+}
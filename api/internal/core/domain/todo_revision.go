@@ -0,0 +1,288 @@
+package domain
+
+import (
+	"encoding/json"
+	"microservice/internal/adapter/orm/model"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// actions of the revisions
+const (
+	RevisionCreate   = "create"
+	RevisionUpdate   = "update"
+	RevisionComplete = "complete"
+	RevisionDelete   = "delete"
+	RevisionRestore  = "restore"
+	RevisionRevert   = "revert"
+	RevisionMove     = "move"
+	RevisionAssign   = "assign"
+)
+
+type (
+	// TodoSnapshot the state of the item recorded by the revisions
+	TodoSnapshot struct {
+		Description string     `json:"description"`
+		DueDate     *time.Time `json:"dueDate"`
+		AllDay      bool       `json:"allDay"`
+		DueTimeZone *string    `json:"dueTimeZone"`
+		Notes       *string    `json:"notes"`
+		Recurrence  *string    `json:"recurrence,omitempty"`
+		Language    string     `json:"language"`
+		CompletedAt *time.Time `json:"completedAt"`
+		Status      string     `json:"status,omitempty"` // empty for the revisions older than the board
+		Version     uint       `json:"version"`
+	}
+
+	// TodoChange the values of a changed field of the snapshot
+	TodoChange struct {
+		From any `json:"from"`
+		To   any `json:"to"`
+	}
+
+	TodoRevision struct {
+		id        uint
+		todoUUID  uuid.UUID
+		action    string
+		actor     *string
+		requestID *string
+		before    *TodoSnapshot
+		after     *TodoSnapshot
+		diff      map[string]TodoChange
+		createdAt time.Time
+	}
+
+	TodoRevisionList struct {
+		total int64
+		list  []*TodoRevision
+	}
+)
+
+// Snapshot the state of the item, nil for the missing item
+func (d *Todo) Snapshot() *TodoSnapshot {
+	if d == nil {
+		return nil
+	}
+
+	snapshot := &TodoSnapshot{
+		DueDate:     d.DueDate(),
+		AllDay:      d.AllDay(),
+		DueTimeZone: d.DueTimeZone(),
+		Notes:       d.Notes(),
+		Recurrence:  d.Recurrence(),
+		Language:    d.Language(),
+		CompletedAt: d.CompletedAt(),
+		Status:      d.Status(),
+		Version:     d.Version(),
+	}
+
+	if d.Description() != nil {
+		snapshot.Description = *d.Description()
+	}
+
+	return snapshot
+}
+
+// Apply copies the state of the snapshot to the item, the version is not copied
+func (s *TodoSnapshot) Apply(d *Todo) *Todo {
+	description := s.Description
+
+	d.SetDescription(&description)
+	d.SetDueDate(s.DueDate)
+	d.SetAllDay(s.AllDay)
+	d.SetDueTimeZone(s.DueTimeZone)
+	d.SetNotes(s.Notes)
+	d.SetRecurrence(s.Recurrence)
+	d.SetCompletedAt(s.CompletedAt)
+
+	// the revisions older than the board have the completion only
+	status := s.Status
+	switch {
+	case len(status) > 0:
+	case s.CompletedAt != nil:
+		status = StatusDone
+	default:
+		status = StatusTodo
+	}
+
+	d.SetStatus(&status)
+
+	if len(s.Language) > 0 {
+		language := s.Language
+		d.SetLanguage(&language)
+	}
+
+	return d
+}
+
+// NewTodoRevision the revision of the write, the before of the creates and the after of the deletes are nil
+func NewTodoRevision(action string, before, after *Todo) *TodoRevision {
+	rev := &TodoRevision{action: action, before: before.Snapshot(), after: after.Snapshot()}
+
+	switch {
+	case after != nil:
+		rev.todoUUID = after.UUID()
+	case before != nil:
+		rev.todoUUID = before.UUID()
+	}
+
+	rev.diff = DiffSnapshots(rev.before, rev.after)
+
+	// the assignees are not a part of the snapshot as they are never reverted, the assignments record their change in the diff
+	if action == RevisionAssign && before != nil && after != nil && !reflect.DeepEqual(before.Assignees(), after.Assignees()) {
+		rev.diff["assignees"] = TodoChange{From: before.Assignees(), To: after.Assignees()}
+	}
+
+	return rev
+}
+
+// DiffSnapshots the changed fields of the snapshots, the version is excluded as it changes on every write
+func DiffSnapshots(before, after *TodoSnapshot) map[string]TodoChange {
+	from, to := snapshotFields(before), snapshotFields(after)
+	diff := make(map[string]TodoChange)
+
+	for field := range unionKeys(from, to) {
+		if field == "version" {
+			continue
+		}
+
+		if !reflect.DeepEqual(from[field], to[field]) {
+			diff[field] = TodoChange{From: from[field], To: to[field]}
+		}
+	}
+
+	return diff
+}
+
+func NewTodoRevisionList() *TodoRevisionList { return &TodoRevisionList{} }
+
+func (rl *TodoRevisionList) SetTotal(total int64) { rl.total = total }
+
+func (rl *TodoRevisionList) Total() int64 { return rl.total }
+
+func (rl *TodoRevisionList) List() []*TodoRevision { return rl.list }
+
+func (rl *TodoRevisionList) ListFromDB(src []*model.TodoRevisions) []*TodoRevision {
+	rl.list = make([]*TodoRevision, 0, len(src))
+
+	for _, m := range src {
+		rl.list = append(rl.list, new(TodoRevision).FromDB(m))
+	}
+
+	return rl.list
+}
+
+//
+
+// ID the revision number, it increases with the order of the writes
+func (d *TodoRevision) ID() uint { return d.id }
+
+func (d *TodoRevision) SetID(id uint) { d.id = id }
+
+func (d *TodoRevision) TodoUUID() uuid.UUID { return d.todoUUID }
+
+func (d *TodoRevision) SetTodoUUID(id uuid.UUID) { d.todoUUID = id }
+
+func (d *TodoRevision) Action() string { return d.action }
+
+// Actor the id of the user who made the change, nil for the anonymous requests
+func (d *TodoRevision) Actor() *string { return d.actor }
+
+func (d *TodoRevision) SetActor(actor *string) { d.actor = actor }
+
+func (d *TodoRevision) RequestID() *string { return d.requestID }
+
+func (d *TodoRevision) SetRequestID(id *string) { d.requestID = id }
+
+func (d *TodoRevision) Before() *TodoSnapshot { return d.before }
+
+func (d *TodoRevision) After() *TodoSnapshot { return d.after }
+
+func (d *TodoRevision) Diff() map[string]TodoChange { return d.diff }
+
+func (d *TodoRevision) CreatedAt() time.Time { return d.createdAt }
+
+func (d *TodoRevision) FromDB(src *model.TodoRevisions) *TodoRevision {
+	if src == nil {
+		return nil
+	}
+
+	d.id = src.ID
+	d.todoUUID = src.TodoUuid
+	d.action = src.Action
+	d.actor = src.Actor
+	d.requestID = src.RequestId
+	d.before = unmarshalSnapshot(src.Before)
+	d.after = unmarshalSnapshot(src.After)
+	d.createdAt = src.CreatedAt
+
+	d.diff = make(map[string]TodoChange)
+	_ = json.Unmarshal([]byte(src.Diff), &d.diff)
+
+	return d
+}
+
+func (d *TodoRevision) ToDB() *model.TodoRevisions {
+	diff, _ := json.Marshal(d.diff)
+
+	return &model.TodoRevisions{
+		TodoUuid:  d.todoUUID,
+		Action:    d.action,
+		Actor:     d.actor,
+		RequestId: d.requestID,
+		Before:    marshalSnapshot(d.before),
+		After:     marshalSnapshot(d.after),
+		Diff:      string(diff),
+	}
+}
+
+// HELPERS
+
+// snapshotFields the JSON fields of the snapshot, so the diff has the same representation as the stored snapshots
+func snapshotFields(s *TodoSnapshot) map[string]any {
+	fields := make(map[string]any)
+	if s == nil {
+		return fields
+	}
+
+	encoded, _ := json.Marshal(s)
+	_ = json.Unmarshal(encoded, &fields)
+
+	return fields
+}
+
+func unionKeys(maps ...map[string]any) map[string]struct{} {
+	keys := make(map[string]struct{})
+	for _, m := range maps {
+		for key := range m {
+			keys[key] = struct{}{}
+		}
+	}
+
+	return keys
+}
+
+func marshalSnapshot(s *TodoSnapshot) *string {
+	if s == nil {
+		return nil
+	}
+
+	encoded, _ := json.Marshal(s)
+	value := string(encoded)
+	return &value
+}
+
+func unmarshalSnapshot(src *string) *TodoSnapshot {
+	if src == nil {
+		return nil
+	}
+
+	s := new(TodoSnapshot)
+	if err := json.Unmarshal([]byte(*src), s); err != nil {
+		return nil
+	}
+
+	return s
+}
@@ -0,0 +1,264 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"microservice/internal/adapter/orm/model"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// permissions on the items, every permission includes the lower ones
+const (
+	PermissionRead    = "read"
+	PermissionComment = "comment"
+	PermissionEdit    = "edit"
+	// PermissionOwner manages the shares of the item, it is not granted
+	PermissionOwner = "owner"
+)
+
+// grantee types of the shares
+const (
+	GranteeUser  = "user"
+	GranteeGroup = "group"
+)
+
+var permissionLevels = map[string]int{
+	PermissionRead:    1,
+	PermissionComment: 2,
+	PermissionEdit:    3,
+	PermissionOwner:   4,
+}
+
+// Permits the permission includes the wanted one, the unknown permissions permit nothing
+func Permits(have, want string) bool {
+	return permissionLevels[want] > 0 && permissionLevels[have] >= permissionLevels[want]
+}
+
+// HighestPermission of the permissions, empty when there is none
+func HighestPermission(permissions ...string) string {
+	highest := ""
+	for _, permission := range permissions {
+		if permissionLevels[permission] > permissionLevels[highest] {
+			highest = permission
+		}
+	}
+
+	return highest
+}
+
+// HashShareToken the stored form of the share-link token
+func HashShareToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// Viewer the user of the request with the groups of the user
+type Viewer struct {
+	actor  string
+	groups []string
+}
+
+func NewViewer(actor string, groups []string) *Viewer {
+	return &Viewer{actor: actor, groups: groups}
+}
+
+// Actor the id of the user, empty for the anonymous requests
+func (v *Viewer) Actor() string { return v.actor }
+
+func (v *Viewer) Groups() []string { return v.groups }
+
+func (v *Viewer) Anonymous() bool { return len(v.actor) == 0 }
+
+// Owns the item is created by the viewer, or it has no owner
+func (v *Viewer) Owns(item *Todo) bool {
+	return item.Owner() == nil || (!v.Anonymous() && *item.Owner() == v.actor)
+}
+
+// Grants the share is granted to the viewer or to a group of the viewer
+func (v *Viewer) Grants(share *TodoShare) bool {
+	if v.Anonymous() {
+		return false
+	}
+
+	switch share.GranteeType() {
+	case GranteeUser:
+		return share.Grantee() == v.actor
+	case GranteeGroup:
+		return slices.Contains(v.groups, share.Grantee())
+	}
+
+	return false
+}
+
+//
+
+type TodoShare struct {
+	Base
+	todoUUID    uuid.UUID
+	granteeType string
+	grantee     string
+	permission  string
+	grantedBy   *string
+}
+
+func NewTodoShare() *TodoShare {
+	return &TodoShare{}
+}
+
+func (d *TodoShare) TodoUUID() uuid.UUID { return d.todoUUID }
+
+func (d *TodoShare) SetTodoUUID(id uuid.UUID) { d.todoUUID = id }
+
+// GranteeType `user` or `group`
+func (d *TodoShare) GranteeType() string { return d.granteeType }
+
+func (d *TodoShare) SetGranteeType(granteeType string) { d.granteeType = granteeType }
+
+// Grantee the id of the user or the group
+func (d *TodoShare) Grantee() string { return d.grantee }
+
+func (d *TodoShare) SetGrantee(grantee string) { d.grantee = grantee }
+
+// Permission `read`, `comment` or `edit`
+func (d *TodoShare) Permission() string { return d.permission }
+
+func (d *TodoShare) SetPermission(permission string) { d.permission = permission }
+
+// GrantedBy the id of the user who granted the permission
+func (d *TodoShare) GrantedBy() *string { return d.grantedBy }
+
+func (d *TodoShare) SetGrantedBy(grantedBy *string) { d.grantedBy = grantedBy }
+
+func (d *TodoShare) FromDB(src *model.TodoShares) *TodoShare {
+	if src == nil {
+		return nil
+	}
+
+	// base
+	d.SetID(&src.ID)
+	d.SetUUID(&src.Uuid)
+	d.SetCreatedAt(&src.CreatedAt)
+	d.SetUpdatedAt(&src.UpdatedAt)
+	//fields
+	d.todoUUID = src.TodoUuid
+	d.granteeType = src.GranteeType
+	d.grantee = src.Grantee
+	d.permission = src.Permission
+	d.grantedBy = src.GrantedBy
+
+	return d
+}
+
+func (d *TodoShare) ToDB() *model.TodoShares {
+	return &model.TodoShares{
+		Uuid:        d.UUID(),
+		TodoUuid:    d.todoUUID,
+		GranteeType: d.granteeType,
+		Grantee:     d.grantee,
+		Permission:  d.permission,
+		GrantedBy:   d.grantedBy,
+	}
+}
+
+func SharesFromDB(src []*model.TodoShares) []*TodoShare {
+	list := make([]*TodoShare, 0, len(src))
+
+	for _, m := range src {
+		list = append(list, NewTodoShare().FromDB(m))
+	}
+
+	return list
+}
+
+//
+
+// TodoShareLink grants the read-only access to the holders of its token
+type TodoShareLink struct {
+	Base
+	todoUUID  uuid.UUID
+	token     string
+	tokenHash string
+	expiresAt *time.Time
+	createdBy *string
+}
+
+func NewTodoShareLink() *TodoShareLink {
+	return &TodoShareLink{}
+}
+
+func (d *TodoShareLink) TodoUUID() uuid.UUID { return d.todoUUID }
+
+func (d *TodoShareLink) SetTodoUUID(id uuid.UUID) { d.todoUUID = id }
+
+// Token the plain token, it is known only when the link is created
+func (d *TodoShareLink) Token() string { return d.token }
+
+// SetToken sets the token with its hash
+func (d *TodoShareLink) SetToken(token string) {
+	d.token = token
+	d.tokenHash = HashShareToken(token)
+}
+
+func (d *TodoShareLink) TokenHash() string { return d.tokenHash }
+
+// ExpiresAt nil for the links without expiry
+func (d *TodoShareLink) ExpiresAt() *time.Time { return d.expiresAt }
+
+func (d *TodoShareLink) SetExpiresAt(t *time.Time) { d.expiresAt = t }
+
+func (d *TodoShareLink) Expired(now time.Time) bool {
+	return d.expiresAt != nil && !now.Before(*d.expiresAt)
+}
+
+func (d *TodoShareLink) CreatedBy() *string { return d.createdBy }
+
+func (d *TodoShareLink) SetCreatedBy(createdBy *string) { d.createdBy = createdBy }
+
+func (d *TodoShareLink) FromDB(src *model.TodoShareLinks) *TodoShareLink {
+	if src == nil {
+		return nil
+	}
+
+	// base
+	d.SetID(&src.ID)
+	d.SetUUID(&src.Uuid)
+	d.SetCreatedAt(&src.CreatedAt)
+	d.SetUpdatedAt(&src.UpdatedAt)
+	//fields
+	d.todoUUID = src.TodoUuid
+	d.tokenHash = src.TokenHash
+	d.expiresAt = src.ExpiresAt
+	d.createdBy = src.CreatedBy
+
+	return d
+}
+
+func (d *TodoShareLink) ToDB() *model.TodoShareLinks {
+	return &model.TodoShareLinks{
+		Uuid:      d.UUID(),
+		TodoUuid:  d.todoUUID,
+		TokenHash: d.tokenHash,
+		ExpiresAt: func() *time.Time {
+			if d.expiresAt == nil {
+				return nil
+			}
+
+			utc := d.expiresAt.UTC()
+			return &utc
+		}(),
+		CreatedBy: d.createdBy,
+	}
+}
+
+func ShareLinksFromDB(src []*model.TodoShareLinks) []*TodoShareLink {
+	list := make([]*TodoShareLink, 0, len(src))
+
+	for _, m := range src {
+		list = append(list, NewTodoShareLink().FromDB(m))
+	}
+
+	return list
+}
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"microservice/internal/adapter/orm/model"
+)
+
+func TestTodo_FromDB(t *testing.T) {
+	t.Run("the highlight of a description with markup is escaped", func(t *testing.T) {
+		m := model.NewTodo()
+		m.Highlight = `<img src=x onerror="alert(1)"> buy ` + HighlightStart + "milk" + HighlightStop + " <script>steal()</script>"
+
+		d := NewTodo().FromDB(m)
+
+		assert.Equal(t, "&lt;img src=x onerror=&#34;alert(1)&#34;&gt; buy <mark>milk</mark> &lt;script&gt;steal()&lt;/script&gt;", *d.Highlight())
+	})
+}
+
+func TestTodoListReqQryParam_ApplyView(t *testing.T) {
+	// the server zone is a day ahead of UTC at the time
+	local := time.Local
+	time.Local = time.FixedZone("UTC+14", 14*60*60)
+	t.Cleanup(func() { time.Local = local })
+
+	now := time.Date(2025, 8, 7, 20, 0, 0, 0, time.UTC)
+	view := ViewToday
+
+	t.Run("the views are in UTC without the time zone of the caller", func(t *testing.T) {
+		qp := NewTodoListReqQryParam()
+		qp.SetView(&view)
+		qp.ApplyView(now)
+
+		assert.Equal(t, time.Date(2025, 8, 7, 0, 0, 0, 0, time.UTC), qp.DueAfter().UTC())
+		assert.Equal(t, time.Date(2025, 8, 8, 0, 0, 0, 0, time.UTC), qp.DueBefore().UTC())
+	})
+
+	t.Run("the views are in the time zone of the caller", func(t *testing.T) {
+		berlin, err := time.LoadLocation("Europe/Berlin")
+		assert.NoError(t, err)
+
+		qp := NewTodoListReqQryParam()
+		qp.SetView(&view)
+		qp.SetLocation(berlin)
+		qp.ApplyView(now)
+
+		assert.Equal(t, time.Date(2025, 8, 6, 22, 0, 0, 0, time.UTC), qp.DueAfter().UTC())
+		assert.Equal(t, time.Date(2025, 8, 7, 22, 0, 0, 0, time.UTC), qp.DueBefore().UTC())
+	})
+}
@@ -0,0 +1,50 @@
+package domain
+
+import "microservice/internal/adapter/orm/model"
+
+// TodoWorkload the open items of an assignee, the unassigned items are counted without assignee
+type TodoWorkload struct {
+	assignee *string
+	open     int64
+	overdue  int64
+}
+
+func NewTodoWorkload() *TodoWorkload {
+	return &TodoWorkload{}
+}
+
+// Assignee nil for the unassigned items
+func (d *TodoWorkload) Assignee() *string { return d.assignee }
+
+func (d *TodoWorkload) SetAssignee(assignee *string) { d.assignee = assignee }
+
+// Open the count of the items not completed yet
+func (d *TodoWorkload) Open() int64 { return d.open }
+
+func (d *TodoWorkload) SetOpen(open int64) { d.open = open }
+
+// Overdue the count of the open items with a passed due date
+func (d *TodoWorkload) Overdue() int64 { return d.overdue }
+
+func (d *TodoWorkload) SetOverdue(overdue int64) { d.overdue = overdue }
+
+func (d *TodoWorkload) FromDB(src *model.TodoWorkloads) *TodoWorkload {
+	if src == nil {
+		return nil
+	}
+
+	d.SetAssignee(src.Assignee)
+	d.SetOpen(src.Open)
+	d.SetOverdue(src.Overdue)
+	return d
+}
+
+func WorkloadsFromDB(src []*model.TodoWorkloads) []*TodoWorkload {
+	list := make([]*TodoWorkload, 0, len(src))
+
+	for _, m := range src {
+		list = append(list, NewTodoWorkload().FromDB(m))
+	}
+
+	return list
+}
@@ -0,0 +1,21 @@
+package port
+
+import (
+	"context"
+	"microservice/internal/core/domain"
+	"time"
+)
+
+//go:generate mockgen -source=./idempotency_contract.go -destination=./mocks/idempotency_repository_mock.go -package=todo_repository_mock
+type IIdempotencyRepository interface {
+	IRepository
+	// Acquire stores the key as in-progress, the existing record is returned when the key is already taken.
+	// the expired records and the stale in-progress locks are taken over
+	Acquire(ctx context.Context, ent *domain.IdempotencyKey) (existing *domain.IdempotencyKey, acquired bool, err error)
+	// Complete stores the response of the acquired key
+	Complete(ctx context.Context, ent *domain.IdempotencyKey) error
+	// Release removes the acquired key, so the request can be retried with the same key
+	Release(ctx context.Context, ent *domain.IdempotencyKey) error
+	// DeleteExpired purges the records expired before the given time
+	DeleteExpired(ctx context.Context, before time.Time) error
+}
@@ -0,0 +1,114 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./idempotency_contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./idempotency_contract.go -destination=./mocks/idempotency_repository_mock.go -package=todo_repository_mock
+//
+
+// Package todo_repository_mock is a generated GoMock package.
+package todo_repository_mock
+
+import (
+	context "context"
+	orm "microservice/internal/adapter/orm"
+	domain "microservice/internal/core/domain"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIIdempotencyRepository is a mock of IIdempotencyRepository interface.
+type MockIIdempotencyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIIdempotencyRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIIdempotencyRepositoryMockRecorder is the mock recorder for MockIIdempotencyRepository.
+type MockIIdempotencyRepositoryMockRecorder struct {
+	mock *MockIIdempotencyRepository
+}
+
+// NewMockIIdempotencyRepository creates a new mock instance.
+func NewMockIIdempotencyRepository(ctrl *gomock.Controller) *MockIIdempotencyRepository {
+	mock := &MockIIdempotencyRepository{ctrl: ctrl}
+	mock.recorder = &MockIIdempotencyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIIdempotencyRepository) EXPECT() *MockIIdempotencyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Acquire mocks base method.
+func (m *MockIIdempotencyRepository) Acquire(ctx context.Context, ent *domain.IdempotencyKey) (*domain.IdempotencyKey, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Acquire", ctx, ent)
+	ret0, _ := ret[0].(*domain.IdempotencyKey)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Acquire indicates an expected call of Acquire.
+func (mr *MockIIdempotencyRepositoryMockRecorder) Acquire(ctx, ent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Acquire", reflect.TypeOf((*MockIIdempotencyRepository)(nil).Acquire), ctx, ent)
+}
+
+// Complete mocks base method.
+func (m *MockIIdempotencyRepository) Complete(ctx context.Context, ent *domain.IdempotencyKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", ctx, ent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockIIdempotencyRepositoryMockRecorder) Complete(ctx, ent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockIIdempotencyRepository)(nil).Complete), ctx, ent)
+}
+
+// DeleteExpired mocks base method.
+func (m *MockIIdempotencyRepository) DeleteExpired(ctx context.Context, before time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpired", ctx, before)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteExpired indicates an expected call of DeleteExpired.
+func (mr *MockIIdempotencyRepositoryMockRecorder) DeleteExpired(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpired", reflect.TypeOf((*MockIIdempotencyRepository)(nil).DeleteExpired), ctx, before)
+}
+
+// Release mocks base method.
+func (m *MockIIdempotencyRepository) Release(ctx context.Context, ent *domain.IdempotencyKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, ent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockIIdempotencyRepositoryMockRecorder) Release(ctx, ent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockIIdempotencyRepository)(nil).Release), ctx, ent)
+}
+
+// Tx mocks base method.
+func (m *MockIIdempotencyRepository) Tx(db orm.ISql) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Tx", db)
+}
+
+// Tx indicates an expected call of Tx.
+func (mr *MockIIdempotencyRepositoryMockRecorder) Tx(db any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tx", reflect.TypeOf((*MockIIdempotencyRepository)(nil).Tx), db)
+}
@@ -0,0 +1,244 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./todo_attachment_contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./todo_attachment_contract.go -destination=./mocks/todo_attachment_repository_mock.go -package=todo_repository_mock
+//
+
+// Package todo_repository_mock is a generated GoMock package.
+package todo_repository_mock
+
+import (
+	context "context"
+	orm "microservice/internal/adapter/orm"
+	domain "microservice/internal/core/domain"
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockITodoAttachmentRepository is a mock of ITodoAttachmentRepository interface.
+type MockITodoAttachmentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockITodoAttachmentRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockITodoAttachmentRepositoryMockRecorder is the mock recorder for MockITodoAttachmentRepository.
+type MockITodoAttachmentRepositoryMockRecorder struct {
+	mock *MockITodoAttachmentRepository
+}
+
+// NewMockITodoAttachmentRepository creates a new mock instance.
+func NewMockITodoAttachmentRepository(ctrl *gomock.Controller) *MockITodoAttachmentRepository {
+	mock := &MockITodoAttachmentRepository{ctrl: ctrl}
+	mock.recorder = &MockITodoAttachmentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITodoAttachmentRepository) EXPECT() *MockITodoAttachmentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockITodoAttachmentRepository) Create(ctx context.Context, ent *domain.TodoAttachment) (*domain.TodoAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, ent)
+	ret0, _ := ret[0].(*domain.TodoAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockITodoAttachmentRepositoryMockRecorder) Create(ctx, ent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockITodoAttachmentRepository)(nil).Create), ctx, ent)
+}
+
+// Delete mocks base method.
+func (m *MockITodoAttachmentRepository) Delete(ctx context.Context, ent *domain.TodoAttachment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, ent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockITodoAttachmentRepositoryMockRecorder) Delete(ctx, ent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockITodoAttachmentRepository)(nil).Delete), ctx, ent)
+}
+
+// DeleteByTodos mocks base method.
+func (m *MockITodoAttachmentRepository) DeleteByTodos(ctx context.Context, todoIDs []uuid.UUID) ([]*domain.TodoAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByTodos", ctx, todoIDs)
+	ret0, _ := ret[0].([]*domain.TodoAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteByTodos indicates an expected call of DeleteByTodos.
+func (mr *MockITodoAttachmentRepositoryMockRecorder) DeleteByTodos(ctx, todoIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByTodos", reflect.TypeOf((*MockITodoAttachmentRepository)(nil).DeleteByTodos), ctx, todoIDs)
+}
+
+// GetByUUID mocks base method.
+func (m *MockITodoAttachmentRepository) GetByUUID(ctx context.Context, todoID, id *uuid.UUID) (*domain.TodoAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUUID", ctx, todoID, id)
+	ret0, _ := ret[0].(*domain.TodoAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUUID indicates an expected call of GetByUUID.
+func (mr *MockITodoAttachmentRepositoryMockRecorder) GetByUUID(ctx, todoID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUUID", reflect.TypeOf((*MockITodoAttachmentRepository)(nil).GetByUUID), ctx, todoID, id)
+}
+
+// GetList mocks base method.
+func (m *MockITodoAttachmentRepository) GetList(ctx context.Context, todoID *uuid.UUID) ([]*domain.TodoAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetList", ctx, todoID)
+	ret0, _ := ret[0].([]*domain.TodoAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetList indicates an expected call of GetList.
+func (mr *MockITodoAttachmentRepositoryMockRecorder) GetList(ctx, todoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetList", reflect.TypeOf((*MockITodoAttachmentRepository)(nil).GetList), ctx, todoID)
+}
+
+// ReferencedKeys mocks base method.
+func (m *MockITodoAttachmentRepository) ReferencedKeys(ctx context.Context, keys []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReferencedKeys", ctx, keys)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReferencedKeys indicates an expected call of ReferencedKeys.
+func (mr *MockITodoAttachmentRepositoryMockRecorder) ReferencedKeys(ctx, keys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReferencedKeys", reflect.TypeOf((*MockITodoAttachmentRepository)(nil).ReferencedKeys), ctx, keys)
+}
+
+// Tx mocks base method.
+func (m *MockITodoAttachmentRepository) Tx(db orm.ISql) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Tx", db)
+}
+
+// Tx indicates an expected call of Tx.
+func (mr *MockITodoAttachmentRepositoryMockRecorder) Tx(db any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tx", reflect.TypeOf((*MockITodoAttachmentRepository)(nil).Tx), db)
+}
+
+// MockITodoAttachmentUsecase is a mock of ITodoAttachmentUsecase interface.
+type MockITodoAttachmentUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockITodoAttachmentUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockITodoAttachmentUsecaseMockRecorder is the mock recorder for MockITodoAttachmentUsecase.
+type MockITodoAttachmentUsecaseMockRecorder struct {
+	mock *MockITodoAttachmentUsecase
+}
+
+// NewMockITodoAttachmentUsecase creates a new mock instance.
+func NewMockITodoAttachmentUsecase(ctrl *gomock.Controller) *MockITodoAttachmentUsecase {
+	mock := &MockITodoAttachmentUsecase{ctrl: ctrl}
+	mock.recorder = &MockITodoAttachmentUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITodoAttachmentUsecase) EXPECT() *MockITodoAttachmentUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockITodoAttachmentUsecase) Delete(ctx context.Context, ent *domain.TodoAttachment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, ent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockITodoAttachmentUsecaseMockRecorder) Delete(ctx, ent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockITodoAttachmentUsecase)(nil).Delete), ctx, ent)
+}
+
+// Download mocks base method.
+func (m *MockITodoAttachmentUsecase) Download(ctx context.Context, todoID, id *uuid.UUID) (*domain.TodoAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Download", ctx, todoID, id)
+	ret0, _ := ret[0].(*domain.TodoAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Download indicates an expected call of Download.
+func (mr *MockITodoAttachmentUsecaseMockRecorder) Download(ctx, todoID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*MockITodoAttachmentUsecase)(nil).Download), ctx, todoID, id)
+}
+
+// GetList mocks base method.
+func (m *MockITodoAttachmentUsecase) GetList(ctx context.Context, todoID *uuid.UUID) ([]*domain.TodoAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetList", ctx, todoID)
+	ret0, _ := ret[0].([]*domain.TodoAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetList indicates an expected call of GetList.
+func (mr *MockITodoAttachmentUsecaseMockRecorder) GetList(ctx, todoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetList", reflect.TypeOf((*MockITodoAttachmentUsecase)(nil).GetList), ctx, todoID)
+}
+
+// SweepOrphans mocks base method.
+func (m *MockITodoAttachmentUsecase) SweepOrphans(ctx context.Context, before time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SweepOrphans", ctx, before)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SweepOrphans indicates an expected call of SweepOrphans.
+func (mr *MockITodoAttachmentUsecaseMockRecorder) SweepOrphans(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SweepOrphans", reflect.TypeOf((*MockITodoAttachmentUsecase)(nil).SweepOrphans), ctx, before)
+}
+
+// Upload mocks base method.
+func (m *MockITodoAttachmentUsecase) Upload(ctx context.Context, ent *domain.TodoAttachment) (*domain.TodoAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", ctx, ent)
+	ret0, _ := ret[0].(*domain.TodoAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upload indicates an expected call of Upload.
+func (mr *MockITodoAttachmentUsecaseMockRecorder) Upload(ctx, ent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockITodoAttachmentUsecase)(nil).Upload), ctx, ent)
+}
@@ -0,0 +1,212 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./todo_calendar_contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./todo_calendar_contract.go -destination=./mocks/todo_calendar_repository_mock.go -package=todo_repository_mock
+//
+
+// Package todo_repository_mock is a generated GoMock package.
+package todo_repository_mock
+
+import (
+	context "context"
+	orm "microservice/internal/adapter/orm"
+	domain "microservice/internal/core/domain"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockITodoCalendarRepository is a mock of ITodoCalendarRepository interface.
+type MockITodoCalendarRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockITodoCalendarRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockITodoCalendarRepositoryMockRecorder is the mock recorder for MockITodoCalendarRepository.
+type MockITodoCalendarRepositoryMockRecorder struct {
+	mock *MockITodoCalendarRepository
+}
+
+// NewMockITodoCalendarRepository creates a new mock instance.
+func NewMockITodoCalendarRepository(ctrl *gomock.Controller) *MockITodoCalendarRepository {
+	mock := &MockITodoCalendarRepository{ctrl: ctrl}
+	mock.recorder = &MockITodoCalendarRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITodoCalendarRepository) EXPECT() *MockITodoCalendarRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockITodoCalendarRepository) Create(ctx context.Context, ent *domain.TodoCalendarFeed) (*domain.TodoCalendarFeed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, ent)
+	ret0, _ := ret[0].(*domain.TodoCalendarFeed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockITodoCalendarRepositoryMockRecorder) Create(ctx, ent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockITodoCalendarRepository)(nil).Create), ctx, ent)
+}
+
+// Delete mocks base method.
+func (m *MockITodoCalendarRepository) Delete(ctx context.Context, owner string, id *uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, owner, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockITodoCalendarRepositoryMockRecorder) Delete(ctx, owner, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockITodoCalendarRepository)(nil).Delete), ctx, owner, id)
+}
+
+// GetByToken mocks base method.
+func (m *MockITodoCalendarRepository) GetByToken(ctx context.Context, tokenHash string) (*domain.TodoCalendarFeed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByToken", ctx, tokenHash)
+	ret0, _ := ret[0].(*domain.TodoCalendarFeed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByToken indicates an expected call of GetByToken.
+func (mr *MockITodoCalendarRepositoryMockRecorder) GetByToken(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByToken", reflect.TypeOf((*MockITodoCalendarRepository)(nil).GetByToken), ctx, tokenHash)
+}
+
+// GetList mocks base method.
+func (m *MockITodoCalendarRepository) GetList(ctx context.Context, owner string) ([]*domain.TodoCalendarFeed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetList", ctx, owner)
+	ret0, _ := ret[0].([]*domain.TodoCalendarFeed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetList indicates an expected call of GetList.
+func (mr *MockITodoCalendarRepositoryMockRecorder) GetList(ctx, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetList", reflect.TypeOf((*MockITodoCalendarRepository)(nil).GetList), ctx, owner)
+}
+
+// Tx mocks base method.
+func (m *MockITodoCalendarRepository) Tx(db orm.ISql) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Tx", db)
+}
+
+// Tx indicates an expected call of Tx.
+func (mr *MockITodoCalendarRepositoryMockRecorder) Tx(db any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tx", reflect.TypeOf((*MockITodoCalendarRepository)(nil).Tx), db)
+}
+
+// MockITodoCalendarUsecase is a mock of ITodoCalendarUsecase interface.
+type MockITodoCalendarUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockITodoCalendarUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockITodoCalendarUsecaseMockRecorder is the mock recorder for MockITodoCalendarUsecase.
+type MockITodoCalendarUsecaseMockRecorder struct {
+	mock *MockITodoCalendarUsecase
+}
+
+// NewMockITodoCalendarUsecase creates a new mock instance.
+func NewMockITodoCalendarUsecase(ctrl *gomock.Controller) *MockITodoCalendarUsecase {
+	mock := &MockITodoCalendarUsecase{ctrl: ctrl}
+	mock.recorder = &MockITodoCalendarUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITodoCalendarUsecase) EXPECT() *MockITodoCalendarUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CreateFeed mocks base method.
+func (m *MockITodoCalendarUsecase) CreateFeed(ctx context.Context, ent *domain.TodoCalendarFeed) (*domain.TodoCalendarFeed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFeed", ctx, ent)
+	ret0, _ := ret[0].(*domain.TodoCalendarFeed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFeed indicates an expected call of CreateFeed.
+func (mr *MockITodoCalendarUsecaseMockRecorder) CreateFeed(ctx, ent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFeed", reflect.TypeOf((*MockITodoCalendarUsecase)(nil).CreateFeed), ctx, ent)
+}
+
+// ExportFeed mocks base method.
+func (m *MockITodoCalendarUsecase) ExportFeed(ctx context.Context, feed *domain.TodoCalendarFeed, each func(*domain.Todo) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportFeed", ctx, feed, each)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportFeed indicates an expected call of ExportFeed.
+func (mr *MockITodoCalendarUsecaseMockRecorder) ExportFeed(ctx, feed, each any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportFeed", reflect.TypeOf((*MockITodoCalendarUsecase)(nil).ExportFeed), ctx, feed, each)
+}
+
+// GetFeed mocks base method.
+func (m *MockITodoCalendarUsecase) GetFeed(ctx context.Context, token string) (*domain.TodoCalendarFeed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeed", ctx, token)
+	ret0, _ := ret[0].(*domain.TodoCalendarFeed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeed indicates an expected call of GetFeed.
+func (mr *MockITodoCalendarUsecaseMockRecorder) GetFeed(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeed", reflect.TypeOf((*MockITodoCalendarUsecase)(nil).GetFeed), ctx, token)
+}
+
+// GetFeeds mocks base method.
+func (m *MockITodoCalendarUsecase) GetFeeds(ctx context.Context) ([]*domain.TodoCalendarFeed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeeds", ctx)
+	ret0, _ := ret[0].([]*domain.TodoCalendarFeed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeeds indicates an expected call of GetFeeds.
+func (mr *MockITodoCalendarUsecaseMockRecorder) GetFeeds(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeeds", reflect.TypeOf((*MockITodoCalendarUsecase)(nil).GetFeeds), ctx)
+}
+
+// RevokeFeed mocks base method.
+func (m *MockITodoCalendarUsecase) RevokeFeed(ctx context.Context, ent *domain.TodoCalendarFeed) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeFeed", ctx, ent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeFeed indicates an expected call of RevokeFeed.
+func (mr *MockITodoCalendarUsecaseMockRecorder) RevokeFeed(ctx, ent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeFeed", reflect.TypeOf((*MockITodoCalendarUsecase)(nil).RevokeFeed), ctx, ent)
+}
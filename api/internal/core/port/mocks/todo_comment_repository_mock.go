@@ -0,0 +1,243 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./todo_comment_contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./todo_comment_contract.go -destination=./mocks/todo_comment_repository_mock.go -package=todo_repository_mock
+//
+
+// Package todo_repository_mock is a generated GoMock package.
+package todo_repository_mock
+
+import (
+	context "context"
+	orm "microservice/internal/adapter/orm"
+	domain "microservice/internal/core/domain"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockITodoCommentRepository is a mock of ITodoCommentRepository interface.
+type MockITodoCommentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockITodoCommentRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockITodoCommentRepositoryMockRecorder is the mock recorder for MockITodoCommentRepository.
+type MockITodoCommentRepositoryMockRecorder struct {
+	mock *MockITodoCommentRepository
+}
+
+// NewMockITodoCommentRepository creates a new mock instance.
+func NewMockITodoCommentRepository(ctrl *gomock.Controller) *MockITodoCommentRepository {
+	mock := &MockITodoCommentRepository{ctrl: ctrl}
+	mock.recorder = &MockITodoCommentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITodoCommentRepository) EXPECT() *MockITodoCommentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockITodoCommentRepository) Create(ctx context.Context, ent *domain.TodoComment) (*domain.TodoComment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, ent)
+	ret0, _ := ret[0].(*domain.TodoComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockITodoCommentRepositoryMockRecorder) Create(ctx, ent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockITodoCommentRepository)(nil).Create), ctx, ent)
+}
+
+// Delete mocks base method.
+func (m *MockITodoCommentRepository) Delete(ctx context.Context, ent *domain.TodoComment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, ent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockITodoCommentRepositoryMockRecorder) Delete(ctx, ent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockITodoCommentRepository)(nil).Delete), ctx, ent)
+}
+
+// GetActivity mocks base method.
+func (m *MockITodoCommentRepository) GetActivity(ctx context.Context, todoID *uuid.UUID, qp *domain.TodoActivityReqQryParam) (*domain.TodoActivityList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivity", ctx, todoID, qp)
+	ret0, _ := ret[0].(*domain.TodoActivityList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivity indicates an expected call of GetActivity.
+func (mr *MockITodoCommentRepositoryMockRecorder) GetActivity(ctx, todoID, qp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivity", reflect.TypeOf((*MockITodoCommentRepository)(nil).GetActivity), ctx, todoID, qp)
+}
+
+// GetByUUID mocks base method.
+func (m *MockITodoCommentRepository) GetByUUID(ctx context.Context, todoID, id *uuid.UUID) (*domain.TodoComment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUUID", ctx, todoID, id)
+	ret0, _ := ret[0].(*domain.TodoComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUUID indicates an expected call of GetByUUID.
+func (mr *MockITodoCommentRepositoryMockRecorder) GetByUUID(ctx, todoID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUUID", reflect.TypeOf((*MockITodoCommentRepository)(nil).GetByUUID), ctx, todoID, id)
+}
+
+// GetList mocks base method.
+func (m *MockITodoCommentRepository) GetList(ctx context.Context, todoID *uuid.UUID, qp *domain.TodoCommentListReqQryParam) (*domain.TodoCommentList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetList", ctx, todoID, qp)
+	ret0, _ := ret[0].(*domain.TodoCommentList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetList indicates an expected call of GetList.
+func (mr *MockITodoCommentRepositoryMockRecorder) GetList(ctx, todoID, qp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetList", reflect.TypeOf((*MockITodoCommentRepository)(nil).GetList), ctx, todoID, qp)
+}
+
+// Tx mocks base method.
+func (m *MockITodoCommentRepository) Tx(db orm.ISql) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Tx", db)
+}
+
+// Tx indicates an expected call of Tx.
+func (mr *MockITodoCommentRepositoryMockRecorder) Tx(db any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tx", reflect.TypeOf((*MockITodoCommentRepository)(nil).Tx), db)
+}
+
+// Update mocks base method.
+func (m *MockITodoCommentRepository) Update(ctx context.Context, ent *domain.TodoComment) (*domain.TodoComment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, ent)
+	ret0, _ := ret[0].(*domain.TodoComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockITodoCommentRepositoryMockRecorder) Update(ctx, ent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockITodoCommentRepository)(nil).Update), ctx, ent)
+}
+
+// MockITodoCommentUsecase is a mock of ITodoCommentUsecase interface.
+type MockITodoCommentUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockITodoCommentUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockITodoCommentUsecaseMockRecorder is the mock recorder for MockITodoCommentUsecase.
+type MockITodoCommentUsecaseMockRecorder struct {
+	mock *MockITodoCommentUsecase
+}
+
+// NewMockITodoCommentUsecase creates a new mock instance.
+func NewMockITodoCommentUsecase(ctrl *gomock.Controller) *MockITodoCommentUsecase {
+	mock := &MockITodoCommentUsecase{ctrl: ctrl}
+	mock.recorder = &MockITodoCommentUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITodoCommentUsecase) EXPECT() *MockITodoCommentUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Activity mocks base method.
+func (m *MockITodoCommentUsecase) Activity(ctx context.Context, todoID *uuid.UUID, qp *domain.TodoActivityReqQryParam) (*domain.TodoActivityList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Activity", ctx, todoID, qp)
+	ret0, _ := ret[0].(*domain.TodoActivityList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Activity indicates an expected call of Activity.
+func (mr *MockITodoCommentUsecaseMockRecorder) Activity(ctx, todoID, qp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Activity", reflect.TypeOf((*MockITodoCommentUsecase)(nil).Activity), ctx, todoID, qp)
+}
+
+// Create mocks base method.
+func (m *MockITodoCommentUsecase) Create(ctx context.Context, ent *domain.TodoComment) (*domain.TodoComment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, ent)
+	ret0, _ := ret[0].(*domain.TodoComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockITodoCommentUsecaseMockRecorder) Create(ctx, ent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockITodoCommentUsecase)(nil).Create), ctx, ent)
+}
+
+// Delete mocks base method.
+func (m *MockITodoCommentUsecase) Delete(ctx context.Context, ent *domain.TodoComment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, ent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockITodoCommentUsecaseMockRecorder) Delete(ctx, ent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockITodoCommentUsecase)(nil).Delete), ctx, ent)
+}
+
+// GetList mocks base method.
+func (m *MockITodoCommentUsecase) GetList(ctx context.Context, todoID *uuid.UUID, qp *domain.TodoCommentListReqQryParam) (*domain.TodoCommentList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetList", ctx, todoID, qp)
+	ret0, _ := ret[0].(*domain.TodoCommentList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetList indicates an expected call of GetList.
+func (mr *MockITodoCommentUsecaseMockRecorder) GetList(ctx, todoID, qp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetList", reflect.TypeOf((*MockITodoCommentUsecase)(nil).GetList), ctx, todoID, qp)
+}
+
+// Update mocks base method.
+func (m *MockITodoCommentUsecase) Update(ctx context.Context, ent *domain.TodoComment) (*domain.TodoComment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, ent)
+	ret0, _ := ret[0].(*domain.TodoComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockITodoCommentUsecaseMockRecorder) Update(ctx, ent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockITodoCommentUsecase)(nil).Update), ctx, ent)
+}
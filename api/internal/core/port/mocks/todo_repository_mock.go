@@ -13,7 +13,9 @@ import (
 	context "context"
 	orm "microservice/internal/adapter/orm"
 	domain "microservice/internal/core/domain"
+	port "microservice/internal/core/port"
 	reflect "reflect"
+	time "time"
 
 	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
@@ -43,6 +45,35 @@ func (m *MockITodoRepository) EXPECT() *MockITodoRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Assign mocks base method.
+func (m *MockITodoRepository) Assign(ctx context.Context, id *uuid.UUID, assignees []string, assignedBy *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assign", ctx, id, assignees, assignedBy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Assign indicates an expected call of Assign.
+func (mr *MockITodoRepositoryMockRecorder) Assign(ctx, id, assignees, assignedBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assign", reflect.TypeOf((*MockITodoRepository)(nil).Assign), ctx, id, assignees, assignedBy)
+}
+
+// Complete mocks base method.
+func (m *MockITodoRepository) Complete(ctx context.Context, ent *domain.Todo) (*domain.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", ctx, ent)
+	ret0, _ := ret[0].(*domain.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockITodoRepositoryMockRecorder) Complete(ctx, ent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockITodoRepository)(nil).Complete), ctx, ent)
+}
+
 // Create mocks base method.
 func (m *MockITodoRepository) Create(ctx context.Context, ent *domain.Todo) (*domain.Todo, error) {
 	m.ctrl.T.Helper()
@@ -58,6 +89,68 @@ func (mr *MockITodoRepositoryMockRecorder) Create(ctx, ent any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockITodoRepository)(nil).Create), ctx, ent)
 }
 
+// CreateInBatches mocks base method.
+func (m *MockITodoRepository) CreateInBatches(ctx context.Context, ents []*domain.Todo) ([]*domain.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInBatches", ctx, ents)
+	ret0, _ := ret[0].([]*domain.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInBatches indicates an expected call of CreateInBatches.
+func (mr *MockITodoRepositoryMockRecorder) CreateInBatches(ctx, ents any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInBatches", reflect.TypeOf((*MockITodoRepository)(nil).CreateInBatches), ctx, ents)
+}
+
+// CreateRevisions mocks base method.
+func (m *MockITodoRepository) CreateRevisions(ctx context.Context, revs ...*domain.TodoRevision) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range revs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateRevisions", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRevisions indicates an expected call of CreateRevisions.
+func (mr *MockITodoRepositoryMockRecorder) CreateRevisions(ctx any, revs ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, revs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRevisions", reflect.TypeOf((*MockITodoRepository)(nil).CreateRevisions), varargs...)
+}
+
+// Delete mocks base method.
+func (m *MockITodoRepository) Delete(ctx context.Context, ent *domain.Todo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, ent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockITodoRepositoryMockRecorder) Delete(ctx, ent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockITodoRepository)(nil).Delete), ctx, ent)
+}
+
+// Export mocks base method.
+func (m *MockITodoRepository) Export(ctx context.Context, qp *domain.TodoListReqQryParam, each func(*domain.Todo) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, qp, each)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockITodoRepositoryMockRecorder) Export(ctx, qp, each any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockITodoRepository)(nil).Export), ctx, qp, each)
+}
+
 // GetByUUID mocks base method.
 func (m *MockITodoRepository) GetByUUID(ctx context.Context, id *uuid.UUID) (*domain.Todo, error) {
 	m.ctrl.T.Helper()
@@ -73,6 +166,21 @@ func (mr *MockITodoRepositoryMockRecorder) GetByUUID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUUID", reflect.TypeOf((*MockITodoRepository)(nil).GetByUUID), ctx, id)
 }
 
+// GetDeletedBefore mocks base method.
+func (m *MockITodoRepository) GetDeletedBefore(ctx context.Context, before time.Time, limit int) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedBefore", ctx, before, limit)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedBefore indicates an expected call of GetDeletedBefore.
+func (mr *MockITodoRepositoryMockRecorder) GetDeletedBefore(ctx, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedBefore", reflect.TypeOf((*MockITodoRepository)(nil).GetDeletedBefore), ctx, before, limit)
+}
+
 // GetList mocks base method.
 func (m *MockITodoRepository) GetList(ctx context.Context, qp *domain.TodoListReqQryParam) (*domain.TodoList, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +196,184 @@ func (mr *MockITodoRepositoryMockRecorder) GetList(ctx, qp any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetList", reflect.TypeOf((*MockITodoRepository)(nil).GetList), ctx, qp)
 }
 
+// GetRevision mocks base method.
+func (m *MockITodoRepository) GetRevision(ctx context.Context, id *uuid.UUID, revision uint) (*domain.TodoRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevision", ctx, id, revision)
+	ret0, _ := ret[0].(*domain.TodoRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevision indicates an expected call of GetRevision.
+func (mr *MockITodoRepositoryMockRecorder) GetRevision(ctx, id, revision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevision", reflect.TypeOf((*MockITodoRepository)(nil).GetRevision), ctx, id, revision)
+}
+
+// GetRevisions mocks base method.
+func (m *MockITodoRepository) GetRevisions(ctx context.Context, id *uuid.UUID, qp *domain.ReqBaseQryParam) (*domain.TodoRevisionList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisions", ctx, id, qp)
+	ret0, _ := ret[0].(*domain.TodoRevisionList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisions indicates an expected call of GetRevisions.
+func (mr *MockITodoRepositoryMockRecorder) GetRevisions(ctx, id, qp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisions", reflect.TypeOf((*MockITodoRepository)(nil).GetRevisions), ctx, id, qp)
+}
+
+// GetWithDeleted mocks base method.
+func (m *MockITodoRepository) GetWithDeleted(ctx context.Context, id *uuid.UUID) (*domain.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWithDeleted", ctx, id)
+	ret0, _ := ret[0].(*domain.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWithDeleted indicates an expected call of GetWithDeleted.
+func (mr *MockITodoRepositoryMockRecorder) GetWithDeleted(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithDeleted", reflect.TypeOf((*MockITodoRepository)(nil).GetWithDeleted), ctx, id)
+}
+
+// Lock mocks base method.
+func (m *MockITodoRepository) Lock(ctx context.Context, id *uuid.UUID) (*domain.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock", ctx, id)
+	ret0, _ := ret[0].(*domain.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Lock indicates an expected call of Lock.
+func (mr *MockITodoRepositoryMockRecorder) Lock(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockITodoRepository)(nil).Lock), ctx, id)
+}
+
+// Move mocks base method.
+func (m *MockITodoRepository) Move(ctx context.Context, ent *domain.Todo) (*domain.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Move", ctx, ent)
+	ret0, _ := ret[0].(*domain.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Move indicates an expected call of Move.
+func (mr *MockITodoRepositoryMockRecorder) Move(ctx, ent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockITodoRepository)(nil).Move), ctx, ent)
+}
+
+// NeighbourRank mocks base method.
+func (m *MockITodoRepository) NeighbourRank(ctx context.Context, column, rank string, next bool) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NeighbourRank", ctx, column, rank, next)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NeighbourRank indicates an expected call of NeighbourRank.
+func (mr *MockITodoRepositoryMockRecorder) NeighbourRank(ctx, column, rank, next any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NeighbourRank", reflect.TypeOf((*MockITodoRepository)(nil).NeighbourRank), ctx, column, rank, next)
+}
+
+// Purge mocks base method.
+func (m *MockITodoRepository) Purge(ctx context.Context, ids []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockITodoRepositoryMockRecorder) Purge(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockITodoRepository)(nil).Purge), ctx, ids)
+}
+
+// Rebalance mocks base method.
+func (m *MockITodoRepository) Rebalance(ctx context.Context, column string, maxLength int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rebalance", ctx, column, maxLength)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rebalance indicates an expected call of Rebalance.
+func (mr *MockITodoRepositoryMockRecorder) Rebalance(ctx, column, maxLength any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rebalance", reflect.TypeOf((*MockITodoRepository)(nil).Rebalance), ctx, column, maxLength)
+}
+
+// Restore mocks base method.
+func (m *MockITodoRepository) Restore(ctx context.Context, ent *domain.Todo) (*domain.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, ent)
+	ret0, _ := ret[0].(*domain.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockITodoRepositoryMockRecorder) Restore(ctx, ent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockITodoRepository)(nil).Restore), ctx, ent)
+}
+
+// Revert mocks base method.
+func (m *MockITodoRepository) Revert(ctx context.Context, ent *domain.Todo) (*domain.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revert", ctx, ent)
+	ret0, _ := ret[0].(*domain.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Revert indicates an expected call of Revert.
+func (mr *MockITodoRepositoryMockRecorder) Revert(ctx, ent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revert", reflect.TypeOf((*MockITodoRepository)(nil).Revert), ctx, ent)
+}
+
+// Stats mocks base method.
+func (m *MockITodoRepository) Stats(ctx context.Context, now time.Time) (*domain.TodoWorkload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stats", ctx, now)
+	ret0, _ := ret[0].(*domain.TodoWorkload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stats indicates an expected call of Stats.
+func (mr *MockITodoRepositoryMockRecorder) Stats(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockITodoRepository)(nil).Stats), ctx, now)
+}
+
+// Transaction mocks base method.
+func (m *MockITodoRepository) Transaction(ctx context.Context, fn func(port.ITodoRepository) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transaction", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Transaction indicates an expected call of Transaction.
+func (mr *MockITodoRepositoryMockRecorder) Transaction(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transaction", reflect.TypeOf((*MockITodoRepository)(nil).Transaction), ctx, fn)
+}
+
 // Tx mocks base method.
 func (m *MockITodoRepository) Tx(db orm.ISql) {
 	m.ctrl.T.Helper()
@@ -100,6 +386,36 @@ func (mr *MockITodoRepositoryMockRecorder) Tx(db any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tx", reflect.TypeOf((*MockITodoRepository)(nil).Tx), db)
 }
 
+// Update mocks base method.
+func (m *MockITodoRepository) Update(ctx context.Context, ent *domain.Todo) (*domain.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, ent)
+	ret0, _ := ret[0].(*domain.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockITodoRepositoryMockRecorder) Update(ctx, ent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockITodoRepository)(nil).Update), ctx, ent)
+}
+
+// Workload mocks base method.
+func (m *MockITodoRepository) Workload(ctx context.Context, qp *domain.TodoListReqQryParam, now time.Time) ([]*domain.TodoWorkload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Workload", ctx, qp, now)
+	ret0, _ := ret[0].([]*domain.TodoWorkload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Workload indicates an expected call of Workload.
+func (mr *MockITodoRepositoryMockRecorder) Workload(ctx, qp, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Workload", reflect.TypeOf((*MockITodoRepository)(nil).Workload), ctx, qp, now)
+}
+
 // MockITodoUsecase is a mock of ITodoUsecase interface.
 type MockITodoUsecase struct {
 	ctrl     *gomock.Controller
@@ -124,6 +440,36 @@ func (m *MockITodoUsecase) EXPECT() *MockITodoUsecaseMockRecorder {
 	return m.recorder
 }
 
+// Assign mocks base method.
+func (m *MockITodoUsecase) Assign(ctx context.Context, ent *domain.Todo) (*domain.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assign", ctx, ent)
+	ret0, _ := ret[0].(*domain.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Assign indicates an expected call of Assign.
+func (mr *MockITodoUsecaseMockRecorder) Assign(ctx, ent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assign", reflect.TypeOf((*MockITodoUsecase)(nil).Assign), ctx, ent)
+}
+
+// Bulk mocks base method.
+func (m *MockITodoUsecase) Bulk(ctx context.Context, bulk *domain.TodoBulk) ([]*domain.TodoBulkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Bulk", ctx, bulk)
+	ret0, _ := ret[0].([]*domain.TodoBulkResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Bulk indicates an expected call of Bulk.
+func (mr *MockITodoUsecaseMockRecorder) Bulk(ctx, bulk any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bulk", reflect.TypeOf((*MockITodoUsecase)(nil).Bulk), ctx, bulk)
+}
+
 // Create mocks base method.
 func (m *MockITodoUsecase) Create(ctx context.Context, ent *domain.Todo) (*domain.Todo, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +485,20 @@ func (mr *MockITodoUsecaseMockRecorder) Create(ctx, ent any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockITodoUsecase)(nil).Create), ctx, ent)
 }
 
+// Delete mocks base method.
+func (m *MockITodoUsecase) Delete(ctx context.Context, ent *domain.Todo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, ent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockITodoUsecaseMockRecorder) Delete(ctx, ent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockITodoUsecase)(nil).Delete), ctx, ent)
+}
+
 // Detail mocks base method.
 func (m *MockITodoUsecase) Detail(ctx context.Context, id *uuid.UUID) (*domain.Todo, error) {
 	m.ctrl.T.Helper()
@@ -154,6 +514,20 @@ func (mr *MockITodoUsecaseMockRecorder) Detail(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Detail", reflect.TypeOf((*MockITodoUsecase)(nil).Detail), ctx, id)
 }
 
+// Export mocks base method.
+func (m *MockITodoUsecase) Export(ctx context.Context, qp *domain.TodoListReqQryParam, each func(*domain.Todo) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, qp, each)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockITodoUsecaseMockRecorder) Export(ctx, qp, each any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockITodoUsecase)(nil).Export), ctx, qp, each)
+}
+
 // GetList mocks base method.
 func (m *MockITodoUsecase) GetList(ctx context.Context, qp *domain.TodoListReqQryParam) (*domain.TodoList, error) {
 	m.ctrl.T.Helper()
@@ -168,3 +542,153 @@ func (mr *MockITodoUsecaseMockRecorder) GetList(ctx, qp any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetList", reflect.TypeOf((*MockITodoUsecase)(nil).GetList), ctx, qp)
 }
+
+// History mocks base method.
+func (m *MockITodoUsecase) History(ctx context.Context, id *uuid.UUID, qp *domain.ReqBaseQryParam) (*domain.TodoRevisionList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "History", ctx, id, qp)
+	ret0, _ := ret[0].(*domain.TodoRevisionList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// History indicates an expected call of History.
+func (mr *MockITodoUsecaseMockRecorder) History(ctx, id, qp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "History", reflect.TypeOf((*MockITodoUsecase)(nil).History), ctx, id, qp)
+}
+
+// Import mocks base method.
+func (m *MockITodoUsecase) Import(ctx context.Context, ents []*domain.Todo) ([]*domain.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, ents)
+	ret0, _ := ret[0].([]*domain.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockITodoUsecaseMockRecorder) Import(ctx, ents any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockITodoUsecase)(nil).Import), ctx, ents)
+}
+
+// Move mocks base method.
+func (m *MockITodoUsecase) Move(ctx context.Context, move *domain.TodoMove) (*domain.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Move", ctx, move)
+	ret0, _ := ret[0].(*domain.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Move indicates an expected call of Move.
+func (mr *MockITodoUsecaseMockRecorder) Move(ctx, move any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockITodoUsecase)(nil).Move), ctx, move)
+}
+
+// Purge mocks base method.
+func (m *MockITodoUsecase) Purge(ctx context.Context, before time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, before)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockITodoUsecaseMockRecorder) Purge(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockITodoUsecase)(nil).Purge), ctx, before)
+}
+
+// Rebalance mocks base method.
+func (m *MockITodoUsecase) Rebalance(ctx context.Context, maxLength int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rebalance", ctx, maxLength)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rebalance indicates an expected call of Rebalance.
+func (mr *MockITodoUsecaseMockRecorder) Rebalance(ctx, maxLength any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rebalance", reflect.TypeOf((*MockITodoUsecase)(nil).Rebalance), ctx, maxLength)
+}
+
+// Restore mocks base method.
+func (m *MockITodoUsecase) Restore(ctx context.Context, ent *domain.Todo) (*domain.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, ent)
+	ret0, _ := ret[0].(*domain.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockITodoUsecaseMockRecorder) Restore(ctx, ent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockITodoUsecase)(nil).Restore), ctx, ent)
+}
+
+// Revert mocks base method.
+func (m *MockITodoUsecase) Revert(ctx context.Context, ent *domain.Todo, revision uint) (*domain.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revert", ctx, ent, revision)
+	ret0, _ := ret[0].(*domain.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Revert indicates an expected call of Revert.
+func (mr *MockITodoUsecaseMockRecorder) Revert(ctx, ent, revision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revert", reflect.TypeOf((*MockITodoUsecase)(nil).Revert), ctx, ent, revision)
+}
+
+// Stats mocks base method.
+func (m *MockITodoUsecase) Stats(ctx context.Context) (*domain.TodoWorkload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stats", ctx)
+	ret0, _ := ret[0].(*domain.TodoWorkload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stats indicates an expected call of Stats.
+func (mr *MockITodoUsecaseMockRecorder) Stats(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockITodoUsecase)(nil).Stats), ctx)
+}
+
+// Update mocks base method.
+func (m *MockITodoUsecase) Update(ctx context.Context, ent *domain.Todo) (*domain.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, ent)
+	ret0, _ := ret[0].(*domain.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockITodoUsecaseMockRecorder) Update(ctx, ent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockITodoUsecase)(nil).Update), ctx, ent)
+}
+
+// Workload mocks base method.
+func (m *MockITodoUsecase) Workload(ctx context.Context) ([]*domain.TodoWorkload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Workload", ctx)
+	ret0, _ := ret[0].([]*domain.TodoWorkload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Workload indicates an expected call of Workload.
+func (mr *MockITodoUsecaseMockRecorder) Workload(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Workload", reflect.TypeOf((*MockITodoUsecase)(nil).Workload), ctx)
+}
@@ -0,0 +1,330 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./todo_share_contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./todo_share_contract.go -destination=./mocks/todo_share_repository_mock.go -package=todo_repository_mock
+//
+
+// Package todo_repository_mock is a generated GoMock package.
+package todo_repository_mock
+
+import (
+	context "context"
+	orm "microservice/internal/adapter/orm"
+	domain "microservice/internal/core/domain"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockITodoShareRepository is a mock of ITodoShareRepository interface.
+type MockITodoShareRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockITodoShareRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockITodoShareRepositoryMockRecorder is the mock recorder for MockITodoShareRepository.
+type MockITodoShareRepositoryMockRecorder struct {
+	mock *MockITodoShareRepository
+}
+
+// NewMockITodoShareRepository creates a new mock instance.
+func NewMockITodoShareRepository(ctrl *gomock.Controller) *MockITodoShareRepository {
+	mock := &MockITodoShareRepository{ctrl: ctrl}
+	mock.recorder = &MockITodoShareRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITodoShareRepository) EXPECT() *MockITodoShareRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateLink mocks base method.
+func (m *MockITodoShareRepository) CreateLink(ctx context.Context, ent *domain.TodoShareLink) (*domain.TodoShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLink", ctx, ent)
+	ret0, _ := ret[0].(*domain.TodoShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLink indicates an expected call of CreateLink.
+func (mr *MockITodoShareRepositoryMockRecorder) CreateLink(ctx, ent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLink", reflect.TypeOf((*MockITodoShareRepository)(nil).CreateLink), ctx, ent)
+}
+
+// Delete mocks base method.
+func (m *MockITodoShareRepository) Delete(ctx context.Context, todoID, id *uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, todoID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockITodoShareRepositoryMockRecorder) Delete(ctx, todoID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockITodoShareRepository)(nil).Delete), ctx, todoID, id)
+}
+
+// DeleteByTodos mocks base method.
+func (m *MockITodoShareRepository) DeleteByTodos(ctx context.Context, todoIDs []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByTodos", ctx, todoIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByTodos indicates an expected call of DeleteByTodos.
+func (mr *MockITodoShareRepositoryMockRecorder) DeleteByTodos(ctx, todoIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByTodos", reflect.TypeOf((*MockITodoShareRepository)(nil).DeleteByTodos), ctx, todoIDs)
+}
+
+// DeleteLink mocks base method.
+func (m *MockITodoShareRepository) DeleteLink(ctx context.Context, todoID, id *uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLink", ctx, todoID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLink indicates an expected call of DeleteLink.
+func (mr *MockITodoShareRepositoryMockRecorder) DeleteLink(ctx, todoID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLink", reflect.TypeOf((*MockITodoShareRepository)(nil).DeleteLink), ctx, todoID, id)
+}
+
+// GetLinkByToken mocks base method.
+func (m *MockITodoShareRepository) GetLinkByToken(ctx context.Context, tokenHash string) (*domain.TodoShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLinkByToken", ctx, tokenHash)
+	ret0, _ := ret[0].(*domain.TodoShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLinkByToken indicates an expected call of GetLinkByToken.
+func (mr *MockITodoShareRepositoryMockRecorder) GetLinkByToken(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLinkByToken", reflect.TypeOf((*MockITodoShareRepository)(nil).GetLinkByToken), ctx, tokenHash)
+}
+
+// GetLinks mocks base method.
+func (m *MockITodoShareRepository) GetLinks(ctx context.Context, todoID *uuid.UUID) ([]*domain.TodoShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLinks", ctx, todoID)
+	ret0, _ := ret[0].([]*domain.TodoShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLinks indicates an expected call of GetLinks.
+func (mr *MockITodoShareRepositoryMockRecorder) GetLinks(ctx, todoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLinks", reflect.TypeOf((*MockITodoShareRepository)(nil).GetLinks), ctx, todoID)
+}
+
+// GetList mocks base method.
+func (m *MockITodoShareRepository) GetList(ctx context.Context, todoID *uuid.UUID) ([]*domain.TodoShare, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetList", ctx, todoID)
+	ret0, _ := ret[0].([]*domain.TodoShare)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetList indicates an expected call of GetList.
+func (mr *MockITodoShareRepositoryMockRecorder) GetList(ctx, todoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetList", reflect.TypeOf((*MockITodoShareRepository)(nil).GetList), ctx, todoID)
+}
+
+// Permission mocks base method.
+func (m *MockITodoShareRepository) Permission(ctx context.Context, todoID *uuid.UUID, viewer *domain.Viewer) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Permission", ctx, todoID, viewer)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Permission indicates an expected call of Permission.
+func (mr *MockITodoShareRepositoryMockRecorder) Permission(ctx, todoID, viewer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Permission", reflect.TypeOf((*MockITodoShareRepository)(nil).Permission), ctx, todoID, viewer)
+}
+
+// Tx mocks base method.
+func (m *MockITodoShareRepository) Tx(db orm.ISql) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Tx", db)
+}
+
+// Tx indicates an expected call of Tx.
+func (mr *MockITodoShareRepositoryMockRecorder) Tx(db any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tx", reflect.TypeOf((*MockITodoShareRepository)(nil).Tx), db)
+}
+
+// Upsert mocks base method.
+func (m *MockITodoShareRepository) Upsert(ctx context.Context, ent *domain.TodoShare) (*domain.TodoShare, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, ent)
+	ret0, _ := ret[0].(*domain.TodoShare)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockITodoShareRepositoryMockRecorder) Upsert(ctx, ent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockITodoShareRepository)(nil).Upsert), ctx, ent)
+}
+
+// MockITodoShareUsecase is a mock of ITodoShareUsecase interface.
+type MockITodoShareUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockITodoShareUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockITodoShareUsecaseMockRecorder is the mock recorder for MockITodoShareUsecase.
+type MockITodoShareUsecaseMockRecorder struct {
+	mock *MockITodoShareUsecase
+}
+
+// NewMockITodoShareUsecase creates a new mock instance.
+func NewMockITodoShareUsecase(ctrl *gomock.Controller) *MockITodoShareUsecase {
+	mock := &MockITodoShareUsecase{ctrl: ctrl}
+	mock.recorder = &MockITodoShareUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITodoShareUsecase) EXPECT() *MockITodoShareUsecaseMockRecorder {
+	return m.recorder
+}
+
+// ByLink mocks base method.
+func (m *MockITodoShareUsecase) ByLink(ctx context.Context, token string) (*domain.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByLink", ctx, token)
+	ret0, _ := ret[0].(*domain.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByLink indicates an expected call of ByLink.
+func (mr *MockITodoShareUsecaseMockRecorder) ByLink(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByLink", reflect.TypeOf((*MockITodoShareUsecase)(nil).ByLink), ctx, token)
+}
+
+// CreateLink mocks base method.
+func (m *MockITodoShareUsecase) CreateLink(ctx context.Context, ent *domain.TodoShareLink) (*domain.TodoShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLink", ctx, ent)
+	ret0, _ := ret[0].(*domain.TodoShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLink indicates an expected call of CreateLink.
+func (mr *MockITodoShareUsecaseMockRecorder) CreateLink(ctx, ent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLink", reflect.TypeOf((*MockITodoShareUsecase)(nil).CreateLink), ctx, ent)
+}
+
+// GetLinks mocks base method.
+func (m *MockITodoShareUsecase) GetLinks(ctx context.Context, todoID *uuid.UUID) ([]*domain.TodoShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLinks", ctx, todoID)
+	ret0, _ := ret[0].([]*domain.TodoShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLinks indicates an expected call of GetLinks.
+func (mr *MockITodoShareUsecaseMockRecorder) GetLinks(ctx, todoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLinks", reflect.TypeOf((*MockITodoShareUsecase)(nil).GetLinks), ctx, todoID)
+}
+
+// GetList mocks base method.
+func (m *MockITodoShareUsecase) GetList(ctx context.Context, todoID *uuid.UUID) ([]*domain.TodoShare, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetList", ctx, todoID)
+	ret0, _ := ret[0].([]*domain.TodoShare)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetList indicates an expected call of GetList.
+func (mr *MockITodoShareUsecaseMockRecorder) GetList(ctx, todoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetList", reflect.TypeOf((*MockITodoShareUsecase)(nil).GetList), ctx, todoID)
+}
+
+// Grant mocks base method.
+func (m *MockITodoShareUsecase) Grant(ctx context.Context, ent *domain.TodoShare) (*domain.TodoShare, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Grant", ctx, ent)
+	ret0, _ := ret[0].(*domain.TodoShare)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Grant indicates an expected call of Grant.
+func (mr *MockITodoShareUsecaseMockRecorder) Grant(ctx, ent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Grant", reflect.TypeOf((*MockITodoShareUsecase)(nil).Grant), ctx, ent)
+}
+
+// Revoke mocks base method.
+func (m *MockITodoShareUsecase) Revoke(ctx context.Context, ent *domain.TodoShare) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, ent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockITodoShareUsecaseMockRecorder) Revoke(ctx, ent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockITodoShareUsecase)(nil).Revoke), ctx, ent)
+}
+
+// RevokeLink mocks base method.
+func (m *MockITodoShareUsecase) RevokeLink(ctx context.Context, ent *domain.TodoShareLink) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeLink", ctx, ent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeLink indicates an expected call of RevokeLink.
+func (mr *MockITodoShareUsecaseMockRecorder) RevokeLink(ctx, ent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeLink", reflect.TypeOf((*MockITodoShareUsecase)(nil).RevokeLink), ctx, ent)
+}
+
+// SharedWithMe mocks base method.
+func (m *MockITodoShareUsecase) SharedWithMe(ctx context.Context, qp *domain.TodoListReqQryParam) (*domain.TodoList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SharedWithMe", ctx, qp)
+	ret0, _ := ret[0].(*domain.TodoList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SharedWithMe indicates an expected call of SharedWithMe.
+func (mr *MockITodoShareUsecaseMockRecorder) SharedWithMe(ctx, qp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SharedWithMe", reflect.TypeOf((*MockITodoShareUsecase)(nil).SharedWithMe), ctx, qp)
+}
@@ -0,0 +1,33 @@
+package port
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"microservice/internal/core/domain"
+	"time"
+)
+
+//go:generate mockgen -source=./todo_attachment_contract.go -destination=./mocks/todo_attachment_repository_mock.go -package=todo_repository_mock
+type ITodoAttachmentRepository interface {
+	IRepository
+	Create(ctx context.Context, ent *domain.TodoAttachment) (*domain.TodoAttachment, error)
+	// GetByUUID the attachment of the item
+	GetByUUID(ctx context.Context, todoID, id *uuid.UUID) (*domain.TodoAttachment, error)
+	GetList(ctx context.Context, todoID *uuid.UUID) ([]*domain.TodoAttachment, error)
+	Delete(ctx context.Context, ent *domain.TodoAttachment) error
+	// DeleteByTodos deletes the attachments of the items, the deleted ones are returned to remove their blobs
+	DeleteByTodos(ctx context.Context, todoIDs []uuid.UUID) ([]*domain.TodoAttachment, error)
+	// ReferencedKeys the storage keys which belong to an attachment
+	ReferencedKeys(ctx context.Context, keys []string) ([]string, error)
+}
+
+type ITodoAttachmentUsecase interface {
+	// Upload stores the content of the entity, the size and the sniffed MIME type are limited by the config
+	Upload(ctx context.Context, ent *domain.TodoAttachment) (*domain.TodoAttachment, error)
+	GetList(ctx context.Context, todoID *uuid.UUID) ([]*domain.TodoAttachment, error)
+	// Download the attachment with its content stream, the caller closes the content
+	Download(ctx context.Context, todoID, id *uuid.UUID) (*domain.TodoAttachment, error)
+	Delete(ctx context.Context, ent *domain.TodoAttachment) error
+	// SweepOrphans removes the blobs written before the time which belong to no attachment, like the blobs of the failed uploads
+	SweepOrphans(ctx context.Context, before time.Time) (removed int, err error)
+}
@@ -0,0 +1,28 @@
+package port
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"microservice/internal/core/domain"
+)
+
+//go:generate mockgen -source=./todo_calendar_contract.go -destination=./mocks/todo_calendar_repository_mock.go -package=todo_repository_mock
+type ITodoCalendarRepository interface {
+	IRepository
+	Create(ctx context.Context, ent *domain.TodoCalendarFeed) (*domain.TodoCalendarFeed, error)
+	GetList(ctx context.Context, owner string) ([]*domain.TodoCalendarFeed, error)
+	// GetByToken the feed of the token hash
+	GetByToken(ctx context.Context, tokenHash string) (*domain.TodoCalendarFeed, error)
+	Delete(ctx context.Context, owner string, id *uuid.UUID) error
+}
+
+type ITodoCalendarUsecase interface {
+	// CreateFeed the feed of the actor of the request, the token of the created feed is returned only once
+	CreateFeed(ctx context.Context, ent *domain.TodoCalendarFeed) (*domain.TodoCalendarFeed, error)
+	GetFeeds(ctx context.Context) ([]*domain.TodoCalendarFeed, error)
+	RevokeFeed(ctx context.Context, ent *domain.TodoCalendarFeed) error
+	// GetFeed the feed of the token, for the subscriptions of the calendars without identity
+	GetFeed(ctx context.Context, token string) (*domain.TodoCalendarFeed, error)
+	// ExportFeed streams the items readable by the owner of the feed, the event feeds list only the items with due date
+	ExportFeed(ctx context.Context, feed *domain.TodoCalendarFeed, each func(*domain.Todo) error) error
+}
@@ -0,0 +1,33 @@
+package port
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"microservice/internal/core/domain"
+)
+
+//go:generate mockgen -source=./todo_comment_contract.go -destination=./mocks/todo_comment_repository_mock.go -package=todo_repository_mock
+type ITodoCommentRepository interface {
+	IRepository
+	Create(ctx context.Context, ent *domain.TodoComment) (*domain.TodoComment, error)
+	// GetByUUID the comment of the item
+	GetByUUID(ctx context.Context, todoID, id *uuid.UUID) (*domain.TodoComment, error)
+	// GetList the root comments with their reply counts, or the replies of the parent
+	GetList(ctx context.Context, todoID *uuid.UUID, qp *domain.TodoCommentListReqQryParam) (*domain.TodoCommentList, error)
+	Update(ctx context.Context, ent *domain.TodoComment) (*domain.TodoComment, error)
+	// Delete soft-deletes the comment with its replies
+	Delete(ctx context.Context, ent *domain.TodoComment) error
+	// GetActivity the comments and the revisions of the item, merged in the order of their creation
+	GetActivity(ctx context.Context, todoID *uuid.UUID, qp *domain.TodoActivityReqQryParam) (*domain.TodoActivityList, error)
+}
+
+type ITodoCommentUsecase interface {
+	// Create the author is the actor of the request, the reply to a reply is added to the thread of its root comment
+	Create(ctx context.Context, ent *domain.TodoComment) (*domain.TodoComment, error)
+	GetList(ctx context.Context, todoID *uuid.UUID, qp *domain.TodoCommentListReqQryParam) (*domain.TodoCommentList, error)
+	// Update changes the body, only the author edits the own comments
+	Update(ctx context.Context, ent *domain.TodoComment) (*domain.TodoComment, error)
+	// Delete only the author deletes the own comments, the replies of the root comment are deleted too
+	Delete(ctx context.Context, ent *domain.TodoComment) error
+	Activity(ctx context.Context, todoID *uuid.UUID, qp *domain.TodoActivityReqQryParam) (*domain.TodoActivityList, error)
+}
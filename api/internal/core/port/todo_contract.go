@@ -4,18 +4,81 @@ import (
 	"context"
 	"github.com/google/uuid"
 	"microservice/internal/core/domain"
+	"time"
 )
 
 //go:generate mockgen -source=./todo_contract.go -destination=./mocks/todo_repository_mock.go -package=todo_repository_mock
 type ITodoRepository interface {
 	IRepository
 	Create(ctx context.Context, ent *domain.Todo) (*domain.Todo, error)
+	CreateInBatches(ctx context.Context, ents []*domain.Todo) ([]*domain.Todo, error)
 	GetByUUID(ctx context.Context, id *uuid.UUID) (*domain.Todo, error)
 	GetList(ctx context.Context, qp *domain.TodoListReqQryParam) (*domain.TodoList, error)
+	// Export streams the items of the list filters to the callback in the list order, the pagination is not applied
+	Export(ctx context.Context, qp *domain.TodoListReqQryParam, each func(*domain.Todo) error) error
+	Update(ctx context.Context, ent *domain.Todo) (*domain.Todo, error)
+	Delete(ctx context.Context, ent *domain.Todo) error
+	Complete(ctx context.Context, ent *domain.Todo) (*domain.Todo, error)
+	// Lock retrieves the item(including the deleted one) and locks it until the end of the transaction
+	Lock(ctx context.Context, id *uuid.UUID) (*domain.Todo, error)
+	Restore(ctx context.Context, ent *domain.Todo) (*domain.Todo, error)
+	// Revert replaces the state of the item including the completion, the deleted item is restored
+	Revert(ctx context.Context, ent *domain.Todo) (*domain.Todo, error)
+	CreateRevisions(ctx context.Context, revs ...*domain.TodoRevision) error
+	GetRevisions(ctx context.Context, id *uuid.UUID, qp *domain.ReqBaseQryParam) (*domain.TodoRevisionList, error)
+	GetRevision(ctx context.Context, id *uuid.UUID, revision uint) (*domain.TodoRevision, error)
+	// GetWithDeleted retrieves the item, including the deleted one
+	GetWithDeleted(ctx context.Context, id *uuid.UUID) (*domain.Todo, error)
+	// GetDeletedBefore the uuids of the items soft-deleted before the time, at most the limit
+	GetDeletedBefore(ctx context.Context, before time.Time, limit int) ([]uuid.UUID, error)
+	// Purge hard-deletes the soft-deleted items with their assignees and comments, the revisions are kept as the audit trail
+	Purge(ctx context.Context, ids []uuid.UUID) error
+	// Assign replaces the assignees of the item and bumps its version
+	Assign(ctx context.Context, id *uuid.UUID, assignees []string, assignedBy *string) error
+	// Workload counts the open and the overdue items readable by the viewer of the query params per assignee
+	Workload(ctx context.Context, qp *domain.TodoListReqQryParam, now time.Time) ([]*domain.TodoWorkload, error)
+	// Stats counts the open and the overdue items of all the users
+	Stats(ctx context.Context, now time.Time) (*domain.TodoWorkload, error)
+	// Move writes the column and the rank of the item, the completion follows the done column
+	Move(ctx context.Context, ent *domain.Todo) (*domain.Todo, error)
+	// NeighbourRank the closest rank of the column after(next) or before the rank, the empty rank is the end of the column
+	NeighbourRank(ctx context.Context, column, rank string, next bool) (string, error)
+	// Rebalance spreads the ranks of the column evenly when a rank is longer than the max length, it returns the count of the ranked items
+	Rebalance(ctx context.Context, column string, maxLength int) (int, error)
+	// Transaction runs the fn with a repository bound to a transaction, it is rolled back when fn returns an error
+	Transaction(ctx context.Context, fn func(repo ITodoRepository) error) error
 }
 
 type ITodoUsecase interface {
 	Create(ctx context.Context, ent *domain.Todo) (*domain.Todo, error)
 	Detail(ctx context.Context, id *uuid.UUID) (*domain.Todo, error)
 	GetList(ctx context.Context, qp *domain.TodoListReqQryParam) (*domain.TodoList, error)
+	// Export streams the items of the list filters to the callback, the error of the callback stops the export
+	Export(ctx context.Context, qp *domain.TodoListReqQryParam, each func(*domain.Todo) error) error
+	// Import creates the items all-or-nothing, they are owned by the actor
+	Import(ctx context.Context, ents []*domain.Todo) ([]*domain.Todo, error)
+	// Update the version of the entity is the expected version of the item(If-Match), zero skips the check
+	Update(ctx context.Context, ent *domain.Todo) (*domain.Todo, error)
+	// Delete the version of the entity is the expected version of the item(If-Match), zero skips the check
+	Delete(ctx context.Context, ent *domain.Todo) error
+	// Restore undeletes the item, the version of the entity is the expected version of the item(If-Match)
+	Restore(ctx context.Context, ent *domain.Todo) (*domain.Todo, error)
+	// History the revisions of the item, including the deleted one
+	History(ctx context.Context, id *uuid.UUID, qp *domain.ReqBaseQryParam) (*domain.TodoRevisionList, error)
+	// Revert restores the state of the item recorded by the revision, the version of the entity is the expected version
+	Revert(ctx context.Context, ent *domain.Todo, revision uint) (*domain.Todo, error)
+	// Assign replaces the assignees of the item by the assignees of the entity
+	Assign(ctx context.Context, ent *domain.Todo) (*domain.Todo, error)
+	// Workload the open and the overdue items per assignee, the unassigned items are counted without assignee
+	Workload(ctx context.Context) ([]*domain.TodoWorkload, error)
+	// Stats the open and the overdue items of all the users, for the metrics of the service
+	Stats(ctx context.Context) (*domain.TodoWorkload, error)
+	// Move places the item into the column between the neighbours, the version of the item is the expected version
+	Move(ctx context.Context, move *domain.TodoMove) (*domain.Todo, error)
+	// Rebalance the columns with a rank longer than the max length, it returns the count of the ranked items
+	Rebalance(ctx context.Context, maxLength int) (int, error)
+	// Purge hard-deletes the items deleted before the time, their attachments, blobs and shares are removed first
+	Purge(ctx context.Context, before time.Time) (purged int, err error)
+	// Bulk applies the operations atomically or independently(best effort), the results are in the order of the operations
+	Bulk(ctx context.Context, bulk *domain.TodoBulk) ([]*domain.TodoBulkResult, error)
 }
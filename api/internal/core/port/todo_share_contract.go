@@ -0,0 +1,40 @@
+package port
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"microservice/internal/core/domain"
+)
+
+//go:generate mockgen -source=./todo_share_contract.go -destination=./mocks/todo_share_repository_mock.go -package=todo_repository_mock
+type ITodoShareRepository interface {
+	IRepository
+	// Upsert grants the permission, the existing grant of the grantee is replaced
+	Upsert(ctx context.Context, ent *domain.TodoShare) (*domain.TodoShare, error)
+	GetList(ctx context.Context, todoID *uuid.UUID) ([]*domain.TodoShare, error)
+	Delete(ctx context.Context, todoID, id *uuid.UUID) error
+	// Permission the highest permission granted to the viewer or to the groups of the viewer, empty when there is none
+	Permission(ctx context.Context, todoID *uuid.UUID, viewer *domain.Viewer) (string, error)
+	CreateLink(ctx context.Context, ent *domain.TodoShareLink) (*domain.TodoShareLink, error)
+	GetLinks(ctx context.Context, todoID *uuid.UUID) ([]*domain.TodoShareLink, error)
+	// GetLinkByToken the link of the token hash, the expired links are returned as well
+	GetLinkByToken(ctx context.Context, tokenHash string) (*domain.TodoShareLink, error)
+	DeleteLink(ctx context.Context, todoID, id *uuid.UUID) error
+	// DeleteByTodos deletes the grants and the links of the purged items
+	DeleteByTodos(ctx context.Context, todoIDs []uuid.UUID) error
+}
+
+type ITodoShareUsecase interface {
+	// Grant the permission to a user or a group, only the owner of the item manages its shares
+	Grant(ctx context.Context, ent *domain.TodoShare) (*domain.TodoShare, error)
+	GetList(ctx context.Context, todoID *uuid.UUID) ([]*domain.TodoShare, error)
+	Revoke(ctx context.Context, ent *domain.TodoShare) error
+	// CreateLink the token of the created link is returned only once
+	CreateLink(ctx context.Context, ent *domain.TodoShareLink) (*domain.TodoShareLink, error)
+	GetLinks(ctx context.Context, todoID *uuid.UUID) ([]*domain.TodoShareLink, error)
+	RevokeLink(ctx context.Context, ent *domain.TodoShareLink) error
+	// ByLink the item of the share-link token, for the read-only access without identity
+	ByLink(ctx context.Context, token string) (*domain.Todo, error)
+	// SharedWithMe the items shared with the actor of the request or with its groups by the other users
+	SharedWithMe(ctx context.Context, qp *domain.TodoListReqQryParam) (*domain.TodoList, error)
+}
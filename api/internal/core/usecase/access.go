@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"microservice/internal/core/domain"
+	"microservice/internal/core/port"
+	"microservice/internal/server/http/status"
+	"microservice/pkg/meta"
+	"microservice/pkg/reqctx"
+)
+
+// access checks the permissions of the request actor on the items, it is shared by the usecases of the items
+type access struct {
+	shareRepo port.ITodoShareRepository
+}
+
+// viewer the actor of the request with its groups
+func (a access) viewer(ctx context.Context) *domain.Viewer {
+	return domain.NewViewer(reqctx.Actor(ctx), reqctx.Groups(ctx))
+}
+
+// permission of the request actor on the item, the owner holds every permission
+func (a access) permission(ctx context.Context, item *domain.Todo) (res string, err error) {
+	viewer := a.viewer(ctx)
+	if viewer.Owns(item) {
+		res = domain.PermissionOwner
+		return
+	}
+
+	id := item.UUID()
+	return a.shareRepo.Permission(ctx, &id, viewer)
+}
+
+// authorize the request actor has the wanted permission on the item.
+// the item is reported as not found, when it is not readable by the actor, so its existence is not disclosed
+func (a access) authorize(ctx context.Context, item *domain.Todo, want string) (err error) {
+	permission, txErr := a.permission(ctx, item)
+	if txErr != nil {
+		err = txErr
+		return
+	}
+
+	if !domain.Permits(permission, domain.PermissionRead) {
+		err = meta.ServiceErr(status.NotFound)
+		return
+	}
+
+	if !domain.Permits(permission, want) {
+		err = meta.ServiceErr(status.Forbidden)
+		return
+	}
+
+	return
+}
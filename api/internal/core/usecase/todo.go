@@ -4,30 +4,50 @@ import (
 	"context"
 	"microservice/internal/adapter/locale"
 	"microservice/internal/adapter/logger"
+	"microservice/internal/adapter/storage"
 	"microservice/internal/core/domain"
 	"microservice/internal/core/port"
+	"microservice/internal/server/http/status"
+	"microservice/pkg/fracindex"
+	"microservice/pkg/meta"
+	"microservice/pkg/reqctx"
+	"time"
 
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 )
 
 type TodoUsecase struct {
-	lgr      logger.ILogger
-	l        locale.ILocale
-	todoRepo port.ITodoRepository
+	lgr            logger.ILogger
+	l              locale.ILocale
+	storage        storage.IStorage
+	todoRepo       port.ITodoRepository
+	attachmentRepo port.ITodoAttachmentRepository
+	shareRepo      port.ITodoShareRepository
+	access         access
 }
 
-func NewTodo(lgr logger.ILogger, l locale.ILocale, todoRepo port.ITodoRepository) port.ITodoUsecase {
-	return &TodoUsecase{l: l, lgr: lgr, todoRepo: todoRepo}
+func NewTodo(lgr logger.ILogger, l locale.ILocale, storage storage.IStorage, todoRepo port.ITodoRepository, attachmentRepo port.ITodoAttachmentRepository, shareRepo port.ITodoShareRepository) port.ITodoUsecase {
+	return &TodoUsecase{
+		l: l, lgr: lgr, storage: storage,
+		todoRepo: todoRepo, attachmentRepo: attachmentRepo, shareRepo: shareRepo, access: access{shareRepo: shareRepo},
+	}
 }
 
+// NOTE: every write is recorded as a revision in the same transaction, see the write helpers.
+// the items are read by the readers and written by the editors, see access
+
 func (uc *TodoUsecase) Create(ctx context.Context, ent *domain.Todo) (res *domain.Todo, err error) {
-	item, txErr := uc.todoRepo.Create(ctx, ent)
+	txErr := uc.todoRepo.Transaction(ctx, func(repo port.ITodoRepository) (txErr error) {
+		res, txErr = uc.create(ctx, repo, ent)
+		return
+	})
+
 	if txErr != nil {
-		err = txErr
+		res, err = nil, txErr
 		return
 	}
 
-	res = item
 	return
 }
 
@@ -38,11 +58,23 @@ func (uc *TodoUsecase) Detail(ctx context.Context, id *uuid.UUID) (res *domain.T
 		return
 	}
 
+	if err = uc.access.authorize(ctx, item, domain.PermissionRead); err != nil {
+		return
+	}
+
 	res = item
 	return
 }
 
 func (uc *TodoUsecase) GetList(ctx context.Context, qp *domain.TodoListReqQryParam) (res *domain.TodoList, err error) {
+	qp.ApplyView(time.Now())
+	qp.SetViewer(uc.access.viewer(ctx))
+
+	if !qp.ResolveAssignee() {
+		err = meta.ServiceErr(status.Unauthorized)
+		return
+	}
+
 	items, txErr := uc.todoRepo.GetList(ctx, qp)
 	if txErr != nil {
 		err = txErr
@@ -53,3 +85,675 @@ func (uc *TodoUsecase) GetList(ctx context.Context, qp *domain.TodoListReqQryPar
 	res = items
 	return
 }
+
+func (uc *TodoUsecase) Export(ctx context.Context, qp *domain.TodoListReqQryParam, each func(*domain.Todo) error) (err error) {
+	qp.ApplyView(time.Now())
+	qp.SetViewer(uc.access.viewer(ctx))
+
+	if !qp.ResolveAssignee() {
+		err = meta.ServiceErr(status.Unauthorized)
+		return
+	}
+
+	return uc.todoRepo.Export(ctx, qp, each)
+}
+
+func (uc *TodoUsecase) Import(ctx context.Context, ents []*domain.Todo) (res []*domain.Todo, err error) {
+	txErr := uc.todoRepo.Transaction(ctx, func(repo port.ITodoRepository) (txErr error) {
+		res, txErr = uc.createBatch(ctx, repo, ents)
+		return
+	})
+
+	if txErr != nil {
+		res, err = nil, txErr
+		return
+	}
+
+	return
+}
+
+func (uc *TodoUsecase) Update(ctx context.Context, ent *domain.Todo) (res *domain.Todo, err error) {
+	txErr := uc.todoRepo.Transaction(ctx, func(repo port.ITodoRepository) (txErr error) {
+		res, txErr = uc.update(ctx, repo, ent)
+		return
+	})
+
+	if txErr != nil {
+		res, err = nil, txErr
+		return
+	}
+
+	return
+}
+
+func (uc *TodoUsecase) Delete(ctx context.Context, ent *domain.Todo) (err error) {
+	if txErr := uc.todoRepo.Transaction(ctx, func(repo port.ITodoRepository) error {
+		return uc.delete(ctx, repo, ent)
+	}); txErr != nil {
+		err = txErr
+		return
+	}
+
+	return
+}
+
+func (uc *TodoUsecase) Restore(ctx context.Context, ent *domain.Todo) (res *domain.Todo, err error) {
+	txErr := uc.todoRepo.Transaction(ctx, func(repo port.ITodoRepository) error {
+		id := ent.UUID()
+		before, lockErr := repo.Lock(ctx, &id)
+		if lockErr != nil {
+			return lockErr
+		}
+
+		if authErr := uc.access.authorize(ctx, before, domain.PermissionEdit); authErr != nil {
+			return authErr
+		}
+
+		if before.DeletedAt().IsZero() {
+			return meta.ServiceErr(status.NotDeleted)
+		}
+
+		item, writeErr := repo.Restore(ctx, ent)
+		if writeErr != nil {
+			return writeErr
+		}
+
+		res = item
+		return uc.record(ctx, repo, domain.RevisionRestore, before, item)
+	})
+
+	if txErr != nil {
+		res, err = nil, txErr
+		return
+	}
+
+	return
+}
+
+func (uc *TodoUsecase) History(ctx context.Context, id *uuid.UUID, qp *domain.ReqBaseQryParam) (res *domain.TodoRevisionList, err error) {
+	item, getErr := uc.todoRepo.GetWithDeleted(ctx, id)
+	if getErr != nil {
+		err = getErr
+		return
+	}
+
+	if err = uc.access.authorize(ctx, item, domain.PermissionRead); err != nil {
+		return
+	}
+
+	items, txErr := uc.todoRepo.GetRevisions(ctx, id, qp)
+	if txErr != nil {
+		err = txErr
+		return
+	}
+
+	res = items
+	return
+}
+
+// Revert the state after the revision is written as a new revision, so the history is never rewritten
+func (uc *TodoUsecase) Revert(ctx context.Context, ent *domain.Todo, revision uint) (res *domain.Todo, err error) {
+	txErr := uc.todoRepo.Transaction(ctx, func(repo port.ITodoRepository) error {
+		id := ent.UUID()
+		before, lockErr := repo.Lock(ctx, &id)
+		if lockErr != nil {
+			return lockErr
+		}
+
+		if authErr := uc.access.authorize(ctx, before, domain.PermissionEdit); authErr != nil {
+			return authErr
+		}
+
+		rev, revErr := repo.GetRevision(ctx, &id, revision)
+		if revErr != nil {
+			return revErr
+		}
+
+		// the state after the delete is the missing item, it is reached by the delete itself
+		if rev.After() == nil {
+			return meta.ServiceErr(status.RevisionNotRevertible)
+		}
+
+		item, writeErr := repo.Revert(ctx, rev.After().Apply(ent))
+		if writeErr != nil {
+			return writeErr
+		}
+
+		res = item
+		return uc.record(ctx, repo, domain.RevisionRevert, before, item)
+	})
+
+	if txErr != nil {
+		res, err = nil, txErr
+		return
+	}
+
+	return
+}
+
+// Assign the assignment bumps the version as the assignees are a part of the item, but it does not conflict with the concurrent writes
+func (uc *TodoUsecase) Assign(ctx context.Context, ent *domain.Todo) (res *domain.Todo, err error) {
+	txErr := uc.todoRepo.Transaction(ctx, func(repo port.ITodoRepository) error {
+		id := ent.UUID()
+		before, lockErr := repo.Lock(ctx, &id)
+		if lockErr != nil {
+			return lockErr
+		}
+
+		if !before.DeletedAt().IsZero() {
+			return meta.ServiceErr(status.NotFound)
+		}
+
+		if authErr := uc.access.authorize(ctx, before, domain.PermissionEdit); authErr != nil {
+			return authErr
+		}
+
+		var assignedBy *string
+		if actor := reqctx.Actor(ctx); len(actor) > 0 {
+			assignedBy = &actor
+		}
+
+		// the locked item has no assignees, they are read for the revision
+		assigned, getErr := repo.GetByUUID(ctx, &id)
+		if getErr != nil {
+			return getErr
+		}
+
+		if writeErr := repo.Assign(ctx, &id, ent.Assignees(), assignedBy); writeErr != nil {
+			return writeErr
+		}
+
+		item, getErr := repo.GetByUUID(ctx, &id)
+		if getErr != nil {
+			return getErr
+		}
+
+		res = item
+		return uc.record(ctx, repo, domain.RevisionAssign, assigned, item)
+	})
+
+	if txErr != nil {
+		res, err = nil, txErr
+		return
+	}
+
+	return
+}
+
+func (uc *TodoUsecase) Workload(ctx context.Context) (res []*domain.TodoWorkload, err error) {
+	qp := domain.NewTodoListReqQryParam()
+	qp.SetViewer(uc.access.viewer(ctx))
+
+	items, txErr := uc.todoRepo.Workload(ctx, qp, time.Now())
+	if txErr != nil {
+		err = txErr
+		return
+	}
+
+	res = items
+	return
+}
+
+func (uc *TodoUsecase) Stats(ctx context.Context) (res *domain.TodoWorkload, err error) {
+	return uc.todoRepo.Stats(ctx, time.Now())
+}
+
+// Move the item gets a rank between the ranks of its neighbours, so only the moved item is written
+func (uc *TodoUsecase) Move(ctx context.Context, move *domain.TodoMove) (res *domain.Todo, err error) {
+	txErr := uc.todoRepo.Transaction(ctx, func(repo port.ITodoRepository) error {
+		ent := move.Todo()
+		id := ent.UUID()
+		before, lockErr := repo.Lock(ctx, &id)
+		if lockErr != nil {
+			return lockErr
+		}
+
+		if !before.DeletedAt().IsZero() {
+			return meta.ServiceErr(status.NotFound)
+		}
+
+		if authErr := uc.access.authorize(ctx, before, domain.PermissionEdit); authErr != nil {
+			return authErr
+		}
+
+		column := before.Status()
+		if move.Status() != nil {
+			column = *move.Status()
+		}
+
+		rank, rankErr := uc.rank(ctx, repo, column, move)
+		if rankErr != nil {
+			return rankErr
+		}
+
+		ent.SetStatus(&column)
+		ent.SetBoardRank(&rank)
+
+		item, writeErr := repo.Move(ctx, ent)
+		if writeErr != nil {
+			return writeErr
+		}
+
+		res = item
+		return uc.record(ctx, repo, domain.RevisionMove, before, item)
+	})
+
+	if txErr != nil {
+		res, err = nil, txErr
+		return
+	}
+
+	return
+}
+
+func (uc *TodoUsecase) Rebalance(ctx context.Context, maxLength int) (res int, err error) {
+	for _, column := range []string{domain.StatusTodo, domain.StatusInProgress, domain.StatusDone} {
+		txErr := uc.todoRepo.Transaction(ctx, func(repo port.ITodoRepository) error {
+			rebalanced, txErr := repo.Rebalance(ctx, column, maxLength)
+			res += rebalanced
+			return txErr
+		})
+
+		if txErr != nil {
+			err = txErr
+			return
+		}
+	}
+
+	return
+}
+
+// Purge removes the items in batches, the rows of the attachments are deleted before their blobs, the shares and the items after them,
+// so the interrupted purge is completed by the next run
+func (uc *TodoUsecase) Purge(ctx context.Context, before time.Time) (purged int, err error) {
+	for {
+		ids, txErr := uc.todoRepo.GetDeletedBefore(ctx, before, purgeBatchSize)
+		if txErr != nil {
+			err = txErr
+			return
+		}
+
+		if len(ids) == 0 {
+			return
+		}
+
+		attachments, txErr := uc.attachmentRepo.DeleteByTodos(ctx, ids)
+		if txErr != nil {
+			err = txErr
+			return
+		}
+
+		for _, attachment := range attachments {
+			if delErr := uc.storage.Delete(ctx, attachment.StorageKey()); delErr != nil {
+				uc.lgr.Error("todo.uc.purge.blob", zap.String("key", attachment.StorageKey()), zap.Error(delErr), logger.Context(ctx))
+			}
+		}
+
+		if err = uc.shareRepo.DeleteByTodos(ctx, ids); err != nil {
+			return
+		}
+
+		if err = uc.todoRepo.Purge(ctx, ids); err != nil {
+			return
+		}
+
+		purged += len(ids)
+		if len(ids) < purgeBatchSize {
+			return
+		}
+	}
+}
+
+// Bulk applies the consecutive creates with multi-row inserts and the other operations one by one.
+// the atomic request stops at the first failure and rolls back all operations, the error of the failed one is returned
+func (uc *TodoUsecase) Bulk(ctx context.Context, bulk *domain.TodoBulk) (res []*domain.TodoBulkResult, err error) {
+	if !bulk.Atomic() {
+		res = uc.applyBulk(ctx, uc.todoRepo, bulk.Operations(), false)
+		return
+	}
+
+	txErr := uc.todoRepo.Transaction(ctx, func(repo port.ITodoRepository) error {
+		res = uc.applyBulk(ctx, repo, bulk.Operations(), true)
+
+		for _, result := range res {
+			if result.Err() != nil {
+				return result.Err()
+			}
+		}
+
+		return nil
+	})
+
+	if txErr != nil {
+		for _, result := range res {
+			result.RollBack()
+		}
+
+		err = txErr
+		return
+	}
+
+	return
+}
+
+// HELPERS
+
+// the write helpers apply the write and record its revision with the repository bound to the caller transaction
+
+func (uc *TodoUsecase) create(ctx context.Context, repo port.ITodoRepository, ent *domain.Todo) (res *domain.Todo, err error) {
+	uc.own(ctx, ent)
+
+	item, txErr := repo.Create(ctx, ent)
+	if txErr != nil {
+		err = txErr
+		return
+	}
+
+	if err = uc.record(ctx, repo, domain.RevisionCreate, nil, item); err != nil {
+		return
+	}
+
+	res = item
+	return
+}
+
+func (uc *TodoUsecase) update(ctx context.Context, repo port.ITodoRepository, ent *domain.Todo) (res *domain.Todo, err error) {
+	id := ent.UUID()
+	before, lockErr := repo.Lock(ctx, &id)
+	if lockErr != nil {
+		err = lockErr
+		return
+	}
+
+	if err = uc.access.authorize(ctx, before, domain.PermissionEdit); err != nil {
+		return
+	}
+
+	item, txErr := repo.Update(ctx, ent)
+	if txErr != nil {
+		err = txErr
+		return
+	}
+
+	if err = uc.record(ctx, repo, domain.RevisionUpdate, before, item); err != nil {
+		return
+	}
+
+	res = item
+	return
+}
+
+func (uc *TodoUsecase) complete(ctx context.Context, repo port.ITodoRepository, ent *domain.Todo) (res *domain.Todo, err error) {
+	id := ent.UUID()
+	before, lockErr := repo.Lock(ctx, &id)
+	if lockErr != nil {
+		err = lockErr
+		return
+	}
+
+	if err = uc.access.authorize(ctx, before, domain.PermissionEdit); err != nil {
+		return
+	}
+
+	item, txErr := repo.Complete(ctx, ent)
+	if txErr != nil {
+		err = txErr
+		return
+	}
+
+	if err = uc.record(ctx, repo, domain.RevisionComplete, before, item); err != nil {
+		return
+	}
+
+	res = item
+	return
+}
+
+func (uc *TodoUsecase) delete(ctx context.Context, repo port.ITodoRepository, ent *domain.Todo) (err error) {
+	id := ent.UUID()
+	before, lockErr := repo.Lock(ctx, &id)
+	if lockErr != nil {
+		err = lockErr
+		return
+	}
+
+	if err = uc.access.authorize(ctx, before, domain.PermissionEdit); err != nil {
+		return
+	}
+
+	if err = repo.Delete(ctx, ent); err != nil {
+		return
+	}
+
+	return uc.record(ctx, repo, domain.RevisionDelete, before, nil)
+}
+
+// rank between the neighbours of the move in the column, the missing neighbour is looked up next to the given one.
+// the neighbours out of the column or out of order are reported as the stale board of the caller
+func (uc *TodoUsecase) rank(ctx context.Context, repo port.ITodoRepository, column string, move *domain.TodoMove) (res string, err error) {
+	neighbour := func(id *uuid.UUID) (string, error) {
+		if id == nil {
+			return "", nil
+		}
+
+		if *id == move.Todo().UUID() {
+			return "", meta.ServiceErr(status.InvalidMove)
+		}
+
+		item, getErr := repo.GetByUUID(ctx, id)
+		if getErr != nil {
+			if meta.ErrStatus(getErr) == status.NotFound {
+				return "", meta.ServiceErr(status.InvalidMove)
+			}
+
+			return "", getErr
+		}
+
+		if item.Status() != column || len(item.BoardRank()) == 0 {
+			return "", meta.ServiceErr(status.InvalidMove)
+		}
+
+		return item.BoardRank(), nil
+	}
+
+	lower, lowerErr := neighbour(move.After())
+	if lowerErr != nil {
+		err = lowerErr
+		return
+	}
+
+	upper, upperErr := neighbour(move.Before())
+	if upperErr != nil {
+		err = upperErr
+		return
+	}
+
+	switch {
+	case move.After() == nil && move.Before() == nil:
+		lower, err = repo.NeighbourRank(ctx, column, "", false)
+	case move.After() == nil:
+		lower, err = repo.NeighbourRank(ctx, column, upper, false)
+	case move.Before() == nil:
+		upper, err = repo.NeighbourRank(ctx, column, lower, true)
+	}
+
+	if err != nil {
+		return
+	}
+
+	rank, rankErr := fracindex.Between(lower, upper)
+	if rankErr != nil {
+		err = meta.ServiceErr(status.InvalidMove)
+		return
+	}
+
+	res = rank
+	return
+}
+
+// own sets the actor of the request as the owner of the created item, the anonymous items have no owner
+func (uc *TodoUsecase) own(ctx context.Context, ent *domain.Todo) {
+	if actor := reqctx.Actor(ctx); len(actor) > 0 {
+		ent.SetOwner(&actor)
+	}
+}
+
+// record appends the revision of the write, the actor and the request id are taken from the request context
+func (uc *TodoUsecase) record(ctx context.Context, repo port.ITodoRepository, action string, before, after *domain.Todo) error {
+	return repo.CreateRevisions(ctx, uc.revision(ctx, action, before, after))
+}
+
+func (uc *TodoUsecase) revision(ctx context.Context, action string, before, after *domain.Todo) *domain.TodoRevision {
+	rev := domain.NewTodoRevision(action, before, after)
+
+	if actor := reqctx.Actor(ctx); len(actor) > 0 {
+		rev.SetActor(&actor)
+	}
+
+	if id := reqctx.RequestID(ctx); len(id) > 0 {
+		rev.SetRequestID(&id)
+	}
+
+	return rev
+}
+
+func (uc *TodoUsecase) applyBulk(ctx context.Context, repo port.ITodoRepository, ops []*domain.TodoBulkOperation, atomic bool) []*domain.TodoBulkResult {
+	res := make([]*domain.TodoBulkResult, len(ops))
+	for i, op := range ops {
+		res[i] = domain.NewTodoBulkResult(i, op.Op())
+	}
+
+	for start := 0; start < len(ops); {
+		end := start + 1
+
+		if ops[start].Op() == domain.BulkOpCreate {
+			for end < len(ops) && ops[end].Op() == domain.BulkOpCreate {
+				end++
+			}
+
+			uc.bulkCreate(ctx, repo, ops[start:end], res[start:end], atomic)
+		} else if atomic {
+			uc.bulkWrite(ctx, repo, ops[start], res[start])
+		} else {
+			// every operation of the best-effort request is applied with its revision in a separate transaction
+			txErr := repo.Transaction(ctx, func(tx port.ITodoRepository) error {
+				uc.bulkWrite(ctx, tx, ops[start], res[start])
+				return res[start].Err()
+			})
+
+			if txErr != nil && res[start].Err() == nil {
+				res[start].Fail(txErr) // the commit is failed
+			}
+		}
+
+		if atomic {
+			for _, result := range res[start:end] {
+				if result.Err() != nil {
+					return res // the rest is not attempted, the transaction is rolled back
+				}
+			}
+		}
+
+		start = end
+	}
+
+	return res
+}
+
+// bulkCreate inserts the items at once, the best-effort request retries them one by one to isolate the failed items
+func (uc *TodoUsecase) bulkCreate(ctx context.Context, repo port.ITodoRepository, ops []*domain.TodoBulkOperation, res []*domain.TodoBulkResult, atomic bool) {
+	ents := make([]*domain.Todo, 0, len(ops))
+	for _, op := range ops {
+		ents = append(ents, op.Todo())
+	}
+
+	var items []*domain.Todo
+	createBatch := func(tx port.ITodoRepository) (txErr error) {
+		items, txErr = uc.createBatch(ctx, tx, ents)
+		return
+	}
+
+	var batchErr error
+	if atomic {
+		batchErr = createBatch(repo)
+	} else {
+		// the batches of the best-effort request are inserted all-or-nothing, so the retries do not duplicate them
+		batchErr = repo.Transaction(ctx, createBatch)
+	}
+
+	if batchErr == nil {
+		for i, item := range items {
+			res[i].Succeed(item)
+		}
+
+		return
+	}
+
+	if atomic {
+		for _, result := range res {
+			result.Fail(batchErr)
+		}
+
+		return
+	}
+
+	for i, ent := range ents {
+		item, txErr := uc.Create(ctx, ent)
+		if txErr != nil {
+			res[i].Fail(txErr)
+			continue
+		}
+
+		res[i].Succeed(item)
+	}
+}
+
+// createBatch inserts the items with multi-row statements and records their revisions
+func (uc *TodoUsecase) createBatch(ctx context.Context, repo port.ITodoRepository, ents []*domain.Todo) (res []*domain.Todo, err error) {
+	for _, ent := range ents {
+		uc.own(ctx, ent)
+	}
+
+	items, txErr := repo.CreateInBatches(ctx, ents)
+	if txErr != nil {
+		err = txErr
+		return
+	}
+
+	revs := make([]*domain.TodoRevision, 0, len(items))
+	for _, item := range items {
+		revs = append(revs, uc.revision(ctx, domain.RevisionCreate, nil, item))
+	}
+
+	if err = repo.CreateRevisions(ctx, revs...); err != nil {
+		return
+	}
+
+	res = items
+	return
+}
+
+func (uc *TodoUsecase) bulkWrite(ctx context.Context, repo port.ITodoRepository, op *domain.TodoBulkOperation, res *domain.TodoBulkResult) {
+	var (
+		item  *domain.Todo
+		txErr error
+	)
+
+	switch op.Op() {
+	case domain.BulkOpUpdate:
+		item, txErr = uc.update(ctx, repo, op.Todo())
+	case domain.BulkOpComplete:
+		item, txErr = uc.complete(ctx, repo, op.Todo())
+	case domain.BulkOpDelete:
+		item, txErr = op.Todo(), uc.delete(ctx, repo, op.Todo())
+	default:
+		txErr = meta.ServiceErr(status.Validate)
+	}
+
+	if txErr != nil {
+		res.Fail(txErr)
+		return
+	}
+
+	res.Succeed(item)
+}
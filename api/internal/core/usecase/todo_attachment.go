@@ -0,0 +1,338 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"microservice/config"
+	"microservice/internal/adapter/locale"
+	"microservice/internal/adapter/logger"
+	"microservice/internal/adapter/storage"
+	"microservice/internal/core/domain"
+	"microservice/internal/core/port"
+	"microservice/internal/server/http/status"
+	"microservice/pkg/meta"
+	"microservice/pkg/reqctx"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/gabriel-vasile/mimetype"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultAttachmentMaxSize bytes of a file, when the config is not set
+	defaultAttachmentMaxSize int64 = 10 << 20
+	// defaultAttachmentMimeTypes the allowed types, when the config is not set
+	defaultAttachmentMimeTypes = "image/*,application/pdf,text/plain,text/csv,application/zip," +
+		"application/vnd.openxmlformats-officedocument.wordprocessingml.document," +
+		"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	// sniffSize bytes of the content to detect its type
+	sniffSize = 3072
+	// purgeBatchSize items of a purge round
+	purgeBatchSize = 100
+	// fileNameMaxLength runes of the stored file name
+	fileNameMaxLength = 255
+)
+
+type TodoAttachmentUsecase struct {
+	lgr            logger.ILogger
+	l              locale.ILocale
+	config         config.Attachment
+	storage        storage.IStorage
+	todoRepo       port.ITodoRepository
+	attachmentRepo port.ITodoAttachmentRepository
+	access         access
+}
+
+func NewTodoAttachment(lgr logger.ILogger, l locale.ILocale, config config.Attachment, storage storage.IStorage, todoRepo port.ITodoRepository, attachmentRepo port.ITodoAttachmentRepository, shareRepo port.ITodoShareRepository) port.ITodoAttachmentUsecase {
+	return &TodoAttachmentUsecase{
+		l: l, lgr: lgr, config: config, storage: storage,
+		todoRepo: todoRepo, attachmentRepo: attachmentRepo, access: access{shareRepo: shareRepo},
+	}
+}
+
+// NOTE: the attachments are read by the readers of the item and written by its editors
+
+// Upload spools the content to a temporary file while its size is limited and its checksum is calculated,
+// so the blob is written only for the accepted files
+func (uc *TodoAttachmentUsecase) Upload(ctx context.Context, ent *domain.TodoAttachment) (res *domain.TodoAttachment, err error) {
+	defer func() { _ = ent.Content().Close() }()
+
+	todoID := ent.TodoUUID()
+	if err = uc.item(ctx, &todoID, domain.PermissionEdit); err != nil {
+		return
+	}
+
+	spool, spoolErr := os.CreateTemp("", "attachment-*")
+	if spoolErr != nil {
+		uc.lgr.Error("todo.attachment.uc.upload.spool", zap.Error(spoolErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	defer func() {
+		_ = spool.Close()
+		_ = os.Remove(spool.Name())
+	}()
+
+	hash := sha256.New()
+	size, copyErr := io.Copy(io.MultiWriter(spool, hash), io.LimitReader(ent.Content(), uc.maxSize()+1))
+	if copyErr != nil {
+		uc.lgr.Error("todo.attachment.uc.upload.read", zap.Error(copyErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	if size > uc.maxSize() {
+		err = meta.ServiceErr(status.AttachmentTooLarge)
+		return
+	}
+
+	head := make([]byte, sniffSize)
+	n, readErr := spool.ReadAt(head, 0)
+	if readErr != nil && !errors.Is(readErr, io.EOF) {
+		uc.lgr.Error("todo.attachment.uc.upload.sniff", zap.Error(readErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	mtype := mimetype.Detect(head[:n])
+	if !uc.allowed(mtype) {
+		err = meta.ServiceErr(status.AttachmentTypeNotAllowed)
+		return
+	}
+
+	id := uuid.New()
+	ent.SetUUID(&id)
+	ent.SetFileName(fileName(ent.FileName()))
+	ent.SetContentType(mtype.String())
+	ent.SetSize(size)
+	ent.SetChecksum(hex.EncodeToString(hash.Sum(nil)))
+	ent.SetStorageKey(domain.AttachmentStorageKey(todoID, id))
+
+	if actor := reqctx.Actor(ctx); len(actor) > 0 {
+		ent.SetUploader(&actor)
+	}
+
+	if _, seekErr := spool.Seek(0, io.SeekStart); seekErr != nil {
+		uc.lgr.Error("todo.attachment.uc.upload.seek", zap.Error(seekErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	if putErr := uc.storage.Put(ctx, ent.StorageKey(), spool, size, ent.ContentType()); putErr != nil {
+		uc.lgr.Error("todo.attachment.uc.upload.put", zap.Error(putErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	item, txErr := uc.attachmentRepo.Create(ctx, ent)
+	if txErr != nil {
+		// NOTE: the blob is swept as an orphan, when it is not removed here
+		if delErr := uc.storage.Delete(context.WithoutCancel(ctx), ent.StorageKey()); delErr != nil {
+			uc.lgr.Error("todo.attachment.uc.upload.rollback", zap.Error(delErr), logger.Context(ctx))
+		}
+
+		err = txErr
+		return
+	}
+
+	res = item
+	return
+}
+
+func (uc *TodoAttachmentUsecase) GetList(ctx context.Context, todoID *uuid.UUID) (res []*domain.TodoAttachment, err error) {
+	if err = uc.item(ctx, todoID, domain.PermissionRead); err != nil {
+		return
+	}
+
+	items, txErr := uc.attachmentRepo.GetList(ctx, todoID)
+	if txErr != nil {
+		err = txErr
+		return
+	}
+
+	res = items
+	return
+}
+
+func (uc *TodoAttachmentUsecase) Download(ctx context.Context, todoID, id *uuid.UUID) (res *domain.TodoAttachment, err error) {
+	if err = uc.item(ctx, todoID, domain.PermissionRead); err != nil {
+		return
+	}
+
+	item, txErr := uc.attachmentRepo.GetByUUID(ctx, todoID, id)
+	if txErr != nil {
+		err = txErr
+		return
+	}
+
+	content, getErr := uc.storage.Get(ctx, item.StorageKey())
+	if getErr != nil {
+		if errors.Is(getErr, storage.ErrNotFound) {
+			uc.lgr.Error("todo.attachment.uc.download.missing", zap.String("key", item.StorageKey()), logger.Context(ctx))
+			err = meta.ServiceErr(status.NotFound)
+			return
+		}
+
+		uc.lgr.Error("todo.attachment.uc.download", zap.Error(getErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	item.SetContent(content)
+	res = item
+	return
+}
+
+// Delete removes the row first, a failed blob removal leaves an orphan to be swept
+func (uc *TodoAttachmentUsecase) Delete(ctx context.Context, ent *domain.TodoAttachment) (err error) {
+	todoID, id := ent.TodoUUID(), ent.UUID()
+	if err = uc.item(ctx, &todoID, domain.PermissionEdit); err != nil {
+		return
+	}
+
+	item, txErr := uc.attachmentRepo.GetByUUID(ctx, &todoID, &id)
+	if txErr != nil {
+		err = txErr
+		return
+	}
+
+	if err = uc.attachmentRepo.Delete(ctx, item); err != nil {
+		return
+	}
+
+	if delErr := uc.storage.Delete(ctx, item.StorageKey()); delErr != nil {
+		uc.lgr.Error("todo.attachment.uc.delete.blob", zap.Error(delErr), logger.Context(ctx))
+	}
+
+	return
+}
+
+func (uc *TodoAttachmentUsecase) SweepOrphans(ctx context.Context, before time.Time) (removed int, err error) {
+	blobs, listErr := uc.storage.List(ctx, domain.AttachmentStoragePrefix)
+	if listErr != nil {
+		uc.lgr.Error("todo.attachment.uc.sweep.list", zap.Error(listErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	// NOTE: the recent blobs are skipped, as their rows may not be committed yet
+	keys := make([]string, 0, len(blobs))
+	for _, blob := range blobs {
+		if blob.ModifiedAt.Before(before) {
+			keys = append(keys, blob.Key)
+		}
+	}
+
+	for start := 0; start < len(keys); start += purgeBatchSize {
+		batch := keys[start:min(start+purgeBatchSize, len(keys))]
+
+		referenced, txErr := uc.attachmentRepo.ReferencedKeys(ctx, batch)
+		if txErr != nil {
+			err = txErr
+			return
+		}
+
+		known := make(map[string]struct{}, len(referenced))
+		for _, key := range referenced {
+			known[key] = struct{}{}
+		}
+
+		for _, key := range batch {
+			if _, ok := known[key]; ok {
+				continue
+			}
+
+			if delErr := uc.storage.Delete(ctx, key); delErr != nil {
+				uc.lgr.Error("todo.attachment.uc.sweep.blob", zap.String("key", key), zap.Error(delErr), logger.Context(ctx))
+				continue
+			}
+
+			removed++
+		}
+	}
+
+	return
+}
+
+// HELPERS
+
+// item checks the permission of the actor on the existing item
+func (uc *TodoAttachmentUsecase) item(ctx context.Context, todoID *uuid.UUID, want string) (err error) {
+	item, txErr := uc.todoRepo.GetByUUID(ctx, todoID)
+	if txErr != nil {
+		err = txErr
+		return
+	}
+
+	return uc.access.authorize(ctx, item, want)
+}
+
+func (uc *TodoAttachmentUsecase) maxSize() int64 {
+	if uc.config.MaxSize > 0 {
+		return uc.config.MaxSize
+	}
+
+	return defaultAttachmentMaxSize
+}
+
+// allowed the detected type or one of its parents matches a configured type, `image/*` matches every image type
+func (uc *TodoAttachmentUsecase) allowed(mtype *mimetype.MIME) bool {
+	types := uc.config.MimeTypes
+	if len(strings.TrimSpace(types)) == 0 {
+		types = defaultAttachmentMimeTypes
+	}
+
+	for _, allowed := range strings.Split(types, ",") {
+		allowed = strings.ToLower(strings.TrimSpace(allowed))
+		if len(allowed) == 0 {
+			continue
+		}
+
+		for m := mtype; m != nil; m = m.Parent() {
+			if prefix, ok := strings.CutSuffix(allowed, "/*"); ok {
+				if strings.HasPrefix(m.String(), prefix+"/") {
+					return true
+				}
+
+				continue
+			}
+
+			if m.Is(allowed) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// fileName the base name of the client side file without the control characters
+func fileName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+
+		return r
+	}, filepath.Base(strings.ReplaceAll(name, "\\", "/")))
+
+	name = strings.TrimSpace(name)
+	if runes := []rune(name); len(runes) > fileNameMaxLength {
+		name = string(runes[:fileNameMaxLength])
+	}
+
+	if len(name) == 0 || name == "." || name == "/" {
+		return "attachment"
+	}
+
+	return name
+}
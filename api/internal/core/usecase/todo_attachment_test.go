@@ -0,0 +1,177 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"microservice/config"
+	localeMock "microservice/internal/adapter/locale/mocks"
+	loggerMock "microservice/internal/adapter/logger/mocks"
+	"microservice/internal/adapter/storage"
+	storageMock "microservice/internal/adapter/storage/mocks"
+	"microservice/internal/core/domain"
+	todoRepoMock "microservice/internal/core/port/mocks"
+	"microservice/internal/server/http/status"
+	"microservice/pkg/meta"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTodoAttachmentUsecase_Upload(t *testing.T) {
+	todoID := uuid.New()
+	png := append([]byte("\x89PNG\r\n\x1a\n"), bytes.Repeat([]byte{0}, 64)...)
+
+	upload := func(name string, content []byte) *domain.TodoAttachment {
+		ent := domain.NewTodoAttachment()
+		ent.SetTodoUUID(todoID)
+		ent.SetFileName(name)
+		ent.SetContent(io.NopCloser(bytes.NewReader(content)))
+		return ent
+	}
+
+	t.Run("the detected type and the checksum are stored", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		//
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		blobs := storageMock.NewMockIStorage(ctrl)
+		todoRepo := todoRepoMock.NewMockITodoRepository(ctrl)
+		attachmentRepo := todoRepoMock.NewMockITodoAttachmentRepository(ctrl)
+		shareRepo := todoRepoMock.NewMockITodoShareRepository(ctrl)
+
+		//
+
+		uc := NewTodoAttachment(logger, locale, config.Attachment{MimeTypes: "image/*"}, blobs, todoRepo, attachmentRepo, shareRepo)
+
+		//
+
+		ctx := context.Background()
+		ent := upload("../../photo.png", png)
+
+		var stored []byte
+		todoRepo.EXPECT().GetByUUID(ctx, &todoID).Return(domain.NewTodo(), nil).Times(1)
+		blobs.EXPECT().Put(ctx, gomock.Any(), gomock.Any(), int64(len(png)), "image/png").DoAndReturn(
+			func(ctx context.Context, key string, content io.Reader, size int64, contentType string) error {
+				stored, _ = io.ReadAll(content)
+				return nil
+			},
+		).Times(1)
+		attachmentRepo.EXPECT().Create(ctx, ent).DoAndReturn(
+			func(ctx context.Context, ent *domain.TodoAttachment) (*domain.TodoAttachment, error) { return ent, nil },
+		).Times(1)
+
+		res, err := uc.Upload(ctx, ent)
+
+		assert.NoError(t, err)
+		assert.Equal(t, png, stored)
+		assert.Equal(t, "photo.png", res.FileName())
+		assert.Equal(t, "image/png", res.ContentType())
+		assert.Equal(t, int64(len(png)), res.Size())
+		assert.Len(t, res.Checksum(), 64)
+		assert.Equal(t, domain.AttachmentStorageKey(todoID, res.UUID()), res.StorageKey())
+	})
+
+	t.Run("the oversized file is rejected before it is stored", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		//
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		blobs := storageMock.NewMockIStorage(ctrl)
+		todoRepo := todoRepoMock.NewMockITodoRepository(ctrl)
+		attachmentRepo := todoRepoMock.NewMockITodoAttachmentRepository(ctrl)
+		shareRepo := todoRepoMock.NewMockITodoShareRepository(ctrl)
+
+		//
+
+		uc := NewTodoAttachment(logger, locale, config.Attachment{MaxSize: 16}, blobs, todoRepo, attachmentRepo, shareRepo)
+
+		//
+
+		ctx := context.Background()
+
+		todoRepo.EXPECT().GetByUUID(ctx, &todoID).Return(domain.NewTodo(), nil).Times(1)
+
+		res, err := uc.Upload(ctx, upload("photo.png", png))
+
+		assert.Nil(t, res)
+		assert.Equal(t, status.AttachmentTooLarge, meta.ErrStatus(err))
+	})
+
+	t.Run("the type is detected from the content, not from the name", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		//
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		blobs := storageMock.NewMockIStorage(ctrl)
+		todoRepo := todoRepoMock.NewMockITodoRepository(ctrl)
+		attachmentRepo := todoRepoMock.NewMockITodoAttachmentRepository(ctrl)
+		shareRepo := todoRepoMock.NewMockITodoShareRepository(ctrl)
+
+		//
+
+		uc := NewTodoAttachment(logger, locale, config.Attachment{MimeTypes: "image/*"}, blobs, todoRepo, attachmentRepo, shareRepo)
+
+		//
+
+		ctx := context.Background()
+
+		todoRepo.EXPECT().GetByUUID(ctx, &todoID).Return(domain.NewTodo(), nil).Times(1)
+
+		res, err := uc.Upload(ctx, upload("photo.png", []byte("#!/bin/sh\necho pwned\n")))
+
+		assert.Nil(t, res)
+		assert.Equal(t, status.AttachmentTypeNotAllowed, meta.ErrStatus(err))
+	})
+}
+
+func TestTodoAttachmentUsecase_SweepOrphans(t *testing.T) {
+	t.Run("only the old unreferenced blobs are swept", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		//
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		blobs := storageMock.NewMockIStorage(ctrl)
+		todoRepo := todoRepoMock.NewMockITodoRepository(ctrl)
+		attachmentRepo := todoRepoMock.NewMockITodoAttachmentRepository(ctrl)
+		shareRepo := todoRepoMock.NewMockITodoShareRepository(ctrl)
+
+		//
+
+		uc := NewTodoAttachment(logger, locale, config.Attachment{}, blobs, todoRepo, attachmentRepo, shareRepo)
+
+		//
+
+		ctx := context.Background()
+		before := time.Now()
+
+		blobs.EXPECT().List(ctx, domain.AttachmentStoragePrefix).Return([]storage.Blob{
+			{Key: "todos/a/referenced", ModifiedAt: before.Add(-time.Hour)},
+			{Key: "todos/a/orphan", ModifiedAt: before.Add(-time.Hour)},
+			{Key: "todos/a/uploading", ModifiedAt: before.Add(time.Minute)},
+		}, nil).Times(1)
+		attachmentRepo.EXPECT().ReferencedKeys(ctx, []string{"todos/a/referenced", "todos/a/orphan"}).
+			Return([]string{"todos/a/referenced"}, nil).Times(1)
+		blobs.EXPECT().Delete(ctx, "todos/a/orphan").Return(nil).Times(1)
+
+		removed, err := uc.SweepOrphans(ctx, before)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, removed)
+	})
+}
@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"microservice/internal/adapter/locale"
+	"microservice/internal/adapter/logger"
+	"microservice/internal/core/domain"
+	"microservice/internal/core/port"
+	"microservice/internal/server/http/status"
+	"microservice/pkg/meta"
+	"microservice/pkg/reqctx"
+
+	"go.uber.org/zap"
+)
+
+type TodoCalendarUsecase struct {
+	lgr          logger.ILogger
+	l            locale.ILocale
+	todoRepo     port.ITodoRepository
+	calendarRepo port.ITodoCalendarRepository
+}
+
+func NewTodoCalendar(lgr logger.ILogger, l locale.ILocale, todoRepo port.ITodoRepository, calendarRepo port.ITodoCalendarRepository) port.ITodoCalendarUsecase {
+	return &TodoCalendarUsecase{l: l, lgr: lgr, todoRepo: todoRepo, calendarRepo: calendarRepo}
+}
+
+// NOTE: the feeds belong to a user, the anonymous requests have no feeds
+
+func (uc *TodoCalendarUsecase) CreateFeed(ctx context.Context, ent *domain.TodoCalendarFeed) (res *domain.TodoCalendarFeed, err error) {
+	actor := reqctx.Actor(ctx)
+	if len(actor) == 0 {
+		err = meta.ServiceErr(status.Unauthorized)
+		return
+	}
+
+	token := make([]byte, shareTokenSize)
+	if _, randErr := rand.Read(token); randErr != nil {
+		uc.lgr.Error("todo.calendar.uc.feed.token", zap.Error(randErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	ent.SetOwner(actor)
+	ent.SetToken(base64.RawURLEncoding.EncodeToString(token))
+
+	feed, txErr := uc.calendarRepo.Create(ctx, ent)
+	if txErr != nil {
+		err = txErr
+		return
+	}
+
+	feed.SetToken(ent.Token())
+	res = feed
+	return
+}
+
+func (uc *TodoCalendarUsecase) GetFeeds(ctx context.Context) (res []*domain.TodoCalendarFeed, err error) {
+	actor := reqctx.Actor(ctx)
+	if len(actor) == 0 {
+		err = meta.ServiceErr(status.Unauthorized)
+		return
+	}
+
+	return uc.calendarRepo.GetList(ctx, actor)
+}
+
+func (uc *TodoCalendarUsecase) RevokeFeed(ctx context.Context, ent *domain.TodoCalendarFeed) (err error) {
+	actor := reqctx.Actor(ctx)
+	if len(actor) == 0 {
+		err = meta.ServiceErr(status.Unauthorized)
+		return
+	}
+
+	id := ent.UUID()
+	return uc.calendarRepo.Delete(ctx, actor, &id)
+}
+
+func (uc *TodoCalendarUsecase) GetFeed(ctx context.Context, token string) (res *domain.TodoCalendarFeed, err error) {
+	return uc.calendarRepo.GetByToken(ctx, domain.HashShareToken(token))
+}
+
+// ExportFeed the groups of the owner are not known without its request, so the items shared with the groups are not listed
+func (uc *TodoCalendarUsecase) ExportFeed(ctx context.Context, feed *domain.TodoCalendarFeed, each func(*domain.Todo) error) (err error) {
+	qp := domain.NewTodoListReqQryParam()
+	qp.SetViewer(domain.NewViewer(feed.Owner(), nil))
+	qp.SetHasDueDate(feed.Component() == domain.CalendarEvent)
+
+	return uc.todoRepo.Export(ctx, qp, each)
+}
@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	localeMock "microservice/internal/adapter/locale/mocks"
+	loggerMock "microservice/internal/adapter/logger/mocks"
+	"microservice/internal/core/domain"
+	todoRepoMock "microservice/internal/core/port/mocks"
+	"microservice/internal/server/http/status"
+	"microservice/pkg/meta"
+	"microservice/pkg/reqctx"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTodoCalendarUsecase_CreateFeed(t *testing.T) {
+	owner := "7"
+
+	t.Run("the feed of the actor with its token", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		//
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		todoRepo := todoRepoMock.NewMockITodoRepository(ctrl)
+		calendarRepo := todoRepoMock.NewMockITodoCalendarRepository(ctrl)
+
+		//
+
+		uc := NewTodoCalendar(logger, locale, todoRepo, calendarRepo)
+
+		//
+
+		ctx := reqctx.WithActor(context.Background(), owner)
+		ent := domain.NewTodoCalendarFeed()
+		ent.SetComponent(domain.CalendarEvent)
+
+		calendarRepo.EXPECT().Create(ctx, ent).DoAndReturn(
+			func(_ context.Context, ent *domain.TodoCalendarFeed) (*domain.TodoCalendarFeed, error) {
+				stored := domain.NewTodoCalendarFeed()
+				stored.SetOwner(ent.Owner())
+				stored.SetComponent(ent.Component())
+				return stored, nil
+			},
+		).Times(1)
+
+		res, err := uc.CreateFeed(ctx, ent)
+
+		assert.NoError(t, err)
+		assert.Equal(t, owner, res.Owner())
+		assert.NotEmpty(t, res.Token())
+		assert.Equal(t, domain.HashShareToken(res.Token()), ent.TokenHash())
+	})
+
+	t.Run("the anonymous requests have no feeds", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		//
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		todoRepo := todoRepoMock.NewMockITodoRepository(ctrl)
+		calendarRepo := todoRepoMock.NewMockITodoCalendarRepository(ctrl)
+
+		//
+
+		uc := NewTodoCalendar(logger, locale, todoRepo, calendarRepo)
+
+		//
+
+		res, err := uc.CreateFeed(context.Background(), domain.NewTodoCalendarFeed())
+
+		assert.Nil(t, res)
+		assert.Equal(t, status.Unauthorized, meta.ErrStatus(err))
+	})
+}
+
+func TestTodoCalendarUsecase_ExportFeed(t *testing.T) {
+	owner := "7"
+
+	t.Run("the event feed lists the items of the owner with due date", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		//
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		todoRepo := todoRepoMock.NewMockITodoRepository(ctrl)
+		calendarRepo := todoRepoMock.NewMockITodoCalendarRepository(ctrl)
+
+		//
+
+		uc := NewTodoCalendar(logger, locale, todoRepo, calendarRepo)
+
+		//
+
+		ctx := context.Background()
+		feed := domain.NewTodoCalendarFeed()
+		feed.SetOwner(owner)
+		feed.SetComponent(domain.CalendarEvent)
+
+		todoRepo.EXPECT().Export(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, qp *domain.TodoListReqQryParam, _ func(*domain.Todo) error) error {
+				assert.Equal(t, owner, qp.Viewer().Actor())
+				assert.True(t, qp.HasDueDate())
+				return nil
+			},
+		).Times(1)
+
+		err := uc.ExportFeed(ctx, feed, func(*domain.Todo) error { return nil })
+
+		assert.NoError(t, err)
+	})
+}
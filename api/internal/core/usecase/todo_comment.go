@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"context"
+	"microservice/internal/adapter/locale"
+	"microservice/internal/adapter/logger"
+	"microservice/internal/core/domain"
+	"microservice/internal/core/port"
+	"microservice/internal/server/http/status"
+	"microservice/pkg/mention"
+	"microservice/pkg/meta"
+	"microservice/pkg/reqctx"
+
+	"github.com/google/uuid"
+)
+
+type TodoCommentUsecase struct {
+	lgr         logger.ILogger
+	l           locale.ILocale
+	todoRepo    port.ITodoRepository
+	commentRepo port.ITodoCommentRepository
+	access      access
+}
+
+func NewTodoComment(lgr logger.ILogger, l locale.ILocale, todoRepo port.ITodoRepository, commentRepo port.ITodoCommentRepository, shareRepo port.ITodoShareRepository) port.ITodoCommentUsecase {
+	return &TodoCommentUsecase{l: l, lgr: lgr, todoRepo: todoRepo, commentRepo: commentRepo, access: access{shareRepo: shareRepo}}
+}
+
+// NOTE: the comments of the deleted items are not reachable, the item is looked up before every operation.
+// the comments are read by the readers of the item and written by its commenters
+
+func (uc *TodoCommentUsecase) Create(ctx context.Context, ent *domain.TodoComment) (res *domain.TodoComment, err error) {
+	todoID := ent.TodoUUID()
+	if err = uc.item(ctx, &todoID, domain.PermissionComment); err != nil {
+		return
+	}
+
+	if ent.ParentUUID() != nil {
+		parent, txErr := uc.commentRepo.GetByUUID(ctx, &todoID, ent.ParentUUID())
+		if txErr != nil {
+			err = txErr
+			if meta.ErrStatus(txErr) == status.NotFound {
+				err = meta.ServiceErr(status.InvalidParentComment)
+			}
+
+			return
+		}
+
+		// the threads are one level deep, the reply to a reply is added to the thread of its root comment
+		if parent.ParentUUID() != nil {
+			ent.SetParentUUID(parent.ParentUUID())
+		}
+	}
+
+	if actor := reqctx.Actor(ctx); len(actor) > 0 {
+		ent.SetAuthor(&actor)
+	}
+
+	ent.SetMentions(mention.Parse(ent.Body()))
+
+	item, txErr := uc.commentRepo.Create(ctx, ent)
+	if txErr != nil {
+		err = txErr
+		return
+	}
+
+	res = item
+	return
+}
+
+func (uc *TodoCommentUsecase) GetList(ctx context.Context, todoID *uuid.UUID, qp *domain.TodoCommentListReqQryParam) (res *domain.TodoCommentList, err error) {
+	if err = uc.item(ctx, todoID, domain.PermissionRead); err != nil {
+		return
+	}
+
+	items, txErr := uc.commentRepo.GetList(ctx, todoID, qp)
+	if txErr != nil {
+		err = txErr
+		return
+	}
+
+	// NOTE: it returns the empty slice for not-found result
+	res = items
+	return
+}
+
+func (uc *TodoCommentUsecase) Update(ctx context.Context, ent *domain.TodoComment) (res *domain.TodoComment, err error) {
+	if _, err = uc.authorized(ctx, ent); err != nil {
+		return
+	}
+
+	ent.SetMentions(mention.Parse(ent.Body()))
+
+	item, txErr := uc.commentRepo.Update(ctx, ent)
+	if txErr != nil {
+		err = txErr
+		return
+	}
+
+	res = item
+	return
+}
+
+func (uc *TodoCommentUsecase) Delete(ctx context.Context, ent *domain.TodoComment) (err error) {
+	comment, authErr := uc.authorized(ctx, ent)
+	if authErr != nil {
+		err = authErr
+		return
+	}
+
+	return uc.commentRepo.Delete(ctx, comment)
+}
+
+func (uc *TodoCommentUsecase) Activity(ctx context.Context, todoID *uuid.UUID, qp *domain.TodoActivityReqQryParam) (res *domain.TodoActivityList, err error) {
+	if err = uc.item(ctx, todoID, domain.PermissionRead); err != nil {
+		return
+	}
+
+	items, txErr := uc.commentRepo.GetActivity(ctx, todoID, qp)
+	if txErr != nil {
+		err = txErr
+		return
+	}
+
+	res = items
+	return
+}
+
+// HELPERS
+
+// item checks the permission of the actor on the existing item
+func (uc *TodoCommentUsecase) item(ctx context.Context, todoID *uuid.UUID, want string) (err error) {
+	item, txErr := uc.todoRepo.GetByUUID(ctx, todoID)
+	if txErr != nil {
+		err = txErr
+		return
+	}
+
+	return uc.access.authorize(ctx, item, want)
+}
+
+// authorized the stored comment, when the actor of the request is its author. the anonymous comments are editable by anyone
+func (uc *TodoCommentUsecase) authorized(ctx context.Context, ent *domain.TodoComment) (res *domain.TodoComment, err error) {
+	todoID, id := ent.TodoUUID(), ent.UUID()
+	if err = uc.item(ctx, &todoID, domain.PermissionComment); err != nil {
+		return
+	}
+
+	comment, txErr := uc.commentRepo.GetByUUID(ctx, &todoID, &id)
+	if txErr != nil {
+		err = txErr
+		return
+	}
+
+	if comment.Author() != nil && *comment.Author() != reqctx.Actor(ctx) {
+		err = meta.ServiceErr(status.NotCommentAuthor)
+		return
+	}
+
+	res = comment
+	return
+}
@@ -0,0 +1,180 @@
+package usecase
+
+import (
+	"context"
+	localeMock "microservice/internal/adapter/locale/mocks"
+	loggerMock "microservice/internal/adapter/logger/mocks"
+	"microservice/internal/core/domain"
+	todoRepoMock "microservice/internal/core/port/mocks"
+	"microservice/internal/server/http/status"
+	"microservice/pkg/meta"
+	"microservice/pkg/reqctx"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTodoCommentUsecase_Create(t *testing.T) {
+	todoID, rootID, replyID := uuid.New(), uuid.New(), uuid.New()
+
+	root := domain.NewTodoComment()
+	root.SetUUID(&rootID)
+	root.SetTodoUUID(todoID)
+
+	reply := domain.NewTodoComment()
+	reply.SetUUID(&replyID)
+	reply.SetTodoUUID(todoID)
+	reply.SetParentUUID(&rootID)
+
+	t.Run("reply to a reply is added to the thread of the root", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		//
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		todoRepo := todoRepoMock.NewMockITodoRepository(ctrl)
+		commentRepo := todoRepoMock.NewMockITodoCommentRepository(ctrl)
+
+		//
+
+		uc := NewTodoComment(logger, locale, todoRepo, commentRepo, todoRepoMock.NewMockITodoShareRepository(ctrl))
+
+		//
+
+		ctx := reqctx.WithActor(context.Background(), "42")
+
+		ent := domain.NewTodoComment()
+		ent.SetTodoUUID(todoID)
+		ent.SetParentUUID(&replyID)
+		ent.SetBody("@alice and @bob, see the reply of @alice")
+
+		todoRepo.EXPECT().GetByUUID(ctx, &todoID).Return(domain.NewTodo(), nil).Times(1)
+		commentRepo.EXPECT().GetByUUID(ctx, &todoID, &replyID).Return(reply, nil).Times(1)
+		commentRepo.EXPECT().Create(ctx, ent).DoAndReturn(
+			func(ctx context.Context, ent *domain.TodoComment) (*domain.TodoComment, error) { return ent, nil },
+		).Times(1)
+
+		res, err := uc.Create(ctx, ent)
+
+		assert.NoError(t, err)
+		assert.Equal(t, rootID, *res.ParentUUID())
+		assert.Equal(t, "42", *res.Author())
+		assert.Equal(t, []string{"alice", "bob"}, res.Mentions())
+	})
+
+	t.Run("replied comment of another item is rejected", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		//
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		todoRepo := todoRepoMock.NewMockITodoRepository(ctrl)
+		commentRepo := todoRepoMock.NewMockITodoCommentRepository(ctrl)
+
+		//
+
+		uc := NewTodoComment(logger, locale, todoRepo, commentRepo, todoRepoMock.NewMockITodoShareRepository(ctrl))
+
+		//
+
+		ctx := context.Background()
+		missing := uuid.New()
+
+		ent := domain.NewTodoComment()
+		ent.SetTodoUUID(todoID)
+		ent.SetParentUUID(&missing)
+
+		todoRepo.EXPECT().GetByUUID(ctx, &todoID).Return(domain.NewTodo(), nil).Times(1)
+		commentRepo.EXPECT().GetByUUID(ctx, &todoID, &missing).Return(nil, meta.ServiceErr(status.NotFound)).Times(1)
+
+		res, err := uc.Create(ctx, ent)
+
+		assert.Nil(t, res)
+		assert.Equal(t, status.InvalidParentComment, meta.ErrStatus(err))
+	})
+}
+
+func TestTodoCommentUsecase_Update(t *testing.T) {
+	todoID, id := uuid.New(), uuid.New()
+	author := "42"
+
+	stored := domain.NewTodoComment()
+	stored.SetUUID(&id)
+	stored.SetTodoUUID(todoID)
+	stored.SetAuthor(&author)
+
+	t.Run("only the author edits the comment", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		//
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		todoRepo := todoRepoMock.NewMockITodoRepository(ctrl)
+		commentRepo := todoRepoMock.NewMockITodoCommentRepository(ctrl)
+
+		//
+
+		uc := NewTodoComment(logger, locale, todoRepo, commentRepo, todoRepoMock.NewMockITodoShareRepository(ctrl))
+
+		//
+
+		ctx := reqctx.WithActor(context.Background(), "7")
+
+		ent := domain.NewTodoComment()
+		ent.SetUUID(&id)
+		ent.SetTodoUUID(todoID)
+		ent.SetBody("edited by another user")
+
+		todoRepo.EXPECT().GetByUUID(ctx, &todoID).Return(domain.NewTodo(), nil).Times(1)
+		commentRepo.EXPECT().GetByUUID(ctx, &todoID, &id).Return(stored, nil).Times(1)
+
+		res, err := uc.Update(ctx, ent)
+
+		assert.Nil(t, res)
+		assert.Equal(t, status.NotCommentAuthor, meta.ErrStatus(err))
+	})
+
+	t.Run("the mentions of the edited body are parsed again", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		//
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		todoRepo := todoRepoMock.NewMockITodoRepository(ctrl)
+		commentRepo := todoRepoMock.NewMockITodoCommentRepository(ctrl)
+
+		//
+
+		uc := NewTodoComment(logger, locale, todoRepo, commentRepo, todoRepoMock.NewMockITodoShareRepository(ctrl))
+
+		//
+
+		ctx := reqctx.WithActor(context.Background(), author)
+
+		ent := domain.NewTodoComment()
+		ent.SetUUID(&id)
+		ent.SetTodoUUID(todoID)
+		ent.SetBody("cc @carol")
+
+		todoRepo.EXPECT().GetByUUID(ctx, &todoID).Return(domain.NewTodo(), nil).Times(1)
+		commentRepo.EXPECT().GetByUUID(ctx, &todoID, &id).Return(stored, nil).Times(1)
+		commentRepo.EXPECT().Update(ctx, ent).DoAndReturn(
+			func(ctx context.Context, ent *domain.TodoComment) (*domain.TodoComment, error) { return ent, nil },
+		).Times(1)
+
+		res, err := uc.Update(ctx, ent)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"carol"}, res.Mentions())
+	})
+}
@@ -0,0 +1,176 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"microservice/internal/adapter/locale"
+	"microservice/internal/adapter/logger"
+	"microservice/internal/core/domain"
+	"microservice/internal/core/port"
+	"microservice/internal/server/http/status"
+	"microservice/pkg/meta"
+	"microservice/pkg/reqctx"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// shareTokenSize random bytes of a share-link token
+const shareTokenSize = 32
+
+type TodoShareUsecase struct {
+	lgr       logger.ILogger
+	l         locale.ILocale
+	todoRepo  port.ITodoRepository
+	shareRepo port.ITodoShareRepository
+	access    access
+}
+
+func NewTodoShare(lgr logger.ILogger, l locale.ILocale, todoRepo port.ITodoRepository, shareRepo port.ITodoShareRepository) port.ITodoShareUsecase {
+	return &TodoShareUsecase{l: l, lgr: lgr, todoRepo: todoRepo, shareRepo: shareRepo, access: access{shareRepo: shareRepo}}
+}
+
+// NOTE: the shares are managed by the owner of the item, the items without owner are managed by everyone
+
+func (uc *TodoShareUsecase) Grant(ctx context.Context, ent *domain.TodoShare) (res *domain.TodoShare, err error) {
+	todoID := ent.TodoUUID()
+	item, ownErr := uc.owned(ctx, &todoID)
+	if ownErr != nil {
+		err = ownErr
+		return
+	}
+
+	if ent.GranteeType() == domain.GranteeUser && item.Owner() != nil && *item.Owner() == ent.Grantee() {
+		err = meta.ServiceErr(status.InvalidGrantee)
+		return
+	}
+
+	if actor := reqctx.Actor(ctx); len(actor) > 0 {
+		ent.SetGrantedBy(&actor)
+	}
+
+	share, txErr := uc.shareRepo.Upsert(ctx, ent)
+	if txErr != nil {
+		err = txErr
+		return
+	}
+
+	res = share
+	return
+}
+
+func (uc *TodoShareUsecase) GetList(ctx context.Context, todoID *uuid.UUID) (res []*domain.TodoShare, err error) {
+	if _, err = uc.owned(ctx, todoID); err != nil {
+		return
+	}
+
+	return uc.shareRepo.GetList(ctx, todoID)
+}
+
+func (uc *TodoShareUsecase) Revoke(ctx context.Context, ent *domain.TodoShare) (err error) {
+	todoID, id := ent.TodoUUID(), ent.UUID()
+	if _, err = uc.owned(ctx, &todoID); err != nil {
+		return
+	}
+
+	return uc.shareRepo.Delete(ctx, &todoID, &id)
+}
+
+func (uc *TodoShareUsecase) CreateLink(ctx context.Context, ent *domain.TodoShareLink) (res *domain.TodoShareLink, err error) {
+	todoID := ent.TodoUUID()
+	if _, err = uc.owned(ctx, &todoID); err != nil {
+		return
+	}
+
+	token := make([]byte, shareTokenSize)
+	if _, randErr := rand.Read(token); randErr != nil {
+		uc.lgr.Error("todo.share.uc.link.token", zap.Error(randErr), logger.Context(ctx))
+		err = meta.ServiceErr(status.Failed)
+		return
+	}
+
+	ent.SetToken(base64.RawURLEncoding.EncodeToString(token))
+
+	if actor := reqctx.Actor(ctx); len(actor) > 0 {
+		ent.SetCreatedBy(&actor)
+	}
+
+	link, txErr := uc.shareRepo.CreateLink(ctx, ent)
+	if txErr != nil {
+		err = txErr
+		return
+	}
+
+	link.SetToken(ent.Token())
+	res = link
+	return
+}
+
+func (uc *TodoShareUsecase) GetLinks(ctx context.Context, todoID *uuid.UUID) (res []*domain.TodoShareLink, err error) {
+	if _, err = uc.owned(ctx, todoID); err != nil {
+		return
+	}
+
+	return uc.shareRepo.GetLinks(ctx, todoID)
+}
+
+func (uc *TodoShareUsecase) RevokeLink(ctx context.Context, ent *domain.TodoShareLink) (err error) {
+	todoID, id := ent.TodoUUID(), ent.UUID()
+	if _, err = uc.owned(ctx, &todoID); err != nil {
+		return
+	}
+
+	return uc.shareRepo.DeleteLink(ctx, &todoID, &id)
+}
+
+// ByLink the unknown tokens and the deleted items are reported as not found
+func (uc *TodoShareUsecase) ByLink(ctx context.Context, token string) (res *domain.Todo, err error) {
+	link, txErr := uc.shareRepo.GetLinkByToken(ctx, domain.HashShareToken(token))
+	if txErr != nil {
+		err = txErr
+		return
+	}
+
+	if link.Expired(time.Now()) {
+		err = meta.ServiceErr(status.ShareLinkExpired)
+		return
+	}
+
+	todoID := link.TodoUUID()
+	return uc.todoRepo.GetByUUID(ctx, &todoID)
+}
+
+func (uc *TodoShareUsecase) SharedWithMe(ctx context.Context, qp *domain.TodoListReqQryParam) (res *domain.TodoList, err error) {
+	viewer := uc.access.viewer(ctx)
+	if viewer.Anonymous() {
+		err = meta.ServiceErr(status.Unauthorized)
+		return
+	}
+
+	qp.ApplyView(time.Now())
+	qp.SetViewer(viewer)
+	qp.SetShared(true)
+	qp.ResolveAssignee()
+
+	return uc.todoRepo.GetList(ctx, qp)
+}
+
+// HELPERS
+
+// owned the item, when the actor of the request owns it
+func (uc *TodoShareUsecase) owned(ctx context.Context, todoID *uuid.UUID) (res *domain.Todo, err error) {
+	item, txErr := uc.todoRepo.GetByUUID(ctx, todoID)
+	if txErr != nil {
+		err = txErr
+		return
+	}
+
+	if err = uc.access.authorize(ctx, item, domain.PermissionOwner); err != nil {
+		return
+	}
+
+	res = item
+	return
+}
@@ -0,0 +1,218 @@
+package usecase
+
+import (
+	"context"
+	localeMock "microservice/internal/adapter/locale/mocks"
+	loggerMock "microservice/internal/adapter/logger/mocks"
+	"microservice/internal/core/domain"
+	todoRepoMock "microservice/internal/core/port/mocks"
+	"microservice/internal/server/http/status"
+	"microservice/pkg/meta"
+	"microservice/pkg/reqctx"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTodoShareUsecase_Grant(t *testing.T) {
+	owner, other := "7", "42"
+	todoID := uuid.New()
+
+	item := domain.NewTodo()
+	item.SetUUID(&todoID)
+	item.SetOwner(&owner)
+
+	grant := func(grantee string) *domain.TodoShare {
+		ent := domain.NewTodoShare()
+		ent.SetTodoUUID(todoID)
+		ent.SetGranteeType(domain.GranteeUser)
+		ent.SetGrantee(grantee)
+		ent.SetPermission(domain.PermissionEdit)
+		return ent
+	}
+
+	t.Run("the owner grants the permission", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		//
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		todoRepo := todoRepoMock.NewMockITodoRepository(ctrl)
+		shareRepo := todoRepoMock.NewMockITodoShareRepository(ctrl)
+
+		//
+
+		uc := NewTodoShare(logger, locale, todoRepo, shareRepo)
+
+		//
+
+		ctx := reqctx.WithActor(context.Background(), owner)
+		ent := grant(other)
+
+		todoRepo.EXPECT().GetByUUID(ctx, &todoID).Return(item, nil).Times(1)
+		shareRepo.EXPECT().Upsert(ctx, ent).Return(ent, nil).Times(1)
+
+		res, err := uc.Grant(ctx, ent)
+
+		assert.NoError(t, err)
+		assert.Equal(t, owner, *res.GrantedBy())
+	})
+
+	t.Run("the owner is not a grantee", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		//
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		todoRepo := todoRepoMock.NewMockITodoRepository(ctrl)
+		shareRepo := todoRepoMock.NewMockITodoShareRepository(ctrl)
+
+		//
+
+		uc := NewTodoShare(logger, locale, todoRepo, shareRepo)
+
+		//
+
+		ctx := reqctx.WithActor(context.Background(), owner)
+
+		todoRepo.EXPECT().GetByUUID(ctx, &todoID).Return(item, nil).Times(1)
+
+		res, err := uc.Grant(ctx, grant(owner))
+
+		assert.Nil(t, res)
+		assert.Equal(t, status.InvalidGrantee, meta.ErrStatus(err))
+	})
+
+	t.Run("an editor does not manage the shares", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		//
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		todoRepo := todoRepoMock.NewMockITodoRepository(ctrl)
+		shareRepo := todoRepoMock.NewMockITodoShareRepository(ctrl)
+
+		//
+
+		uc := NewTodoShare(logger, locale, todoRepo, shareRepo)
+
+		//
+
+		ctx := reqctx.WithActor(context.Background(), other)
+
+		todoRepo.EXPECT().GetByUUID(ctx, &todoID).Return(item, nil).Times(1)
+		shareRepo.EXPECT().Permission(ctx, &todoID, gomock.Any()).Return(domain.PermissionEdit, nil).Times(1)
+
+		res, err := uc.Grant(ctx, grant("99"))
+
+		assert.Nil(t, res)
+		assert.Equal(t, status.Forbidden, meta.ErrStatus(err))
+	})
+
+	t.Run("an unshared item is not disclosed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		//
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		todoRepo := todoRepoMock.NewMockITodoRepository(ctrl)
+		shareRepo := todoRepoMock.NewMockITodoShareRepository(ctrl)
+
+		//
+
+		uc := NewTodoShare(logger, locale, todoRepo, shareRepo)
+
+		//
+
+		ctx := reqctx.WithActor(context.Background(), other)
+
+		todoRepo.EXPECT().GetByUUID(ctx, &todoID).Return(item, nil).Times(1)
+		shareRepo.EXPECT().Permission(ctx, &todoID, gomock.Any()).Return("", nil).Times(1)
+
+		res, err := uc.Grant(ctx, grant("99"))
+
+		assert.Nil(t, res)
+		assert.Equal(t, status.NotFound, meta.ErrStatus(err))
+	})
+}
+
+func TestTodoShareUsecase_ByLink(t *testing.T) {
+	todoID := uuid.New()
+
+	t.Run("an expired link is gone", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		//
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		todoRepo := todoRepoMock.NewMockITodoRepository(ctrl)
+		shareRepo := todoRepoMock.NewMockITodoShareRepository(ctrl)
+
+		//
+
+		uc := NewTodoShare(logger, locale, todoRepo, shareRepo)
+
+		//
+
+		ctx := context.Background()
+		expiredAt := time.Now().Add(-time.Minute)
+
+		link := domain.NewTodoShareLink()
+		link.SetTodoUUID(todoID)
+		link.SetExpiresAt(&expiredAt)
+
+		shareRepo.EXPECT().GetLinkByToken(ctx, domain.HashShareToken("token")).Return(link, nil).Times(1)
+
+		res, err := uc.ByLink(ctx, "token")
+
+		assert.Nil(t, res)
+		assert.Equal(t, status.ShareLinkExpired, meta.ErrStatus(err))
+	})
+
+	t.Run("the item of the link", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		//
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		todoRepo := todoRepoMock.NewMockITodoRepository(ctrl)
+		shareRepo := todoRepoMock.NewMockITodoShareRepository(ctrl)
+
+		//
+
+		uc := NewTodoShare(logger, locale, todoRepo, shareRepo)
+
+		//
+
+		ctx := context.Background()
+
+		link := domain.NewTodoShareLink()
+		link.SetTodoUUID(todoID)
+
+		item := domain.NewTodo()
+		item.SetUUID(&todoID)
+
+		shareRepo.EXPECT().GetLinkByToken(ctx, domain.HashShareToken("token")).Return(link, nil).Times(1)
+		todoRepo.EXPECT().GetByUUID(ctx, &todoID).Return(item, nil).Times(1)
+
+		res, err := uc.ByLink(ctx, "token")
+
+		assert.NoError(t, err)
+		assert.Equal(t, item, res)
+	})
+}
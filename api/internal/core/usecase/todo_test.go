@@ -5,8 +5,13 @@ import (
 	"fmt"
 	localeMock "microservice/internal/adapter/locale/mocks"
 	loggerMock "microservice/internal/adapter/logger/mocks"
+	storageMock "microservice/internal/adapter/storage/mocks"
 	"microservice/internal/core/domain"
+	"microservice/internal/core/port"
 	todoRepoMock "microservice/internal/core/port/mocks"
+	"microservice/internal/server/http/status"
+	"microservice/pkg/meta"
+	"microservice/pkg/reqctx"
 	"sync"
 	"testing"
 	"time"
@@ -43,7 +48,7 @@ func TestTodoUsecase_Create(t *testing.T) {
 
 		//
 
-		uc := NewTodo(logger, locale, todoRepo)
+		uc := NewTodo(logger, locale, storageMock.NewMockIStorage(ctrl), todoRepo, todoRepoMock.NewMockITodoAttachmentRepository(ctrl), todoRepoMock.NewMockITodoShareRepository(ctrl))
 
 		//
 
@@ -53,7 +58,9 @@ func TestTodoUsecase_Create(t *testing.T) {
 		wg := sync.WaitGroup{}
 		wg.Add(1)
 
+		todoRepo.EXPECT().Transaction(ctx, gomock.Any()).DoAndReturn(inTransaction(todoRepo)).Times(1)
 		todoRepo.EXPECT().Create(ctx, testTodo).Return(expectedTodo, nil).Times(1)
+		todoRepo.EXPECT().CreateRevisions(ctx, gomock.Any()).Return(nil).Times(1)
 
 		result, err := uc.Create(ctx, testTodo)
 
@@ -87,14 +94,16 @@ func TestTodoUsecase_Create(t *testing.T) {
 
 		//
 
-		uc := NewTodo(logger, locale, todoRepo)
+		uc := NewTodo(logger, locale, storageMock.NewMockIStorage(ctrl), todoRepo, todoRepoMock.NewMockITodoAttachmentRepository(ctrl), todoRepoMock.NewMockITodoShareRepository(ctrl))
 
 		//
 
 		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 		defer cancel()
 
+		todoRepo.EXPECT().Transaction(ctx, gomock.Any()).DoAndReturn(inTransaction(todoRepo)).Times(1)
 		todoRepo.EXPECT().Create(ctx, testTodo).Return(expectedTodo, nil).Times(1)
+		todoRepo.EXPECT().CreateRevisions(ctx, gomock.Any()).Return(nil).Times(1)
 
 		result, err := uc.Create(ctx, testTodo)
 
@@ -118,7 +127,7 @@ func TestTodoUsecase_Create(t *testing.T) {
 
 		//
 
-		uc := NewTodo(logger, locale, todoRepo)
+		uc := NewTodo(logger, locale, storageMock.NewMockIStorage(ctrl), todoRepo, todoRepoMock.NewMockITodoAttachmentRepository(ctrl), todoRepoMock.NewMockITodoShareRepository(ctrl))
 
 		//
 
@@ -127,6 +136,7 @@ func TestTodoUsecase_Create(t *testing.T) {
 
 		expectedErr := fmt.Errorf("repository error")
 
+		todoRepo.EXPECT().Transaction(ctx, gomock.Any()).DoAndReturn(inTransaction(todoRepo)).Times(1)
 		todoRepo.EXPECT().Create(ctx, testTodo).Return(nil, expectedErr).Times(1)
 		// no queue or logger expectations (goroutine won't run)
 
@@ -137,3 +147,630 @@ func TestTodoUsecase_Create(t *testing.T) {
 		assert.Nil(t, result)
 	})
 }
+
+func TestTodoUsecase_GetList(t *testing.T) {
+	t.Run("today view in the caller time zone", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		//
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		todoRepo := todoRepoMock.NewMockITodoRepository(ctrl)
+
+		//
+
+		uc := NewTodo(logger, locale, storageMock.NewMockIStorage(ctrl), todoRepo, todoRepoMock.NewMockITodoAttachmentRepository(ctrl), todoRepoMock.NewMockITodoShareRepository(ctrl))
+
+		//
+
+		location, _ := time.LoadLocation("Asia/Tokyo")
+		view := domain.ViewToday
+
+		qp := domain.NewTodoListReqQryParam()
+		qp.SetLocation(location)
+		qp.SetView(&view)
+
+		ctx := context.Background()
+		todoRepo.EXPECT().GetList(ctx, qp).Return(domain.NewTodoList(), nil).Times(1)
+
+		_, err := uc.GetList(ctx, qp)
+		now := time.Now()
+
+		assert.NoError(t, err)
+		assert.NotNil(t, qp.DueAfter())
+		assert.NotNil(t, qp.DueBefore())
+
+		start := qp.DueAfter().In(location)
+		assert.Equal(t, 0, start.Hour()+start.Minute()+start.Second())
+		assert.Equal(t, 24*time.Hour, qp.DueBefore().Sub(*qp.DueAfter()))
+		assert.False(t, now.Before(*qp.DueAfter()))
+		assert.True(t, now.Before(*qp.DueBefore()))
+	})
+
+	t.Run("overdue narrows the requested range", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		//
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		todoRepo := todoRepoMock.NewMockITodoRepository(ctrl)
+
+		//
+
+		uc := NewTodo(logger, locale, storageMock.NewMockIStorage(ctrl), todoRepo, todoRepoMock.NewMockITodoAttachmentRepository(ctrl), todoRepoMock.NewMockITodoShareRepository(ctrl))
+
+		//
+
+		dueBefore := time.Now().Add(72 * time.Hour)
+
+		qp := domain.NewTodoListReqQryParam()
+		qp.SetDueBefore(&dueBefore)
+		qp.SetOverdue(true)
+
+		ctx := context.Background()
+		todoRepo.EXPECT().GetList(ctx, qp).Return(domain.NewTodoList(), nil).Times(1)
+
+		_, err := uc.GetList(ctx, qp)
+
+		assert.NoError(t, err)
+		assert.Nil(t, qp.DueAfter())
+		assert.True(t, qp.DueBefore().Before(dueBefore))
+		assert.False(t, qp.DueBefore().After(time.Now()))
+	})
+
+	t.Run("assignee me is the caller", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		//
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		todoRepo := todoRepoMock.NewMockITodoRepository(ctrl)
+
+		//
+
+		uc := NewTodo(logger, locale, storageMock.NewMockIStorage(ctrl), todoRepo, todoRepoMock.NewMockITodoAttachmentRepository(ctrl), todoRepoMock.NewMockITodoShareRepository(ctrl))
+
+		//
+
+		me := domain.AssigneeMe
+
+		qp := domain.NewTodoListReqQryParam()
+		qp.SetAssignee(&me)
+
+		ctx := reqctx.WithActor(context.Background(), "42")
+		todoRepo.EXPECT().GetList(ctx, qp).Return(domain.NewTodoList(), nil).Times(1)
+
+		_, err := uc.GetList(ctx, qp)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "42", *qp.Assignee())
+	})
+
+	t.Run("assignee me of the anonymous caller", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		//
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		todoRepo := todoRepoMock.NewMockITodoRepository(ctrl)
+
+		//
+
+		uc := NewTodo(logger, locale, storageMock.NewMockIStorage(ctrl), todoRepo, todoRepoMock.NewMockITodoAttachmentRepository(ctrl), todoRepoMock.NewMockITodoShareRepository(ctrl))
+
+		//
+
+		me := domain.AssigneeMe
+
+		qp := domain.NewTodoListReqQryParam()
+		qp.SetAssignee(&me)
+
+		res, err := uc.GetList(context.Background(), qp)
+
+		assert.Nil(t, res)
+		assert.Equal(t, status.Unauthorized, meta.ErrStatus(err))
+	})
+}
+
+func TestTodoUsecase_Bulk(t *testing.T) {
+	description := "bulk mock item"
+	missing := uuid.New()
+
+	newBulk := func(mode string) *domain.TodoBulk {
+		first, second := domain.NewTodo(), domain.NewTodo()
+		first.SetDescription(&description)
+		second.SetDescription(&description)
+
+		deleted := domain.NewTodo()
+		deleted.SetUUID(&missing)
+
+		bulk := domain.NewTodoBulk()
+		bulk.SetMode(mode)
+		bulk.SetOperations([]*domain.TodoBulkOperation{
+			domain.NewTodoBulkOperation(domain.BulkOpCreate, first),
+			domain.NewTodoBulkOperation(domain.BulkOpCreate, second),
+			domain.NewTodoBulkOperation(domain.BulkOpDelete, deleted),
+		})
+
+		return bulk
+	}
+
+	t.Run("atomic request is rolled back by the first failure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		//
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		todoRepo := todoRepoMock.NewMockITodoRepository(ctrl)
+
+		//
+
+		uc := NewTodo(logger, locale, storageMock.NewMockIStorage(ctrl), todoRepo, todoRepoMock.NewMockITodoAttachmentRepository(ctrl), todoRepoMock.NewMockITodoShareRepository(ctrl))
+
+		//
+
+		ctx := context.Background()
+		bulk := newBulk(domain.BulkModeAtomic)
+
+		todoRepo.EXPECT().Transaction(ctx, gomock.Any()).DoAndReturn(inTransaction(todoRepo)).Times(1)
+		todoRepo.EXPECT().CreateInBatches(ctx, gomock.Len(2)).DoAndReturn(
+			func(ctx context.Context, ents []*domain.Todo) ([]*domain.Todo, error) { return ents, nil },
+		).Times(1)
+		todoRepo.EXPECT().CreateRevisions(ctx, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		todoRepo.EXPECT().Lock(ctx, &missing).Return(nil, meta.ServiceErr(status.NotFound)).Times(1)
+
+		res, err := uc.Bulk(ctx, bulk)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, status.NotFound, meta.ErrStatus(err))
+		assert.Len(t, res, 3)
+
+		for _, result := range res {
+			assert.False(t, result.Applied())
+		}
+
+		assert.Nil(t, res[0].Err())
+		assert.NotNil(t, res[2].Err())
+	})
+
+	t.Run("best-effort request reports the failures per item", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		//
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		todoRepo := todoRepoMock.NewMockITodoRepository(ctrl)
+
+		//
+
+		uc := NewTodo(logger, locale, storageMock.NewMockIStorage(ctrl), todoRepo, todoRepoMock.NewMockITodoAttachmentRepository(ctrl), todoRepoMock.NewMockITodoShareRepository(ctrl))
+
+		//
+
+		ctx := context.Background()
+		bulk := newBulk(domain.BulkModeBestEffort)
+
+		// the batch fails, the items are retried one by one, every retry in its own transaction
+		todoRepo.EXPECT().Transaction(ctx, gomock.Any()).Return(meta.ServiceErr(status.ItemExist)).Times(1)
+		todoRepo.EXPECT().Transaction(ctx, gomock.Any()).DoAndReturn(inTransaction(todoRepo)).Times(3)
+		gomock.InOrder(
+			todoRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil, meta.ServiceErr(status.ItemExist)),
+			todoRepo.EXPECT().Create(ctx, gomock.Any()).Return(domain.NewTodo(), nil),
+		)
+		todoRepo.EXPECT().Lock(ctx, &missing).Return(domain.NewTodo(), nil).Times(1)
+		todoRepo.EXPECT().Delete(ctx, gomock.Any()).Return(nil).Times(1)
+		todoRepo.EXPECT().CreateRevisions(ctx, gomock.Any()).Return(nil).Times(2)
+
+		res, err := uc.Bulk(ctx, bulk)
+
+		assert.NoError(t, err)
+		assert.Len(t, res, 3)
+		assert.Equal(t, status.ItemExist, meta.ErrStatus(res[0].Err()))
+		assert.False(t, res[0].Applied())
+		assert.True(t, res[1].Applied())
+		assert.True(t, res[2].Applied())
+	})
+}
+
+func TestTodoUsecase_Revert(t *testing.T) {
+	id := uuid.New()
+	previous, current := "reverted description", "current description"
+
+	before, after := domain.NewTodo(), domain.NewTodo()
+	before.SetUUID(&id)
+	before.SetDescription(&previous)
+	after.SetUUID(&id)
+	after.SetDescription(&current)
+
+	t.Run("the state after the revision is written as a new revision", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		//
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		todoRepo := todoRepoMock.NewMockITodoRepository(ctrl)
+
+		//
+
+		uc := NewTodo(logger, locale, storageMock.NewMockIStorage(ctrl), todoRepo, todoRepoMock.NewMockITodoAttachmentRepository(ctrl), todoRepoMock.NewMockITodoShareRepository(ctrl))
+
+		//
+
+		ctx := context.Background()
+		rev := domain.NewTodoRevision(domain.RevisionCreate, nil, before)
+
+		ent := domain.NewTodo()
+		ent.SetUUID(&id)
+		ent.SetVersion(2)
+
+		todoRepo.EXPECT().Transaction(ctx, gomock.Any()).DoAndReturn(inTransaction(todoRepo)).Times(1)
+		todoRepo.EXPECT().GetRevision(ctx, &id, uint(1)).Return(rev, nil).Times(1)
+		todoRepo.EXPECT().Lock(ctx, &id).Return(after, nil).Times(1)
+		todoRepo.EXPECT().Revert(ctx, ent).DoAndReturn(
+			func(ctx context.Context, ent *domain.Todo) (*domain.Todo, error) { return ent, nil },
+		).Times(1)
+		todoRepo.EXPECT().CreateRevisions(ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, revs ...*domain.TodoRevision) error {
+				assert.Equal(t, domain.RevisionRevert, revs[0].Action())
+				assert.Equal(t, domain.TodoChange{From: current, To: previous}, revs[0].Diff()["description"])
+				return nil
+			},
+		).Times(1)
+
+		res, err := uc.Revert(ctx, ent, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, previous, *res.Description())
+		assert.Equal(t, uint(2), res.Version()) // the expected version is kept for the conditional write
+	})
+
+	t.Run("revision of a delete is not revertible", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		//
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		todoRepo := todoRepoMock.NewMockITodoRepository(ctrl)
+
+		//
+
+		uc := NewTodo(logger, locale, storageMock.NewMockIStorage(ctrl), todoRepo, todoRepoMock.NewMockITodoAttachmentRepository(ctrl), todoRepoMock.NewMockITodoShareRepository(ctrl))
+
+		//
+
+		ctx := context.Background()
+		rev := domain.NewTodoRevision(domain.RevisionDelete, after, nil)
+
+		ent := domain.NewTodo()
+		ent.SetUUID(&id)
+
+		todoRepo.EXPECT().Transaction(ctx, gomock.Any()).DoAndReturn(inTransaction(todoRepo)).Times(1)
+		todoRepo.EXPECT().Lock(ctx, &id).Return(ent, nil).Times(1)
+		todoRepo.EXPECT().GetRevision(ctx, &id, uint(3)).Return(rev, nil).Times(1)
+
+		res, err := uc.Revert(ctx, ent, 3)
+
+		assert.Nil(t, res)
+		assert.Equal(t, status.RevisionNotRevertible, meta.ErrStatus(err))
+	})
+
+	t.Run("only the deleted item is restored", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		//
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		todoRepo := todoRepoMock.NewMockITodoRepository(ctrl)
+
+		//
+
+		uc := NewTodo(logger, locale, storageMock.NewMockIStorage(ctrl), todoRepo, todoRepoMock.NewMockITodoAttachmentRepository(ctrl), todoRepoMock.NewMockITodoShareRepository(ctrl))
+
+		//
+
+		ctx := context.Background()
+
+		todoRepo.EXPECT().Transaction(ctx, gomock.Any()).DoAndReturn(inTransaction(todoRepo)).Times(1)
+		todoRepo.EXPECT().Lock(ctx, &id).Return(after, nil).Times(1)
+
+		res, err := uc.Restore(ctx, after)
+
+		assert.Nil(t, res)
+		assert.Equal(t, status.NotDeleted, meta.ErrStatus(err))
+	})
+}
+
+// HELPERS
+
+// inTransaction runs the transaction callback with the mocked repository
+func inTransaction(repo port.ITodoRepository) func(context.Context, func(port.ITodoRepository) error) error {
+	return func(ctx context.Context, fn func(port.ITodoRepository) error) error { return fn(repo) }
+}
+
+func TestTodoUsecase_Move(t *testing.T) {
+	id, afterID, beforeID := uuid.New(), uuid.New(), uuid.New()
+	todo, done := domain.StatusTodo, domain.StatusDone
+	lowerRank, upperRank := "V", "k"
+
+	item, after, before := domain.NewTodo(), domain.NewTodo(), domain.NewTodo()
+	item.SetUUID(&id)
+	after.SetUUID(&afterID)
+	after.SetStatus(&todo)
+	after.SetBoardRank(&lowerRank)
+	before.SetUUID(&beforeID)
+	before.SetStatus(&todo)
+	before.SetBoardRank(&upperRank)
+
+	t.Run("the item is ranked between its neighbours", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		//
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		todoRepo := todoRepoMock.NewMockITodoRepository(ctrl)
+
+		//
+
+		uc := NewTodo(logger, locale, storageMock.NewMockIStorage(ctrl), todoRepo, todoRepoMock.NewMockITodoAttachmentRepository(ctrl), todoRepoMock.NewMockITodoShareRepository(ctrl))
+
+		//
+
+		ctx := context.Background()
+
+		ent := domain.NewTodo()
+		ent.SetUUID(&id)
+		move := domain.NewTodoMove(ent)
+		move.SetAfter(&afterID)
+		move.SetBefore(&beforeID)
+
+		todoRepo.EXPECT().Transaction(ctx, gomock.Any()).DoAndReturn(inTransaction(todoRepo)).Times(1)
+		todoRepo.EXPECT().Lock(ctx, &id).Return(item, nil).Times(1)
+		todoRepo.EXPECT().GetByUUID(ctx, &afterID).Return(after, nil).Times(1)
+		todoRepo.EXPECT().GetByUUID(ctx, &beforeID).Return(before, nil).Times(1)
+		todoRepo.EXPECT().Move(ctx, ent).DoAndReturn(
+			func(ctx context.Context, ent *domain.Todo) (*domain.Todo, error) { return ent, nil },
+		).Times(1)
+		todoRepo.EXPECT().CreateRevisions(ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, revs ...*domain.TodoRevision) error {
+				assert.Equal(t, domain.RevisionMove, revs[0].Action())
+				return nil
+			},
+		).Times(1)
+
+		res, err := uc.Move(ctx, move)
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.StatusTodo, res.Status())
+		assert.Greater(t, res.BoardRank(), lowerRank)
+		assert.Less(t, res.BoardRank(), upperRank)
+	})
+
+	t.Run("neighbour of another column is rejected", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		//
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		todoRepo := todoRepoMock.NewMockITodoRepository(ctrl)
+
+		//
+
+		uc := NewTodo(logger, locale, storageMock.NewMockIStorage(ctrl), todoRepo, todoRepoMock.NewMockITodoAttachmentRepository(ctrl), todoRepoMock.NewMockITodoShareRepository(ctrl))
+
+		//
+
+		ctx := context.Background()
+
+		ent := domain.NewTodo()
+		ent.SetUUID(&id)
+		move := domain.NewTodoMove(ent)
+		move.SetStatus(&done)
+		move.SetAfter(&afterID)
+
+		todoRepo.EXPECT().Transaction(ctx, gomock.Any()).DoAndReturn(inTransaction(todoRepo)).Times(1)
+		todoRepo.EXPECT().Lock(ctx, &id).Return(item, nil).Times(1)
+		todoRepo.EXPECT().GetByUUID(ctx, &afterID).Return(after, nil).Times(1)
+		todoRepo.EXPECT().Move(gomock.Any(), gomock.Any()).Times(0)
+
+		res, err := uc.Move(ctx, move)
+
+		assert.Nil(t, res)
+		assert.Equal(t, status.InvalidMove, meta.ErrStatus(err))
+	})
+}
+
+func TestTodoUsecase_Import(t *testing.T) {
+	description := "imported mock item"
+
+	newItems := func() []*domain.Todo {
+		first, second := domain.NewTodo(), domain.NewTodo()
+		first.SetDescription(&description)
+		second.SetDescription(&description)
+
+		return []*domain.Todo{first, second}
+	}
+
+	t.Run("the items are owned by the importer with their revisions", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		//
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		todoRepo := todoRepoMock.NewMockITodoRepository(ctrl)
+
+		//
+
+		uc := NewTodo(logger, locale, storageMock.NewMockIStorage(ctrl), todoRepo, todoRepoMock.NewMockITodoAttachmentRepository(ctrl), todoRepoMock.NewMockITodoShareRepository(ctrl))
+
+		//
+
+		ctx := reqctx.WithActor(context.Background(), "42")
+
+		todoRepo.EXPECT().Transaction(ctx, gomock.Any()).DoAndReturn(inTransaction(todoRepo)).Times(1)
+		todoRepo.EXPECT().CreateInBatches(ctx, gomock.Len(2)).DoAndReturn(
+			func(ctx context.Context, ents []*domain.Todo) ([]*domain.Todo, error) { return ents, nil },
+		).Times(1)
+		todoRepo.EXPECT().CreateRevisions(ctx, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+		res, err := uc.Import(ctx, newItems())
+
+		assert.NoError(t, err)
+		assert.Len(t, res, 2)
+
+		for _, item := range res {
+			assert.Equal(t, "42", *item.Owner())
+		}
+	})
+
+	t.Run("nothing is imported by a failure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		//
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		todoRepo := todoRepoMock.NewMockITodoRepository(ctrl)
+
+		//
+
+		uc := NewTodo(logger, locale, storageMock.NewMockIStorage(ctrl), todoRepo, todoRepoMock.NewMockITodoAttachmentRepository(ctrl), todoRepoMock.NewMockITodoShareRepository(ctrl))
+
+		//
+
+		ctx := context.Background()
+
+		todoRepo.EXPECT().Transaction(ctx, gomock.Any()).DoAndReturn(inTransaction(todoRepo)).Times(1)
+		todoRepo.EXPECT().CreateInBatches(ctx, gomock.Len(2)).Return(nil, meta.ServiceErr(status.Failed)).Times(1)
+		todoRepo.EXPECT().CreateRevisions(gomock.Any(), gomock.Any()).Times(0)
+
+		res, err := uc.Import(ctx, newItems())
+
+		assert.Nil(t, res)
+		assert.Equal(t, status.Failed, meta.ErrStatus(err))
+	})
+}
+
+func TestTodoUsecase_Assign(t *testing.T) {
+	id := uuid.New()
+
+	t.Run("the assignment is recorded as a revision", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		//
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		todoRepo := todoRepoMock.NewMockITodoRepository(ctrl)
+
+		//
+
+		uc := NewTodo(logger, locale, storageMock.NewMockIStorage(ctrl), todoRepo, todoRepoMock.NewMockITodoAttachmentRepository(ctrl), todoRepoMock.NewMockITodoShareRepository(ctrl))
+
+		//
+
+		ctx := reqctx.WithActor(context.Background(), "7")
+
+		locked, assigned, reassigned := domain.NewTodo(), domain.NewTodo(), domain.NewTodo()
+		for _, item := range []*domain.Todo{locked, assigned, reassigned} {
+			item.SetUUID(&id)
+			item.SetVersion(1)
+		}
+
+		reassigned.SetVersion(2)
+
+		assigned.SetAssignees([]string{"42"})
+		reassigned.SetAssignees([]string{"43"})
+
+		ent := domain.NewTodo()
+		ent.SetUUID(&id)
+		ent.SetAssignees([]string{"43"})
+
+		actor := "7"
+		todoRepo.EXPECT().Transaction(ctx, gomock.Any()).DoAndReturn(inTransaction(todoRepo)).Times(1)
+		todoRepo.EXPECT().Lock(ctx, &id).Return(locked, nil).Times(1)
+		gomock.InOrder(
+			todoRepo.EXPECT().GetByUUID(ctx, &id).Return(assigned, nil).Times(1),
+			todoRepo.EXPECT().Assign(ctx, &id, []string{"43"}, &actor).Return(nil).Times(1),
+			todoRepo.EXPECT().GetByUUID(ctx, &id).Return(reassigned, nil).Times(1),
+		)
+		todoRepo.EXPECT().CreateRevisions(ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, revs ...*domain.TodoRevision) error {
+				assert.Equal(t, domain.RevisionAssign, revs[0].Action())
+				assert.Equal(t, domain.TodoChange{From: []string{"42"}, To: []string{"43"}}, revs[0].Diff()["assignees"])
+				return nil
+			},
+		).Times(1)
+
+		res, err := uc.Assign(ctx, ent)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"43"}, res.Assignees())
+		assert.Equal(t, uint(2), res.Version())
+	})
+}
+
+func TestTodoUsecase_Purge(t *testing.T) {
+	t.Run("the blobs of the purged items are removed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		//
+
+		locale := localeMock.NewMockILocale(ctrl)
+		logger := loggerMock.NewMockILogger(ctrl)
+		blobs := storageMock.NewMockIStorage(ctrl)
+		todoRepo := todoRepoMock.NewMockITodoRepository(ctrl)
+		attachmentRepo := todoRepoMock.NewMockITodoAttachmentRepository(ctrl)
+		shareRepo := todoRepoMock.NewMockITodoShareRepository(ctrl)
+
+		//
+
+		uc := NewTodo(logger, locale, blobs, todoRepo, attachmentRepo, shareRepo)
+
+		//
+
+		ctx := context.Background()
+		before := time.Now()
+		ids := []uuid.UUID{uuid.New(), uuid.New()}
+
+		attachment := domain.NewTodoAttachment()
+		attachment.SetStorageKey(domain.AttachmentStorageKey(ids[0], uuid.New()))
+
+		todoRepo.EXPECT().GetDeletedBefore(ctx, before, purgeBatchSize).Return(ids, nil).Times(1)
+		attachmentRepo.EXPECT().DeleteByTodos(ctx, ids).Return([]*domain.TodoAttachment{attachment}, nil).Times(1)
+		blobs.EXPECT().Delete(ctx, attachment.StorageKey()).Return(nil).Times(1)
+		shareRepo.EXPECT().DeleteByTodos(ctx, ids).Return(nil).Times(1)
+		todoRepo.EXPECT().Purge(ctx, ids).Return(nil).Times(1)
+
+		purged, err := uc.Purge(ctx, before)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, purged)
+	})
+}
@@ -0,0 +1,157 @@
+package usecase
+
+import (
+	"context"
+	"microservice/internal/core/domain"
+	"microservice/internal/core/port"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracedTodoUsecase traces the calls of the todo usecase, the spans are the children of the request span
+type TracedTodoUsecase struct {
+	uc     port.ITodoUsecase
+	tracer trace.Tracer
+}
+
+func NewTracedTodo(uc port.ITodoUsecase, tracer trace.Tracer) port.ITodoUsecase {
+	return &TracedTodoUsecase{uc: uc, tracer: tracer}
+}
+
+func (t *TracedTodoUsecase) Create(ctx context.Context, ent *domain.Todo) (res *domain.Todo, err error) {
+	ctx, span := t.start(ctx, "Create")
+	defer func() { end(span, err) }()
+
+	return t.uc.Create(ctx, ent)
+}
+
+func (t *TracedTodoUsecase) Detail(ctx context.Context, id *uuid.UUID) (res *domain.Todo, err error) {
+	ctx, span := t.start(ctx, "Detail")
+	defer func() { end(span, err) }()
+
+	return t.uc.Detail(ctx, id)
+}
+
+func (t *TracedTodoUsecase) GetList(ctx context.Context, qp *domain.TodoListReqQryParam) (res *domain.TodoList, err error) {
+	ctx, span := t.start(ctx, "GetList")
+	defer func() { end(span, err) }()
+
+	return t.uc.GetList(ctx, qp)
+}
+
+func (t *TracedTodoUsecase) Export(ctx context.Context, qp *domain.TodoListReqQryParam, each func(*domain.Todo) error) (err error) {
+	ctx, span := t.start(ctx, "Export")
+	defer func() { end(span, err) }()
+
+	return t.uc.Export(ctx, qp, each)
+}
+
+func (t *TracedTodoUsecase) Import(ctx context.Context, ents []*domain.Todo) (res []*domain.Todo, err error) {
+	ctx, span := t.start(ctx, "Import")
+	defer func() { end(span, err) }()
+
+	return t.uc.Import(ctx, ents)
+}
+
+func (t *TracedTodoUsecase) Update(ctx context.Context, ent *domain.Todo) (res *domain.Todo, err error) {
+	ctx, span := t.start(ctx, "Update")
+	defer func() { end(span, err) }()
+
+	return t.uc.Update(ctx, ent)
+}
+
+func (t *TracedTodoUsecase) Delete(ctx context.Context, ent *domain.Todo) (err error) {
+	ctx, span := t.start(ctx, "Delete")
+	defer func() { end(span, err) }()
+
+	return t.uc.Delete(ctx, ent)
+}
+
+func (t *TracedTodoUsecase) Restore(ctx context.Context, ent *domain.Todo) (res *domain.Todo, err error) {
+	ctx, span := t.start(ctx, "Restore")
+	defer func() { end(span, err) }()
+
+	return t.uc.Restore(ctx, ent)
+}
+
+func (t *TracedTodoUsecase) History(ctx context.Context, id *uuid.UUID, qp *domain.ReqBaseQryParam) (res *domain.TodoRevisionList, err error) {
+	ctx, span := t.start(ctx, "History")
+	defer func() { end(span, err) }()
+
+	return t.uc.History(ctx, id, qp)
+}
+
+func (t *TracedTodoUsecase) Revert(ctx context.Context, ent *domain.Todo, revision uint) (res *domain.Todo, err error) {
+	ctx, span := t.start(ctx, "Revert")
+	defer func() { end(span, err) }()
+
+	return t.uc.Revert(ctx, ent, revision)
+}
+
+func (t *TracedTodoUsecase) Assign(ctx context.Context, ent *domain.Todo) (res *domain.Todo, err error) {
+	ctx, span := t.start(ctx, "Assign")
+	defer func() { end(span, err) }()
+
+	return t.uc.Assign(ctx, ent)
+}
+
+func (t *TracedTodoUsecase) Workload(ctx context.Context) (res []*domain.TodoWorkload, err error) {
+	ctx, span := t.start(ctx, "Workload")
+	defer func() { end(span, err) }()
+
+	return t.uc.Workload(ctx)
+}
+
+func (t *TracedTodoUsecase) Stats(ctx context.Context) (res *domain.TodoWorkload, err error) {
+	ctx, span := t.start(ctx, "Stats")
+	defer func() { end(span, err) }()
+
+	return t.uc.Stats(ctx)
+}
+
+func (t *TracedTodoUsecase) Move(ctx context.Context, move *domain.TodoMove) (res *domain.Todo, err error) {
+	ctx, span := t.start(ctx, "Move")
+	defer func() { end(span, err) }()
+
+	return t.uc.Move(ctx, move)
+}
+
+func (t *TracedTodoUsecase) Rebalance(ctx context.Context, maxLength int) (res int, err error) {
+	ctx, span := t.start(ctx, "Rebalance")
+	defer func() { end(span, err) }()
+
+	return t.uc.Rebalance(ctx, maxLength)
+}
+
+func (t *TracedTodoUsecase) Purge(ctx context.Context, before time.Time) (res int, err error) {
+	ctx, span := t.start(ctx, "Purge")
+	defer func() { end(span, err) }()
+
+	return t.uc.Purge(ctx, before)
+}
+
+func (t *TracedTodoUsecase) Bulk(ctx context.Context, bulk *domain.TodoBulk) (res []*domain.TodoBulkResult, err error) {
+	ctx, span := t.start(ctx, "Bulk")
+	defer func() { end(span, err) }()
+
+	return t.uc.Bulk(ctx, bulk)
+}
+
+// HELPERS
+
+func (t *TracedTodoUsecase) start(ctx context.Context, method string) (context.Context, trace.Span) {
+	return t.tracer.Start(ctx, "todo.uc."+method, trace.WithSpanKind(trace.SpanKindInternal))
+}
+
+// end records the error of the call on the span
+func end(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
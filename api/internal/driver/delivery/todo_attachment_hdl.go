@@ -0,0 +1,218 @@
+package delivery
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"microservice/internal/adapter/locale"
+	"microservice/internal/adapter/logger"
+	"microservice/internal/core/domain"
+	"microservice/internal/core/port"
+	"microservice/internal/driver/dto"
+	"microservice/internal/server/http/status"
+	"microservice/pkg/meta"
+	"mime"
+	"mime/multipart"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// attachmentFormField the name of the file part of the upload form
+const attachmentFormField = "file"
+
+type (
+	ITodoAttachmentHandler interface {
+		Upload(ctx *gin.Context)
+		GetList(ctx *gin.Context)
+		Download(ctx *gin.Context)
+		Delete(ctx *gin.Context)
+	}
+
+	TodoAttachmentHandler struct {
+		lgr          logger.ILogger
+		l            locale.ILocale
+		attachmentUC port.ITodoAttachmentUsecase
+	}
+)
+
+func NewTodoAttachment(lgr logger.ILogger, l locale.ILocale, attachmentUC port.ITodoAttachmentUsecase) ITodoAttachmentHandler {
+	return &TodoAttachmentHandler{lgr: lgr, l: l, attachmentUC: attachmentUC}
+}
+
+// Upload godoc
+// @Summary Upload Todo Attachment
+// @Description The `file` part of the form is streamed, the size and the type detected from the content are limited by the config
+// @Tags Attachment
+// @Accept multipart/form-data
+// @Produce json
+// @Param uuid path string true "Todo UUID" example(f81eee2d-2cca-4169-8062-7404a78d5c3b)
+// @Param X-User-ID header string false "id of the uploader" example(42)
+// @Param file formData file true "the attached file"
+// @Success 201 {object} meta.Response{data=dto.AttachmentResponse, error=nil} "success response"
+// @Failure	400 {object} meta.Response{data=nil} "process failure"
+// @Failure	404 {object} meta.Response{data=nil} "todo not found"
+// @Failure	413 {object} meta.Response{data=nil} "the file exceeds the size limit"
+// @Failure	415 {object} meta.Response{data=nil} "the type of the file is not allowed"
+// @Failure	422 {object} meta.Response{data=nil} "the file part is missing"
+// @Router /api/v1/todo/{uuid}/attachments [post]
+func (h *TodoAttachmentHandler) Upload(ctx *gin.Context) {
+	item, err := meta.ReqRouteParamsToDomain[*dto.DetailUriRequest, domain.Todo](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	part, err := h.filePart(ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Msg(meta.Locale(ctx, h.l).Get("attachment_required")).Err(err).Json()
+		return
+	}
+
+	req := domain.NewTodoAttachment()
+	req.SetTodoUUID(item.UUID())
+	req.SetFileName(part.FileName())
+	req.SetContent(part)
+
+	res, ucErr := h.attachmentUC.Upload(ctx, req)
+	if ucErr != nil {
+		meta.Resp(ctx, h.l).ServiceErr(ucErr).Json()
+		return
+	}
+
+	meta.Resp(ctx, h.l).Data(dto.AttachmentResp(res)).Status(status.Created).Json()
+	return
+}
+
+// GetList godoc
+// @Summary Get Todo Attachments List
+// @Description Lists the attachments of the item in the order of their upload
+// @Tags Attachment
+// @Accept json
+// @Produce json
+// @Param uuid path string true "Todo UUID" example(f81eee2d-2cca-4169-8062-7404a78d5c3b)
+// @Success 200 {object} meta.Response{data=[]dto.AttachmentResponse, error=nil} "success response"
+// @Failure	400 {object} meta.Response{data=nil} "process failure"
+// @Failure	404 {object} meta.Response{data=nil} "todo not found"
+// @Failure	422 {object} meta.Response{data=nil} "invalid data types"
+// @Router /api/v1/todo/{uuid}/attachments [get]
+func (h *TodoAttachmentHandler) GetList(ctx *gin.Context) {
+	item, err := meta.ReqRouteParamsToDomain[*dto.DetailUriRequest, domain.Todo](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	id := item.UUID()
+	res, ucErr := h.attachmentUC.GetList(ctx, &id)
+	if ucErr != nil {
+		meta.Resp(ctx, h.l).ServiceErr(ucErr).Json()
+		return
+	}
+
+	meta.Resp(ctx, h.l).Data(dto.AttachmentListResp(res)).Json()
+	return
+}
+
+// Download godoc
+// @Summary Download Todo Attachment
+// @Description Streams the content, the SHA-256 of the content is sent by the `Repr-Digest` header
+// @Tags Attachment
+// @Produce octet-stream
+// @Param uuid path string true "Todo UUID" example(f81eee2d-2cca-4169-8062-7404a78d5c3b)
+// @Param attachment path string true "Attachment UUID" example(0d6c2b1e-4f3a-4b8e-9c7d-1a2b3c4d5e6f)
+// @Success 200 {file} file "the content of the file"
+// @Header 200 {string} Repr-Digest "sha-256=:<base64 of the checksum>:"
+// @Failure	400 {object} meta.Response{data=nil} "process failure"
+// @Failure	404 {object} meta.Response{data=nil} "not found"
+// @Failure	422 {object} meta.Response{data=nil} "invalid data types"
+// @Router /api/v1/todo/{uuid}/attachments/{attachment} [get]
+func (h *TodoAttachmentHandler) Download(ctx *gin.Context) {
+	req, err := meta.ReqRouteParamsToDomain[*dto.AttachmentUriRequest, domain.TodoAttachment](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	todoID, id := req.TodoUUID(), req.UUID()
+	res, ucErr := h.attachmentUC.Download(ctx, &todoID, &id)
+	if ucErr != nil {
+		meta.Resp(ctx, h.l).ServiceErr(ucErr).Json()
+		return
+	}
+
+	defer func() { _ = res.Content().Close() }()
+
+	headers := map[string]string{
+		"Content-Disposition": mime.FormatMediaType("attachment", map[string]string{"filename": res.FileName()}),
+		"ETag":                fmt.Sprintf(`"%s"`, res.Checksum()),
+	}
+
+	if sum, hexErr := hex.DecodeString(res.Checksum()); hexErr == nil {
+		headers["Repr-Digest"] = fmt.Sprintf("sha-256=:%s:", base64.StdEncoding.EncodeToString(sum))
+	}
+
+	meta.Stream(ctx)
+	ctx.DataFromReader(http.StatusOK, res.Size(), res.ContentType(), res.Content(), headers)
+	return
+}
+
+// Delete godoc
+// @Summary Delete Todo Attachment
+// @Description Deletes the attachment with its content
+// @Tags Attachment
+// @Accept json
+// @Produce json
+// @Param uuid path string true "Todo UUID" example(f81eee2d-2cca-4169-8062-7404a78d5c3b)
+// @Param attachment path string true "Attachment UUID" example(0d6c2b1e-4f3a-4b8e-9c7d-1a2b3c4d5e6f)
+// @Success 200 {object} meta.Response{data=nil, error=nil} "success response"
+// @Failure	400 {object} meta.Response{data=nil} "process failure"
+// @Failure	404 {object} meta.Response{data=nil} "not found"
+// @Failure	422 {object} meta.Response{data=nil} "invalid data types"
+// @Router /api/v1/todo/{uuid}/attachments/{attachment} [delete]
+func (h *TodoAttachmentHandler) Delete(ctx *gin.Context) {
+	req, err := meta.ReqRouteParamsToDomain[*dto.AttachmentUriRequest, domain.TodoAttachment](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	if ucErr := h.attachmentUC.Delete(ctx, req); ucErr != nil {
+		meta.Resp(ctx, h.l).ServiceErr(ucErr).Json()
+		return
+	}
+
+	meta.Resp(ctx, h.l).Status(status.Deleted).Json()
+	return
+}
+
+// HELPERS
+
+// filePart the first file part of the form, the parts before it are skipped. the body is read as a stream, so the file is not buffered
+func (h *TodoAttachmentHandler) filePart(ctx *gin.Context) (*multipart.Part, error) {
+	reader, err := ctx.Request.MultipartReader()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		part, partErr := reader.NextPart()
+		if errors.Is(partErr, io.EOF) {
+			return nil, fmt.Errorf("the `%s` part is missing", attachmentFormField)
+		}
+
+		if partErr != nil {
+			h.lgr.Debug("todo.attachment.hdl.part", zap.Error(partErr), logger.Context(ctx))
+			return nil, partErr
+		}
+
+		if part.FormName() == attachmentFormField && len(part.FileName()) > 0 {
+			return part, nil
+		}
+
+		_ = part.Close()
+	}
+}
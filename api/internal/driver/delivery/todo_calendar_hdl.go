@@ -0,0 +1,180 @@
+package delivery
+
+import (
+	"microservice/internal/adapter/locale"
+	"microservice/internal/adapter/logger"
+	"microservice/internal/core/domain"
+	"microservice/internal/core/port"
+	"microservice/internal/driver/dto"
+	"microservice/internal/server/http/status"
+	"microservice/pkg/meta"
+	"mime"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// calendarName the display name of the subscribed calendars
+const calendarName = "Todos"
+
+type (
+	ITodoCalendarHandler interface {
+		CreateFeed(ctx *gin.Context)
+		GetFeeds(ctx *gin.Context)
+		RevokeFeed(ctx *gin.Context)
+		Feed(ctx *gin.Context)
+	}
+
+	TodoCalendarHandler struct {
+		lgr        logger.ILogger
+		l          locale.ILocale
+		calendarUC port.ITodoCalendarUsecase
+	}
+)
+
+func NewTodoCalendar(lgr logger.ILogger, l locale.ILocale, calendarUC port.ITodoCalendarUsecase) ITodoCalendarHandler {
+	return &TodoCalendarHandler{lgr: lgr, l: l, calendarUC: calendarUC}
+}
+
+// CreateFeed godoc
+// @Summary Create Calendar Feed
+// @Description Creates an iCalendar feed of the items readable by the user, the calendars subscribe to its path. Its token is returned only once
+// @Tags Calendar
+// @Accept json
+// @Produce json
+// @Param X-User-ID header string true "id of the user" example(42)
+// @Param Request body dto.CalendarFeedCreateRequest true "necessary fields for request"
+// @Param Idempotency-Key header string false "unique key of the request, the retries with the same key replay the first response" example(7c9e6679-7425-40de-944b-e07fc1f90ae7)
+// @Success 201 {object} meta.Response{data=dto.CalendarFeedResponse, error=nil} "success response"
+// @Failure	400 {object} meta.Response{data=nil} "process failure"
+// @Failure	401 {object} meta.Response{data=nil} "the user is unknown"
+// @Failure	422 {object} meta.Response{data=nil} "unprocessable"
+// @Router /api/v1/todo/calendar/feeds [post]
+func (h *TodoCalendarHandler) CreateFeed(ctx *gin.Context) {
+	req, err := meta.ReqBodyToDomain[*dto.CalendarFeedCreateRequest, domain.TodoCalendarFeed](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	res, ucErr := h.calendarUC.CreateFeed(ctx, req)
+	if ucErr != nil {
+		meta.Resp(ctx, h.l).ServiceErr(ucErr).Json()
+		return
+	}
+
+	meta.Resp(ctx, h.l).Data(dto.CalendarFeedResp(res)).Status(status.Created).Json()
+	return
+}
+
+// GetFeeds godoc
+// @Summary Get Calendar Feeds
+// @Description Lists the feeds of the user without their tokens
+// @Tags Calendar
+// @Accept json
+// @Produce json
+// @Param X-User-ID header string true "id of the user" example(42)
+// @Success 200 {object} meta.Response{data=[]dto.CalendarFeedResponse, error=nil} "success response"
+// @Failure	400 {object} meta.Response{data=nil} "process failure"
+// @Failure	401 {object} meta.Response{data=nil} "the user is unknown"
+// @Router /api/v1/todo/calendar/feeds [get]
+func (h *TodoCalendarHandler) GetFeeds(ctx *gin.Context) {
+	res, ucErr := h.calendarUC.GetFeeds(ctx)
+	if ucErr != nil {
+		meta.Resp(ctx, h.l).ServiceErr(ucErr).Json()
+		return
+	}
+
+	meta.Resp(ctx, h.l).Data(dto.CalendarFeedListResp(res)).Json()
+	return
+}
+
+// RevokeFeed godoc
+// @Summary Revoke Calendar Feed
+// @Tags Calendar
+// @Accept json
+// @Produce json
+// @Param feed path string true "Feed UUID" example(5c7e9a1b-3d5f-4a7b-9c1d-3e5f7a9b1c2d)
+// @Param X-User-ID header string true "id of the user" example(42)
+// @Success 200 {object} meta.Response{data=nil, error=nil} "success response"
+// @Failure	400 {object} meta.Response{data=nil} "process failure"
+// @Failure	401 {object} meta.Response{data=nil} "the user is unknown"
+// @Failure	404 {object} meta.Response{data=nil} "not found"
+// @Failure	422 {object} meta.Response{data=nil} "invalid data types"
+// @Router /api/v1/todo/calendar/feeds/{feed} [delete]
+func (h *TodoCalendarHandler) RevokeFeed(ctx *gin.Context) {
+	req, err := meta.ReqRouteParamsToDomain[*dto.CalendarFeedUriRequest, domain.TodoCalendarFeed](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	if ucErr := h.calendarUC.RevokeFeed(ctx, req); ucErr != nil {
+		meta.Resp(ctx, h.l).ServiceErr(ucErr).Json()
+		return
+	}
+
+	meta.Resp(ctx, h.l).Status(status.Deleted).Json()
+	return
+}
+
+// Feed godoc
+// @Summary Calendar Feed
+// @Description The iCalendar(RFC 5545) of the feed, the token of the path grants the read-only access without identity.
+// @Description The `vtodo` feeds list the items as tasks, the `vevent` feeds list the items with due date as events.
+// @Tags Calendar
+// @Produce text/calendar
+// @Param file path string true "the token of the feed with the .ics extension" example(q0Zx3Jm2b6LhT9r1Vw8yNc4uEa7sKd5fGp0oRi2jHl.ics)
+// @Success 200 {string} string "the calendar"
+// @Failure	400 {object} meta.Response{data=nil} "process failure"
+// @Failure	404 {object} meta.Response{data=nil} "unknown token"
+// @Failure	422 {object} meta.Response{data=nil} "invalid data types"
+// @Router /ical/{file} [get]
+func (h *TodoCalendarHandler) Feed(ctx *gin.Context) {
+	req, err := meta.ReqRouteParamsToDomain[*dto.CalendarFileUriRequest, domain.TodoCalendarFeed](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	feed, ucErr := h.calendarUC.GetFeed(ctx, req.Token())
+	if ucErr != nil {
+		meta.Resp(ctx, h.l).ServiceErr(ucErr).Json()
+		return
+	}
+
+	// NOTE: the headers are sent with the first item, so the failures before it are still reported as JSON
+	meta.Stream(ctx)
+	writer, started := dto.NewCalendarWriter(ctx.Writer, feed.Component(), calendarName), false
+	start := func() {
+		if !started {
+			started = true
+			ctx.Header("Content-Disposition", mime.FormatMediaType("inline", map[string]string{"filename": "todos.ics"}))
+			ctx.Header("Content-Type", "text/calendar; charset=utf-8")
+			ctx.Status(http.StatusOK)
+		}
+	}
+
+	ucErr = h.calendarUC.ExportFeed(ctx, feed, func(item *domain.Todo) error {
+		start()
+		return writer.Write(item)
+	})
+
+	if ucErr != nil && !started {
+		meta.Resp(ctx, h.l).ServiceErr(ucErr).Json()
+		return
+	}
+
+	if ucErr != nil {
+		h.lgr.Warn("todo.calendar.hdl.feed", zap.Error(ucErr), logger.Context(ctx))
+		return
+	}
+
+	start()
+	if closeErr := writer.Close(); closeErr != nil {
+		h.lgr.Warn("todo.calendar.hdl.feed.close", zap.Error(closeErr), logger.Context(ctx))
+	}
+
+	return
+}
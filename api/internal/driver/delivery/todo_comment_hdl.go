@@ -0,0 +1,226 @@
+package delivery
+
+import (
+	"microservice/internal/adapter/locale"
+	"microservice/internal/adapter/logger"
+	"microservice/internal/core/domain"
+	"microservice/internal/core/port"
+	"microservice/internal/driver/dto"
+	"microservice/internal/server/http/status"
+	"microservice/pkg/meta"
+
+	"github.com/gin-gonic/gin"
+)
+
+type (
+	ITodoCommentHandler interface {
+		Create(ctx *gin.Context)
+		GetList(ctx *gin.Context)
+		Update(ctx *gin.Context)
+		Delete(ctx *gin.Context)
+		Activity(ctx *gin.Context)
+	}
+
+	TodoCommentHandler struct {
+		lgr       logger.ILogger
+		l         locale.ILocale
+		commentUC port.ITodoCommentUsecase
+	}
+)
+
+func NewTodoComment(lgr logger.ILogger, l locale.ILocale, commentUC port.ITodoCommentUsecase) ITodoCommentHandler {
+	return &TodoCommentHandler{lgr: lgr, l: l, commentUC: commentUC}
+}
+
+// Create godoc
+// @Summary Create Todo Comment
+// @Description The `@user` mentions of the body are parsed, the reply to a reply is added to the thread of its root comment
+// @Tags Comment
+// @Accept json
+// @Produce json
+// @Param uuid path string true "Todo UUID" example(f81eee2d-2cca-4169-8062-7404a78d5c3b)
+// @Param X-User-ID header string false "id of the author" example(42)
+// @Param Idempotency-Key header string false "unique key of the request, the retries with the same key replay the first response" example(7c9e6679-7425-40de-944b-e07fc1f90ae7)
+// @Param Request body dto.CommentCreateRequest true "necessary fields for request"
+// @Success 201 {object} meta.Response{data=dto.CommentResponse, error=nil} "success response"
+// @Failure	400 {object} meta.Response{data=nil} "process failure"
+// @Failure	404 {object} meta.Response{data=nil} "todo not found"
+// @Failure	422 {object} meta.Response{data=nil} "unprocessable, or the replied comment does not belong to the item"
+// @Router /api/v1/todo/{uuid}/comments [post]
+func (h *TodoCommentHandler) Create(ctx *gin.Context) {
+	item, err := meta.ReqRouteParamsToDomain[*dto.DetailUriRequest, domain.Todo](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	req, err := meta.ReqBodyToDomain[*dto.CommentCreateRequest, domain.TodoComment](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	req.SetTodoUUID(item.UUID())
+
+	res, ucErr := h.commentUC.Create(ctx, req)
+	if ucErr != nil {
+		meta.Resp(ctx, h.l).ServiceErr(ucErr).Json()
+		return
+	}
+
+	meta.Resp(ctx, h.l).Data(dto.CommentResp(res)).Status(status.Created).Json()
+	return
+}
+
+// GetList godoc
+// @Summary Get Todo Comments List
+// @Description Lists the root comments with their reply counts, or the replies of the `parent` comment
+// @Tags Comment
+// @Accept json
+// @Produce json
+// @Param uuid path string true "Todo UUID" example(f81eee2d-2cca-4169-8062-7404a78d5c3b)
+// @Param page query int false "Page Number"
+// @Param limit query int false "Page Limit"
+// @Param order query string false "`asc`(default) or `desc` by the creation"
+// @Param parent query string false "UUID of the root comment, lists its replies" example(5b0e7f1c-3d4a-4c1e-9b8f-2a6d7e9c0f13)
+// @Success 200 {object} meta.Response{data=dto.CommentListResponse, error=nil} "success response"
+// @Failure	400 {object} meta.Response{data=nil} "process failure"
+// @Failure	404 {object} meta.Response{data=nil} "todo not found"
+// @Failure	422 {object} meta.Response{data=nil} "invalid data types"
+// @Router /api/v1/todo/{uuid}/comments [get]
+func (h *TodoCommentHandler) GetList(ctx *gin.Context) {
+	item, err := meta.ReqRouteParamsToDomain[*dto.DetailUriRequest, domain.Todo](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	qp, err := meta.ReqQryParamToDomain[*dto.CommentListQryRequest, domain.TodoCommentListReqQryParam](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	id := item.UUID()
+	res, ucErr := h.commentUC.GetList(ctx, &id, qp)
+	if ucErr != nil {
+		meta.Resp(ctx, h.l).ServiceErr(ucErr).Json()
+		return
+	}
+
+	meta.Resp(ctx, h.l).Data(dto.CommentListResp(qp, res)).Json()
+	return
+}
+
+// Update godoc
+// @Summary Edit Todo Comment
+// @Description Only the author edits the comment, the anonymous comments are editable by anyone
+// @Tags Comment
+// @Accept json
+// @Produce json
+// @Param uuid path string true "Todo UUID" example(f81eee2d-2cca-4169-8062-7404a78d5c3b)
+// @Param comment path string true "Comment UUID" example(5b0e7f1c-3d4a-4c1e-9b8f-2a6d7e9c0f13)
+// @Param X-User-ID header string false "id of the author" example(42)
+// @Param Request body dto.CommentUpdateRequest true "necessary fields for request"
+// @Success 200 {object} meta.Response{data=dto.CommentResponse, error=nil} "success response"
+// @Failure	400 {object} meta.Response{data=nil} "process failure"
+// @Failure	403 {object} meta.Response{data=nil} "not the author of the comment"
+// @Failure	404 {object} meta.Response{data=nil} "not found"
+// @Failure	422 {object} meta.Response{data=nil} "unprocessable"
+// @Router /api/v1/todo/{uuid}/comments/{comment} [put]
+func (h *TodoCommentHandler) Update(ctx *gin.Context) {
+	item, err := meta.ReqRouteParamsToDomain[*dto.CommentUriRequest, domain.TodoComment](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	req, err := meta.ReqBodyToDomain[*dto.CommentUpdateRequest, domain.TodoComment](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	id := item.UUID()
+	req.SetUUID(&id)
+	req.SetTodoUUID(item.TodoUUID())
+
+	res, ucErr := h.commentUC.Update(ctx, req)
+	if ucErr != nil {
+		meta.Resp(ctx, h.l).ServiceErr(ucErr).Json()
+		return
+	}
+
+	meta.Resp(ctx, h.l).Data(dto.CommentResp(res)).Json()
+	return
+}
+
+// Delete godoc
+// @Summary Delete Todo Comment
+// @Description Only the author deletes the comment, the replies of a root comment are deleted with it
+// @Tags Comment
+// @Accept json
+// @Produce json
+// @Param uuid path string true "Todo UUID" example(f81eee2d-2cca-4169-8062-7404a78d5c3b)
+// @Param comment path string true "Comment UUID" example(5b0e7f1c-3d4a-4c1e-9b8f-2a6d7e9c0f13)
+// @Param X-User-ID header string false "id of the author" example(42)
+// @Success 200 {object} meta.Response{data=nil, error=nil} "success response"
+// @Failure	400 {object} meta.Response{data=nil} "process failure"
+// @Failure	403 {object} meta.Response{data=nil} "not the author of the comment"
+// @Failure	404 {object} meta.Response{data=nil} "not found"
+// @Failure	422 {object} meta.Response{data=nil} "invalid data types"
+// @Router /api/v1/todo/{uuid}/comments/{comment} [delete]
+func (h *TodoCommentHandler) Delete(ctx *gin.Context) {
+	req, err := meta.ReqRouteParamsToDomain[*dto.CommentUriRequest, domain.TodoComment](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	if ucErr := h.commentUC.Delete(ctx, req); ucErr != nil {
+		meta.Resp(ctx, h.l).ServiceErr(ucErr).Json()
+		return
+	}
+
+	meta.Resp(ctx, h.l).Status(status.Deleted).Json()
+	return
+}
+
+// Activity godoc
+// @Summary Get Todo Activity Feed
+// @Description The comments and the changes(revisions) of the item merged in the chronological order
+// @Tags Comment
+// @Accept json
+// @Produce json
+// @Param uuid path string true "Todo UUID" example(f81eee2d-2cca-4169-8062-7404a78d5c3b)
+// @Param page query int false "Page Number"
+// @Param limit query int false "Page Limit"
+// @Param order query string false "`asc`(default) or `desc` by the creation"
+// @Success 200 {object} meta.Response{data=dto.ActivityResponse, error=nil} "success response"
+// @Failure	400 {object} meta.Response{data=nil} "process failure"
+// @Failure	404 {object} meta.Response{data=nil} "todo not found"
+// @Failure	422 {object} meta.Response{data=nil} "invalid data types"
+// @Router /api/v1/todo/{uuid}/activity [get]
+func (h *TodoCommentHandler) Activity(ctx *gin.Context) {
+	item, err := meta.ReqRouteParamsToDomain[*dto.DetailUriRequest, domain.Todo](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	qp, err := meta.ReqQryParamToDomain[*dto.ActivityQryRequest, domain.TodoActivityReqQryParam](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	id := item.UUID()
+	res, ucErr := h.commentUC.Activity(ctx, &id, qp)
+	if ucErr != nil {
+		meta.Resp(ctx, h.l).ServiceErr(ucErr).Json()
+		return
+	}
+
+	meta.Resp(ctx, h.l).Data(dto.ActivityResp(qp, res)).Json()
+	return
+}
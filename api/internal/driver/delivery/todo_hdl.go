@@ -1,6 +1,9 @@
 package delivery
 
 import (
+	"errors"
+	"fmt"
+	"microservice/config"
 	"microservice/internal/adapter/locale"
 	"microservice/internal/adapter/logger"
 	"microservice/internal/core/domain"
@@ -8,8 +11,11 @@ import (
 	"microservice/internal/driver/dto"
 	"microservice/internal/server/http/status"
 	"microservice/pkg/meta"
+	"mime"
+	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
 )
 
 type (
@@ -17,17 +23,38 @@ type (
 		Create(ctx *gin.Context)
 		GetDetails(ctx *gin.Context)
 		GetList(ctx *gin.Context)
+		Update(ctx *gin.Context)
+		Delete(ctx *gin.Context)
+		Bulk(ctx *gin.Context)
+		Restore(ctx *gin.Context)
+		History(ctx *gin.Context)
+		Revert(ctx *gin.Context)
+		Assign(ctx *gin.Context)
+		Workload(ctx *gin.Context)
+		Move(ctx *gin.Context)
+		Export(ctx *gin.Context)
+		Import(ctx *gin.Context)
 	}
 
 	TodoHandler struct {
 		lgr    logger.ILogger
 		l      locale.ILocale
+		config config.Todo
 		todoUC port.ITodoUsecase
 	}
 )
 
-func NewTodo(lgr logger.ILogger, l locale.ILocale, todoUC port.ITodoUsecase) ITodoHandler {
-	return &TodoHandler{lgr: lgr, l: l, todoUC: todoUC}
+const (
+	// defaultBulkMaxSize operations of a bulk request, when the config is not set
+	defaultBulkMaxSize = 100
+	// defaultImportMaxRows rows of an imported file, when the config is not set
+	defaultImportMaxRows = 1000
+	// defaultImportMaxSize bytes of an imported file, when the config is not set
+	defaultImportMaxSize int64 = 5 << 20
+)
+
+func NewTodo(lgr logger.ILogger, l locale.ILocale, config config.Todo, todoUC port.ITodoUsecase) ITodoHandler {
+	return &TodoHandler{lgr: lgr, l: l, config: config, todoUC: todoUC}
 }
 
 // Create godoc
@@ -36,11 +63,14 @@ func NewTodo(lgr logger.ILogger, l locale.ILocale, todoUC port.ITodoUsecase) ITo
 // @Accept json
 // @Produce json
 // @Param Request body dto.CreateRequest true "necessary fields for request"
+// @Param Idempotency-Key header string false "unique key of the request, the retries with the same key replay the first response" example(7c9e6679-7425-40de-944b-e07fc1f90ae7)
 // @Success 201 {object} meta.Response{data=dto.CreateResponse, error=nil} "success response"
+// @Header 201 {string} ETag "version of the item"
+// @Header 201 {string} Idempotent-Replayed "true for the replayed response of a retry"
 // @Failure	400 {object} meta.Response{data=nil} "process failure"
 // @Failure	404 {object} meta.Response{data=nil} "not found"
-// @Failure	409 {object} meta.Response{data=nil} "already exists"
-// @Failure	422 {object} meta.Response{data=nil} "unprocessable"
+// @Failure	409 {object} meta.Response{data=nil} "already exists, or the request with the same idempotency key is in progress"
+// @Failure	422 {object} meta.Response{data=nil} "unprocessable, or the idempotency key is reused with a different request"
 // @Router /api/v1/todo/create [post]
 func (h *TodoHandler) Create(ctx *gin.Context) {
 	req, err := meta.ReqBodyToDomain[*dto.CreateRequest, domain.Todo](ctx)
@@ -55,6 +85,7 @@ func (h *TodoHandler) Create(ctx *gin.Context) {
 		return
 	}
 
+	meta.SetETag(ctx, res.Version())
 	meta.Resp(ctx, h.l).Data(dto.CreateResp(res)).Status(status.Created).Json()
 	return
 }
@@ -65,7 +96,11 @@ func (h *TodoHandler) Create(ctx *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param uuid path string true "Todo UUID" example(f81eee2d-2cca-4169-8062-7404a78d5c3b)
+// @Param render query string false "`html` renders the Markdown notes to the sanitised HTML"
+// @Param If-None-Match header string false "ETag of the cached item" example("3")
 // @Success 200 {object}  meta.Response{data=dto.DetailResponse, error=nil} "success response"
+// @Header 200 {string} ETag "version of the item"
+// @Success 304 "not modified"
 // @Failure	400 {object} meta.Response{data=nil} "process failure"
 // @Failure	404 {object} meta.Response{data=nil} "not found"
 // @Failure	422 {object} meta.Response{data=nil} "invalid data types"
@@ -77,6 +112,12 @@ func (h *TodoHandler) GetDetails(ctx *gin.Context) {
 		return
 	}
 
+	qp, err := meta.ReqQryParamToDomain[*dto.DetailQryRequest, domain.TodoDetailReqQryParam](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
 	id := req.UUID()
 	res, ucErr := h.todoUC.Detail(ctx, &id)
 	if ucErr != nil {
@@ -84,7 +125,21 @@ func (h *TodoHandler) GetDetails(ctx *gin.Context) {
 		return
 	}
 
-	meta.Resp(ctx, h.l).Data(dto.DetailResp(res)).Json()
+	// NOTE: the rendered notes are a variant of the item, they are cached apart
+	variant := ""
+	if qp.RenderNotes() {
+		variant = dto.RenderHtml
+	}
+
+	etag := meta.ETag(res.Version(), variant)
+	meta.SetVariantETag(ctx, res.Version(), variant)
+
+	if meta.NotModified(ctx, etag) {
+		meta.Resp(ctx, h.l).Status(status.NotModified).Json()
+		return
+	}
+
+	meta.Resp(ctx, h.l).Data(dto.DetailResp(qp, res)).Json()
 	return
 }
 
@@ -95,11 +150,21 @@ func (h *TodoHandler) GetDetails(ctx *gin.Context) {
 // @Produce json
 // @Param page query int false "Page Number"
 // @Param limit query int false "Page Limit"
-// @Param sort query string false "`id` `description` `created_at` `updated_at`"
+// @Param sort query string false "`id` `description` `due_date` `completed_at` `created_at`(default) `updated_at` `status` `board_rank` `rank`(default of search)"
 // @Param order query string false "`asc` or `desc`"
-// @Param search query string false "Search the Description"
+// @Param search query string false "Full-text search of the Description(web-search syntax: quoted phrase, or, -excluded)"
+// @Param lang query string false "Language of the searched phrase, like `english`, `german`, `simple`"
+// @Param due_after query string false "Due at or after, date-only or RFC3339" example(2025-08-07)
+// @Param due_before query string false "Due before, date-only or RFC3339" example(2025-08-14T00:00:00+02:00)
+// @Param overdue query bool false "Only the items with a passed due date"
+// @Param view query string false "`today` `tomorrow` `this_week` `no_due_date`"
+// @Param tz query string false "IANA time zone of the views and date-only values, default: UTC" example(Europe/Berlin)
+// @Param assignee query string false "Only the items assigned to the user, `me` for the caller(X-User-ID)" example(me)
+// @Param unassigned query bool false "Only the items without assignee"
+// @Param status query string false "Only the items of the board column: `todo` `in_progress` `done`, sort by `board_rank` and order `asc` for the column order"
 // @Success 200 {object}  meta.Response{data=dto.TodoListResponse, error=nil} "success response"
 // @Failure	400 {object} meta.Response{data=nil} "process failure"
+// @Failure	401 {object} meta.Response{data=nil} "`assignee=me` without X-User-ID"
 // @Failure	422 {object} meta.Response{data=nil} "database error while retrieving"
 // @Router /api/v1/todo/list [get]
 func (h *TodoHandler) GetList(ctx *gin.Context) {
@@ -118,3 +183,558 @@ func (h *TodoHandler) GetList(ctx *gin.Context) {
 	meta.Resp(ctx, h.l).Data(dto.TodoListResp(qp, res)).Json()
 	return
 }
+
+// Update godoc
+// @Summary Update Todo
+// @Description Replaces the fields of the item, the omitted optional fields are cleared
+// @Tags Todo
+// @Accept json
+// @Produce json
+// @Param uuid path string true "Todo UUID" example(f81eee2d-2cca-4169-8062-7404a78d5c3b)
+// @Param If-Match header string false "ETag of the item, the update is rejected when the item was modified meanwhile" example("3")
+// @Param Request body dto.UpdateRequest true "necessary fields for request"
+// @Success 200 {object} meta.Response{data=dto.DetailResponse, error=nil} "success response"
+// @Header 200 {string} ETag "version of the item"
+// @Failure	400 {object} meta.Response{data=nil} "process failure"
+// @Failure	404 {object} meta.Response{data=nil} "not found"
+// @Failure	412 {object} meta.Response{data=nil} "modified by another request"
+// @Failure	422 {object} meta.Response{data=nil} "unprocessable"
+// @Router /api/v1/todo/{uuid} [put]
+func (h *TodoHandler) Update(ctx *gin.Context) {
+	item, err := meta.ReqRouteParamsToDomain[*dto.DetailUriRequest, domain.Todo](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	versions, err := meta.IfMatchVersions(ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.PreconditionFailed).Err(err).Json()
+		return
+	}
+
+	req, err := meta.ReqBodyToDomain[*dto.UpdateRequest, domain.Todo](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	id := item.UUID()
+	req.SetUUID(&id)
+	req.SetExpectedVersions(versions...)
+
+	res, ucErr := h.todoUC.Update(ctx, req)
+	if ucErr != nil {
+		meta.Resp(ctx, h.l).ServiceErr(ucErr).Json()
+		return
+	}
+
+	meta.SetETag(ctx, res.Version())
+	meta.Resp(ctx, h.l).Data(dto.DetailResp(domain.NewTodoDetailReqQryParam(), res)).Json()
+	return
+}
+
+// Delete godoc
+// @Summary Delete Todo
+// @Tags Todo
+// @Accept json
+// @Produce json
+// @Param uuid path string true "Todo UUID" example(f81eee2d-2cca-4169-8062-7404a78d5c3b)
+// @Param If-Match header string false "ETag of the item, the deletion is rejected when the item was modified meanwhile" example("3")
+// @Success 200 {object} meta.Response{data=nil, error=nil} "success response"
+// @Failure	400 {object} meta.Response{data=nil} "process failure"
+// @Failure	404 {object} meta.Response{data=nil} "not found"
+// @Failure	412 {object} meta.Response{data=nil} "modified by another request"
+// @Failure	422 {object} meta.Response{data=nil} "invalid data types"
+// @Router /api/v1/todo/{uuid} [delete]
+func (h *TodoHandler) Delete(ctx *gin.Context) {
+	req, err := meta.ReqRouteParamsToDomain[*dto.DetailUriRequest, domain.Todo](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	versions, err := meta.IfMatchVersions(ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.PreconditionFailed).Err(err).Json()
+		return
+	}
+
+	req.SetExpectedVersions(versions...)
+
+	if ucErr := h.todoUC.Delete(ctx, req); ucErr != nil {
+		meta.Resp(ctx, h.l).ServiceErr(ucErr).Json()
+		return
+	}
+
+	meta.Resp(ctx, h.l).Status(status.Deleted).Json()
+	return
+}
+
+// Bulk godoc
+// @Summary Bulk Create, Update, Complete and Delete Todos
+// @Description The `atomic` mode applies all operations in a single transaction, the first failure rolls back all of them.
+// @Description The `best_effort` mode applies every operation independently and reports the failures per item(207).
+// @Tags Todo
+// @Accept json
+// @Produce json
+// @Param Request body dto.BulkRequest true "operations in the order of execution"
+// @Param Idempotency-Key header string false "unique key of the request, the retries with the same key replay the first response" example(7c9e6679-7425-40de-944b-e07fc1f90ae7)
+// @Success 200 {object} meta.Response{data=dto.BulkResponse, error=nil} "all operations are applied"
+// @Success 207 {object} meta.Response{data=dto.BulkResponse, error=nil} "some operations of the best-effort request are failed"
+// @Failure	400 {object} meta.Response{data=dto.BulkResponse} "process failure, the atomic request is rolled back"
+// @Failure	404 {object} meta.Response{data=dto.BulkResponse} "not found, the atomic request is rolled back"
+// @Failure	412 {object} meta.Response{data=dto.BulkResponse} "outdated version, the atomic request is rolled back"
+// @Failure	413 {object} meta.Response{data=nil} "the body of the idempotent request exceeds the size limit"
+// @Failure	422 {object} meta.Response{data=nil} "unprocessable, or too many operations"
+// @Router /api/v1/todo/bulk [post]
+func (h *TodoHandler) Bulk(ctx *gin.Context) {
+	req, err := meta.ReqBodyToDomain[*dto.BulkRequest, domain.TodoBulk](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	if maxSize := h.bulkMaxSize(); len(req.Operations()) > maxSize {
+		meta.Resp(ctx, h.l).Status(status.Validate).Msg(meta.Locale(ctx, h.l).Get("bulk_too_large")).
+			Err(fmt.Errorf("at most %d operations are allowed", maxSize)).Json()
+		return
+	}
+
+	res, ucErr := h.todoUC.Bulk(ctx, req)
+	resp := dto.BulkResp(meta.Locale(ctx, h.l), req, res)
+
+	if ucErr != nil {
+		meta.Resp(ctx, h.l).ServiceErr(ucErr).Data(resp).Json()
+		return
+	}
+
+	if resp.Failed > 0 {
+		meta.Resp(ctx, h.l).Status(status.BulkPartial).Data(resp).Json()
+		return
+	}
+
+	meta.Resp(ctx, h.l).Data(resp).Json()
+	return
+}
+
+// Restore godoc
+// @Summary Restore Deleted Todo
+// @Tags Todo
+// @Accept json
+// @Produce json
+// @Param uuid path string true "Todo UUID" example(f81eee2d-2cca-4169-8062-7404a78d5c3b)
+// @Param If-Match header string false "ETag of the deleted item, the restore is rejected when the item was modified meanwhile" example("3")
+// @Success 200 {object} meta.Response{data=dto.DetailResponse, error=nil} "success response"
+// @Header 200 {string} ETag "version of the item"
+// @Failure	400 {object} meta.Response{data=nil} "process failure"
+// @Failure	404 {object} meta.Response{data=nil} "not found"
+// @Failure	409 {object} meta.Response{data=nil} "the item is not deleted"
+// @Failure	412 {object} meta.Response{data=nil} "modified by another request"
+// @Failure	422 {object} meta.Response{data=nil} "invalid data types"
+// @Router /api/v1/todo/{uuid}/restore [post]
+func (h *TodoHandler) Restore(ctx *gin.Context) {
+	req, err := meta.ReqRouteParamsToDomain[*dto.DetailUriRequest, domain.Todo](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	versions, err := meta.IfMatchVersions(ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.PreconditionFailed).Err(err).Json()
+		return
+	}
+
+	req.SetExpectedVersions(versions...)
+
+	res, ucErr := h.todoUC.Restore(ctx, req)
+	if ucErr != nil {
+		meta.Resp(ctx, h.l).ServiceErr(ucErr).Json()
+		return
+	}
+
+	meta.SetETag(ctx, res.Version())
+	meta.Resp(ctx, h.l).Data(dto.DetailResp(domain.NewTodoDetailReqQryParam(), res)).Json()
+	return
+}
+
+// History godoc
+// @Summary Get Todo Revision History
+// @Description Every create, update, complete, delete, restore and revert of the item is recorded as an immutable revision
+// @Tags Todo
+// @Accept json
+// @Produce json
+// @Param uuid path string true "Todo UUID" example(f81eee2d-2cca-4169-8062-7404a78d5c3b)
+// @Param page query int false "Page Number"
+// @Param limit query int false "Page Limit"
+// @Param order query string false "`asc` or `desc`(default) by the revision number"
+// @Success 200 {object} meta.Response{data=dto.HistoryResponse, error=nil} "success response"
+// @Failure	400 {object} meta.Response{data=nil} "process failure"
+// @Failure	404 {object} meta.Response{data=nil} "not found"
+// @Failure	422 {object} meta.Response{data=nil} "invalid data types"
+// @Router /api/v1/todo/{uuid}/history [get]
+func (h *TodoHandler) History(ctx *gin.Context) {
+	req, err := meta.ReqRouteParamsToDomain[*dto.DetailUriRequest, domain.Todo](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	qp, err := meta.ReqQryParamToDomain[*dto.HistoryQryRequest, domain.ReqBaseQryParam](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	id := req.UUID()
+	res, ucErr := h.todoUC.History(ctx, &id, qp)
+	if ucErr != nil {
+		meta.Resp(ctx, h.l).ServiceErr(ucErr).Json()
+		return
+	}
+
+	meta.Resp(ctx, h.l).Data(dto.HistoryResp(qp, res)).Json()
+	return
+}
+
+// Revert godoc
+// @Summary Revert Todo to Revision
+// @Description Restores the state of the item after the revision, the revert itself is recorded as a new revision
+// @Tags Todo
+// @Accept json
+// @Produce json
+// @Param uuid path string true "Todo UUID" example(f81eee2d-2cca-4169-8062-7404a78d5c3b)
+// @Param revision path int true "Revision number" example(12)
+// @Param If-Match header string false "ETag of the item, the revert is rejected when the item was modified meanwhile" example("3")
+// @Success 200 {object} meta.Response{data=dto.DetailResponse, error=nil} "success response"
+// @Header 200 {string} ETag "version of the item"
+// @Failure	400 {object} meta.Response{data=nil} "process failure"
+// @Failure	404 {object} meta.Response{data=nil} "not found"
+// @Failure	412 {object} meta.Response{data=nil} "modified by another request"
+// @Failure	422 {object} meta.Response{data=nil} "invalid data types, or the revision of a delete"
+// @Router /api/v1/todo/{uuid}/history/{revision}/revert [post]
+func (h *TodoHandler) Revert(ctx *gin.Context) {
+	rev, err := meta.ReqRouteParamsToDomain[*dto.RevisionUriRequest, domain.TodoRevision](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	versions, err := meta.IfMatchVersions(ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.PreconditionFailed).Err(err).Json()
+		return
+	}
+
+	id := rev.TodoUUID()
+	req := domain.NewTodo()
+	req.SetUUID(&id)
+	req.SetExpectedVersions(versions...)
+
+	res, ucErr := h.todoUC.Revert(ctx, req, rev.ID())
+	if ucErr != nil {
+		meta.Resp(ctx, h.l).ServiceErr(ucErr).Json()
+		return
+	}
+
+	meta.SetETag(ctx, res.Version())
+	meta.Resp(ctx, h.l).Data(dto.DetailResp(domain.NewTodoDetailReqQryParam(), res)).Json()
+	return
+}
+
+// Assign godoc
+// @Summary Assign Todo
+// @Description Replaces the assignees of the item, the empty list unassigns everyone
+// @Tags Todo
+// @Accept json
+// @Produce json
+// @Param uuid path string true "Todo UUID" example(f81eee2d-2cca-4169-8062-7404a78d5c3b)
+// @Param X-User-ID header string false "id of the editor" example(7)
+// @Param Request body dto.AssignRequest true "necessary fields for request"
+// @Success 200 {object} meta.Response{data=dto.DetailResponse, error=nil} "success response"
+// @Failure	400 {object} meta.Response{data=nil} "process failure"
+// @Failure	403 {object} meta.Response{data=nil} "not an editor of the item"
+// @Failure	404 {object} meta.Response{data=nil} "not found"
+// @Failure	422 {object} meta.Response{data=nil} "unprocessable"
+// @Router /api/v1/todo/{uuid}/assignees [put]
+func (h *TodoHandler) Assign(ctx *gin.Context) {
+	item, err := meta.ReqRouteParamsToDomain[*dto.DetailUriRequest, domain.Todo](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	req, err := meta.ReqBodyToDomain[*dto.AssignRequest, domain.Todo](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	id := item.UUID()
+	req.SetUUID(&id)
+
+	res, ucErr := h.todoUC.Assign(ctx, req)
+	if ucErr != nil {
+		meta.Resp(ctx, h.l).ServiceErr(ucErr).Json()
+		return
+	}
+
+	meta.SetETag(ctx, res.Version())
+	meta.Resp(ctx, h.l).Data(dto.DetailResp(domain.NewTodoDetailReqQryParam(), res)).Json()
+	return
+}
+
+// Workload godoc
+// @Summary Get Todo Workload
+// @Description The open and the overdue items per assignee, the items without assignee are counted with the null assignee. Only the items readable by the caller are counted
+// @Tags Todo
+// @Accept json
+// @Produce json
+// @Param X-User-ID header string false "id of the user" example(7)
+// @Param X-User-Groups header string false "comma separated ids of the groups of the user" example(design,backend)
+// @Success 200 {object} meta.Response{data=[]dto.WorkloadResponse, error=nil} "success response"
+// @Failure	400 {object} meta.Response{data=nil} "process failure"
+// @Router /api/v1/todo/workload [get]
+func (h *TodoHandler) Workload(ctx *gin.Context) {
+	res, ucErr := h.todoUC.Workload(ctx)
+	if ucErr != nil {
+		meta.Resp(ctx, h.l).ServiceErr(ucErr).Json()
+		return
+	}
+
+	meta.Resp(ctx, h.l).Data(dto.WorkloadResp(res)).Json()
+	return
+}
+
+// Move godoc
+// @Summary Move Todo on Board
+// @Description Places the item into the column between the neighbours, only the moved item is written. The item moved to the `done` column is completed, the item moved out of it is reopened
+// @Tags Todo
+// @Accept json
+// @Produce json
+// @Param uuid path string true "Todo UUID" example(f81eee2d-2cca-4169-8062-7404a78d5c3b)
+// @Param If-Match header string false "ETag of the item, the move is rejected when the item was modified meanwhile" example("3")
+// @Param Request body dto.MoveRequest true "necessary fields for request"
+// @Success 200 {object} meta.Response{data=dto.DetailResponse, error=nil} "success response"
+// @Header 200 {string} ETag "version of the item"
+// @Failure	400 {object} meta.Response{data=nil} "process failure"
+// @Failure	403 {object} meta.Response{data=nil} "not an editor of the item"
+// @Failure	404 {object} meta.Response{data=nil} "not found"
+// @Failure	409 {object} meta.Response{data=nil} "the neighbours are not in the column or not in order, the board is stale"
+// @Failure	412 {object} meta.Response{data=nil} "modified by another request"
+// @Failure	422 {object} meta.Response{data=nil} "unprocessable"
+// @Router /api/v1/todo/{uuid}/move [post]
+func (h *TodoHandler) Move(ctx *gin.Context) {
+	item, err := meta.ReqRouteParamsToDomain[*dto.DetailUriRequest, domain.Todo](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	req, err := meta.ReqBodyToDomain[*dto.MoveRequest, domain.TodoMove](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	versions, err := meta.IfMatchVersions(ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.PreconditionFailed).Err(err).Json()
+		return
+	}
+
+	id := item.UUID()
+	req.Todo().SetUUID(&id)
+	req.Todo().SetExpectedVersions(versions...)
+
+	res, ucErr := h.todoUC.Move(ctx, req)
+	if ucErr != nil {
+		meta.Resp(ctx, h.l).ServiceErr(ucErr).Json()
+		return
+	}
+
+	meta.SetETag(ctx, res.Version())
+	meta.Resp(ctx, h.l).Data(dto.DetailResp(domain.NewTodoDetailReqQryParam(), res)).Json()
+	return
+}
+
+// Export godoc
+// @Summary Export Todos
+// @Description Streams the items of the list filters in the list order as a file, the pagination is not applied.
+// @Description The `todotxt` format has no notes, the due date and the `in_progress` column are kept in the `due:` and `status:` tags.
+// @Description The `ics` format is an iCalendar(RFC 5545) of VTODO components with the recurrence rules of the due dates.
+// @Tags Todo
+// @Accept json
+// @Produce json,text/csv,application/x-ndjson,plain,text/calendar
+// @Param format query string true "`csv` `json` `ndjson` `todotxt` `ics`"
+// @Param sort query string false "`id` `description` `due_date` `completed_at` `created_at`(default) `updated_at` `status` `board_rank` `rank`(default of search)"
+// @Param order query string false "`asc` or `desc`"
+// @Param search query string false "Full-text search of the Description(web-search syntax: quoted phrase, or, -excluded)"
+// @Param lang query string false "Language of the searched phrase, like `english`, `german`, `simple`"
+// @Param due_after query string false "Due at or after, date-only or RFC3339" example(2025-08-07)
+// @Param due_before query string false "Due before, date-only or RFC3339" example(2025-08-14T00:00:00+02:00)
+// @Param overdue query bool false "Only the items with a passed due date"
+// @Param view query string false "`today` `tomorrow` `this_week` `no_due_date`"
+// @Param tz query string false "IANA time zone of the views and date-only values, default: UTC" example(Europe/Berlin)
+// @Param assignee query string false "Only the items assigned to the user, `me` for the caller(X-User-ID)" example(me)
+// @Param unassigned query bool false "Only the items without assignee"
+// @Param status query string false "Only the items of the board column: `todo` `in_progress` `done`"
+// @Success 200 {array} dto.ExportItem "the file of the format, the items of the json and the ndjson formats"
+// @Header 200 {string} Content-Disposition "attachment with the file name of the format"
+// @Failure	400 {object} meta.Response{data=nil} "process failure"
+// @Failure	401 {object} meta.Response{data=nil} "`assignee=me` without X-User-ID"
+// @Failure	422 {object} meta.Response{data=nil} "invalid format or filters"
+// @Router /api/v1/todo/export [get]
+func (h *TodoHandler) Export(ctx *gin.Context) {
+	qry, err := meta.ReqQryParamToDomain[*dto.TodoListQryRequest, domain.TodoListReqQryParam](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	format, err := meta.ReqQryParamToDomain[*dto.FormatQryRequest, string](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	// NOTE: the headers are sent with the first item, so the failures before it are still reported as JSON
+	meta.Stream(ctx)
+	writer, started := dto.NewExportWriter(*format, ctx.Writer), false
+	start := func() {
+		if !started {
+			started = true
+			contentType, fileName := dto.ExportContentType(*format)
+			ctx.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fileName}))
+			ctx.Header("Content-Type", contentType)
+			ctx.Status(http.StatusOK)
+		}
+	}
+
+	ucErr := h.todoUC.Export(ctx, qry, func(item *domain.Todo) error {
+		start()
+		return writer.Write(item)
+	})
+
+	if ucErr != nil && !started {
+		meta.Resp(ctx, h.l).ServiceErr(ucErr).Json()
+		return
+	}
+
+	if ucErr != nil {
+		// the status is sent already, the truncated file is the only sign of the failure
+		h.lgr.Warn("todo.hdl.export", zap.Error(ucErr), logger.Context(ctx))
+		return
+	}
+
+	start()
+	if closeErr := writer.Close(); closeErr != nil {
+		h.lgr.Warn("todo.hdl.export.close", zap.Error(closeErr), logger.Context(ctx))
+	}
+
+	return
+}
+
+// Import godoc
+// @Summary Import Todos
+// @Description Creates the items of the file all-or-nothing, the body is the file in the format of the export.
+// @Description Every row is validated like the create request, the invalid rows are reported by their line and nothing is imported.
+// @Description The CSV file requires a header row with a `description` column, the JSON file is an array, the other columns and keys of the export are ignored.
+// @Description The `ics` file imports its VTODO and VEVENT components with the DUE or the DTSTART as the due date, the cancelled ones are skipped.
+// @Tags Todo
+// @Accept json,text/csv,application/x-ndjson,plain,text/calendar
+// @Produce json
+// @Param format query string true "`csv` `json` `ndjson` `todotxt` `ics`"
+// @Param dry_run query bool false "validates the file without importing it"
+// @Param Request body []dto.ImportRow true "the file"
+// @Param Idempotency-Key header string false "unique key of the request, the retries with the same key replay the first response" example(7c9e6679-7425-40de-944b-e07fc1f90ae7)
+// @Success 200 {object} meta.Response{data=dto.ImportResponse, error=nil} "the file of the dry run is valid"
+// @Success 201 {object} meta.Response{data=dto.ImportResponse, error=nil} "the rows are imported"
+// @Failure	400 {object} meta.Response{data=nil} "process failure"
+// @Failure	413 {object} meta.Response{data=nil} "the file exceeds the size or the row limit"
+// @Failure	422 {object} meta.Response{data=dto.ImportResponse} "invalid rows, or the file has no rows"
+// @Router /api/v1/todo/import [post]
+func (h *TodoHandler) Import(ctx *gin.Context) {
+	format, err := meta.ReqQryParamToDomain[*dto.FormatQryRequest, string](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	dryRun, err := meta.ReqQryParamToDomain[*dto.DryRunQryRequest, bool](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	body := http.MaxBytesReader(ctx.Writer, ctx.Request.Body, h.importMaxSize())
+	ents, resp, err := dto.DecodeImport(ctx, *format, body, h.importMaxRows())
+
+	var maxBytesErr *http.MaxBytesError
+	switch {
+	case errors.As(err, &maxBytesErr):
+		meta.Resp(ctx, h.l).Status(status.ImportTooLarge).Err(fmt.Errorf("at most %d bytes are allowed", maxBytesErr.Limit)).Json()
+		return
+	case errors.Is(err, dto.ErrImportTooLarge):
+		meta.Resp(ctx, h.l).Status(status.ImportTooLarge).Err(fmt.Errorf("at most %d rows are allowed", h.importMaxRows())).Json()
+		return
+	case err != nil:
+		h.lgr.Debug("todo.hdl.import", zap.Error(err), logger.Context(ctx))
+		meta.Resp(ctx, h.l).Status(status.Failed).Err(err).Json()
+		return
+	}
+
+	resp.DryRun = *dryRun
+
+	if resp.Invalid > 0 {
+		meta.Resp(ctx, h.l).Status(status.Validate).Msg(meta.Locale(ctx, h.l).Get("import_invalid")).Data(resp).Json()
+		return
+	}
+
+	if len(ents) == 0 {
+		meta.Resp(ctx, h.l).Status(status.Validate).Msg(meta.Locale(ctx, h.l).Get("import_empty")).Data(resp).Json()
+		return
+	}
+
+	if resp.DryRun {
+		meta.Resp(ctx, h.l).Data(resp).Json()
+		return
+	}
+
+	res, ucErr := h.todoUC.Import(ctx, ents)
+	if ucErr != nil {
+		meta.Resp(ctx, h.l).ServiceErr(ucErr).Json()
+		return
+	}
+
+	resp.Imported = len(res)
+	meta.Resp(ctx, h.l).Data(resp).Status(status.Created).Json()
+	return
+}
+
+// HELPERS
+
+func (h *TodoHandler) importMaxRows() int {
+	if h.config.ImportMaxRows > 0 {
+		return h.config.ImportMaxRows
+	}
+
+	return defaultImportMaxRows
+}
+
+func (h *TodoHandler) importMaxSize() int64 {
+	if h.config.ImportMaxSize > 0 {
+		return h.config.ImportMaxSize
+	}
+
+	return defaultImportMaxSize
+}
+
+func (h *TodoHandler) bulkMaxSize() int {
+	if h.config.BulkMaxSize > 0 {
+		return h.config.BulkMaxSize
+	}
+
+	return defaultBulkMaxSize
+}
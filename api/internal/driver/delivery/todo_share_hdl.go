@@ -0,0 +1,318 @@
+package delivery
+
+import (
+	"microservice/internal/adapter/locale"
+	"microservice/internal/adapter/logger"
+	"microservice/internal/core/domain"
+	"microservice/internal/core/port"
+	"microservice/internal/driver/dto"
+	"microservice/internal/server/http/status"
+	"microservice/pkg/meta"
+
+	"github.com/gin-gonic/gin"
+)
+
+type (
+	ITodoShareHandler interface {
+		Grant(ctx *gin.Context)
+		GetList(ctx *gin.Context)
+		Revoke(ctx *gin.Context)
+		CreateLink(ctx *gin.Context)
+		GetLinks(ctx *gin.Context)
+		RevokeLink(ctx *gin.Context)
+		ByLink(ctx *gin.Context)
+		SharedWithMe(ctx *gin.Context)
+	}
+
+	TodoShareHandler struct {
+		lgr     logger.ILogger
+		l       locale.ILocale
+		shareUC port.ITodoShareUsecase
+	}
+)
+
+func NewTodoShare(lgr logger.ILogger, l locale.ILocale, shareUC port.ITodoShareUsecase) ITodoShareHandler {
+	return &TodoShareHandler{lgr: lgr, l: l, shareUC: shareUC}
+}
+
+// Grant godoc
+// @Summary Share Todo
+// @Description Grants the permission to a user or a group, the existing grant of the grantee is replaced. Only the owner of the item manages its shares
+// @Tags Share
+// @Accept json
+// @Produce json
+// @Param uuid path string true "Todo UUID" example(f81eee2d-2cca-4169-8062-7404a78d5c3b)
+// @Param X-User-ID header string false "id of the owner" example(7)
+// @Param Request body dto.ShareGrantRequest true "necessary fields for request"
+// @Success 200 {object} meta.Response{data=dto.ShareResponse, error=nil} "success response"
+// @Failure	400 {object} meta.Response{data=nil} "process failure"
+// @Failure	403 {object} meta.Response{data=nil} "not the owner of the item"
+// @Failure	404 {object} meta.Response{data=nil} "todo not found"
+// @Failure	422 {object} meta.Response{data=nil} "unprocessable, or the grantee is the owner"
+// @Router /api/v1/todo/{uuid}/shares [put]
+func (h *TodoShareHandler) Grant(ctx *gin.Context) {
+	item, err := meta.ReqRouteParamsToDomain[*dto.DetailUriRequest, domain.Todo](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	req, err := meta.ReqBodyToDomain[*dto.ShareGrantRequest, domain.TodoShare](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	req.SetTodoUUID(item.UUID())
+
+	res, ucErr := h.shareUC.Grant(ctx, req)
+	if ucErr != nil {
+		meta.Resp(ctx, h.l).ServiceErr(ucErr).Json()
+		return
+	}
+
+	meta.Resp(ctx, h.l).Data(dto.ShareResp(res)).Json()
+	return
+}
+
+// GetList godoc
+// @Summary Get Todo Shares
+// @Description Lists the grants of the item, only for its owner
+// @Tags Share
+// @Accept json
+// @Produce json
+// @Param uuid path string true "Todo UUID" example(f81eee2d-2cca-4169-8062-7404a78d5c3b)
+// @Param X-User-ID header string false "id of the owner" example(7)
+// @Success 200 {object} meta.Response{data=[]dto.ShareResponse, error=nil} "success response"
+// @Failure	400 {object} meta.Response{data=nil} "process failure"
+// @Failure	403 {object} meta.Response{data=nil} "not the owner of the item"
+// @Failure	404 {object} meta.Response{data=nil} "todo not found"
+// @Failure	422 {object} meta.Response{data=nil} "invalid data types"
+// @Router /api/v1/todo/{uuid}/shares [get]
+func (h *TodoShareHandler) GetList(ctx *gin.Context) {
+	item, err := meta.ReqRouteParamsToDomain[*dto.DetailUriRequest, domain.Todo](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	id := item.UUID()
+	res, ucErr := h.shareUC.GetList(ctx, &id)
+	if ucErr != nil {
+		meta.Resp(ctx, h.l).ServiceErr(ucErr).Json()
+		return
+	}
+
+	meta.Resp(ctx, h.l).Data(dto.ShareListResp(res)).Json()
+	return
+}
+
+// Revoke godoc
+// @Summary Revoke Todo Share
+// @Tags Share
+// @Accept json
+// @Produce json
+// @Param uuid path string true "Todo UUID" example(f81eee2d-2cca-4169-8062-7404a78d5c3b)
+// @Param share path string true "Share UUID" example(7a1d3c5e-9b2f-4e6a-8c0d-1f2e3d4c5b6a)
+// @Param X-User-ID header string false "id of the owner" example(7)
+// @Success 200 {object} meta.Response{data=nil, error=nil} "success response"
+// @Failure	400 {object} meta.Response{data=nil} "process failure"
+// @Failure	403 {object} meta.Response{data=nil} "not the owner of the item"
+// @Failure	404 {object} meta.Response{data=nil} "not found"
+// @Failure	422 {object} meta.Response{data=nil} "invalid data types"
+// @Router /api/v1/todo/{uuid}/shares/{share} [delete]
+func (h *TodoShareHandler) Revoke(ctx *gin.Context) {
+	req, err := meta.ReqRouteParamsToDomain[*dto.ShareUriRequest, domain.TodoShare](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	if ucErr := h.shareUC.Revoke(ctx, req); ucErr != nil {
+		meta.Resp(ctx, h.l).ServiceErr(ucErr).Json()
+		return
+	}
+
+	meta.Resp(ctx, h.l).Status(status.Deleted).Json()
+	return
+}
+
+// CreateLink godoc
+// @Summary Create Todo Share Link
+// @Description Creates a read-only link of the item, its token is returned only once
+// @Tags Share
+// @Accept json
+// @Produce json
+// @Param uuid path string true "Todo UUID" example(f81eee2d-2cca-4169-8062-7404a78d5c3b)
+// @Param X-User-ID header string false "id of the owner" example(7)
+// @Param Request body dto.ShareLinkCreateRequest true "necessary fields for request"
+// @Success 201 {object} meta.Response{data=dto.ShareLinkResponse, error=nil} "success response"
+// @Failure	400 {object} meta.Response{data=nil} "process failure"
+// @Failure	403 {object} meta.Response{data=nil} "not the owner of the item"
+// @Failure	404 {object} meta.Response{data=nil} "todo not found"
+// @Failure	422 {object} meta.Response{data=nil} "unprocessable"
+// @Router /api/v1/todo/{uuid}/share-links [post]
+func (h *TodoShareHandler) CreateLink(ctx *gin.Context) {
+	item, err := meta.ReqRouteParamsToDomain[*dto.DetailUriRequest, domain.Todo](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	req, err := meta.ReqBodyToDomain[*dto.ShareLinkCreateRequest, domain.TodoShareLink](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	req.SetTodoUUID(item.UUID())
+
+	res, ucErr := h.shareUC.CreateLink(ctx, req)
+	if ucErr != nil {
+		meta.Resp(ctx, h.l).ServiceErr(ucErr).Json()
+		return
+	}
+
+	meta.Resp(ctx, h.l).Data(dto.ShareLinkResp(res)).Status(status.Created).Json()
+	return
+}
+
+// GetLinks godoc
+// @Summary Get Todo Share Links
+// @Description Lists the links of the item without their tokens, only for its owner
+// @Tags Share
+// @Accept json
+// @Produce json
+// @Param uuid path string true "Todo UUID" example(f81eee2d-2cca-4169-8062-7404a78d5c3b)
+// @Param X-User-ID header string false "id of the owner" example(7)
+// @Success 200 {object} meta.Response{data=[]dto.ShareLinkResponse, error=nil} "success response"
+// @Failure	400 {object} meta.Response{data=nil} "process failure"
+// @Failure	403 {object} meta.Response{data=nil} "not the owner of the item"
+// @Failure	404 {object} meta.Response{data=nil} "todo not found"
+// @Failure	422 {object} meta.Response{data=nil} "invalid data types"
+// @Router /api/v1/todo/{uuid}/share-links [get]
+func (h *TodoShareHandler) GetLinks(ctx *gin.Context) {
+	item, err := meta.ReqRouteParamsToDomain[*dto.DetailUriRequest, domain.Todo](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	id := item.UUID()
+	res, ucErr := h.shareUC.GetLinks(ctx, &id)
+	if ucErr != nil {
+		meta.Resp(ctx, h.l).ServiceErr(ucErr).Json()
+		return
+	}
+
+	meta.Resp(ctx, h.l).Data(dto.ShareLinkListResp(res)).Json()
+	return
+}
+
+// RevokeLink godoc
+// @Summary Revoke Todo Share Link
+// @Tags Share
+// @Accept json
+// @Produce json
+// @Param uuid path string true "Todo UUID" example(f81eee2d-2cca-4169-8062-7404a78d5c3b)
+// @Param link path string true "Share Link UUID" example(3e5f7a9b-1c2d-4e6f-8a0b-2c4d6e8f0a1b)
+// @Param X-User-ID header string false "id of the owner" example(7)
+// @Success 200 {object} meta.Response{data=nil, error=nil} "success response"
+// @Failure	400 {object} meta.Response{data=nil} "process failure"
+// @Failure	403 {object} meta.Response{data=nil} "not the owner of the item"
+// @Failure	404 {object} meta.Response{data=nil} "not found"
+// @Failure	422 {object} meta.Response{data=nil} "invalid data types"
+// @Router /api/v1/todo/{uuid}/share-links/{link} [delete]
+func (h *TodoShareHandler) RevokeLink(ctx *gin.Context) {
+	req, err := meta.ReqRouteParamsToDomain[*dto.ShareLinkUriRequest, domain.TodoShareLink](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	if ucErr := h.shareUC.RevokeLink(ctx, req); ucErr != nil {
+		meta.Resp(ctx, h.l).ServiceErr(ucErr).Json()
+		return
+	}
+
+	meta.Resp(ctx, h.l).Status(status.Deleted).Json()
+	return
+}
+
+// ByLink godoc
+// @Summary Get Shared Todo
+// @Description The read-only access to the item by the token of its share link, the identity is not required
+// @Tags Share
+// @Accept json
+// @Produce json
+// @Param token path string true "Share link token" example(q0Zx3Jm2b6LhT9r1Vw8yNc4uEa7sKd5fGp0oRi2jHl)
+// @Param render query string false "`html` renders the Markdown notes to the sanitised HTML"
+// @Success 200 {object} meta.Response{data=dto.DetailResponse, error=nil} "success response"
+// @Failure	400 {object} meta.Response{data=nil} "process failure"
+// @Failure	404 {object} meta.Response{data=nil} "unknown token, or the item is deleted"
+// @Failure	410 {object} meta.Response{data=nil} "the link is expired"
+// @Failure	422 {object} meta.Response{data=nil} "invalid data types"
+// @Router /api/v1/shared/{token} [get]
+func (h *TodoShareHandler) ByLink(ctx *gin.Context) {
+	req, err := meta.ReqRouteParamsToDomain[*dto.ShareTokenUriRequest, domain.TodoShareLink](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	qp, err := meta.ReqQryParamToDomain[*dto.DetailQryRequest, domain.TodoDetailReqQryParam](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	res, ucErr := h.shareUC.ByLink(ctx, req.Token())
+	if ucErr != nil {
+		meta.Resp(ctx, h.l).ServiceErr(ucErr).Json()
+		return
+	}
+
+	meta.Resp(ctx, h.l).Data(dto.DetailResp(qp, res)).Json()
+	return
+}
+
+// SharedWithMe godoc
+// @Summary Get Todos Shared With Me
+// @Description Lists the items shared with the user or with the groups of the user by the other users, it supports the filters of the list
+// @Tags Share
+// @Accept json
+// @Produce json
+// @Param X-User-ID header string true "id of the user" example(42)
+// @Param X-User-Groups header string false "comma separated ids of the groups of the user" example(design,backend)
+// @Param page query int false "Page Number"
+// @Param limit query int false "Page Limit"
+// @Param sort query string false "`id` `description` `due_date` `completed_at` `created_at`(default) `updated_at` `status` `board_rank` `rank`(default of search)"
+// @Param order query string false "`asc` or `desc`"
+// @Param search query string false "Full-text search of the Description(web-search syntax: quoted phrase, or, -excluded)"
+// @Param lang query string false "Language of the searched phrase, like `english`, `german`, `simple`"
+// @Param due_after query string false "Due at or after, date-only or RFC3339" example(2025-08-07)
+// @Param due_before query string false "Due before, date-only or RFC3339" example(2025-08-14T00:00:00+02:00)
+// @Param overdue query bool false "Only the items with a passed due date"
+// @Param view query string false "`today` `tomorrow` `this_week` `no_due_date`"
+// @Param tz query string false "IANA time zone of the views and date-only values, default: UTC" example(Europe/Berlin)
+// @Success 200 {object} meta.Response{data=dto.TodoListResponse, error=nil} "success response"
+// @Failure	400 {object} meta.Response{data=nil} "process failure"
+// @Failure	401 {object} meta.Response{data=nil} "the user is unknown"
+// @Failure	422 {object} meta.Response{data=nil} "invalid data types"
+// @Router /api/v1/todo/shared [get]
+func (h *TodoShareHandler) SharedWithMe(ctx *gin.Context) {
+	qp, err := meta.ReqQryParamToDomain[*dto.TodoListQryRequest, domain.TodoListReqQryParam](ctx)
+	if err != nil {
+		meta.Resp(ctx, h.l).Status(status.Validate).Err(err).Json()
+		return
+	}
+
+	res, ucErr := h.shareUC.SharedWithMe(ctx, qp)
+	if ucErr != nil {
+		meta.Resp(ctx, h.l).ServiceErr(ucErr).Json()
+		return
+	}
+
+	meta.Resp(ctx, h.l).Data(dto.TodoListResp(qp, res)).Json()
+	return
+}
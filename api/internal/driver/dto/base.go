@@ -2,12 +2,14 @@ package dto
 
 import "microservice/internal/core/domain"
 
+// NOTE: the sort and the order are a part of the ORDER BY clause, so they are limited to the known values
+
 type ListQryRequest struct {
 	Page   int    `form:"page" binding:"omitempty,numeric" json:"page"`   // integer value
 	Limit  int    `form:"limit" binding:"omitempty,numeric" json:"limit"` // integer value
-	Sort   string `form:"sort" binding:"omitempty,ascii" json:"sort"`
-	Order  string `form:"order" binding:"omitempty,ascii" json:"order"` // "asc" or "desc"
-	Search string `form:"search" binding:"omitempty,alphanum" json:"search"`
+	Sort   string `form:"sort" binding:"omitempty,oneof=id description due_date completed_at created_at updated_at status board_rank rank" json:"sort"`
+	Order  string `form:"order" binding:"omitempty,oneof=asc desc" json:"order"`
+	Search string `form:"search" binding:"omitempty,max=256" json:"search"` // web-search syntax: "quoted phrase", or, -excluded
 }
 
 func (r *ListQryRequest) EvalBaseQry() domain.ReqBaseQryParam {
@@ -1,32 +1,75 @@
 package dto
 
 import (
-	"fmt"
 	"github.com/google/uuid"
 	"math"
 	"microservice/internal/core/domain"
+	"microservice/pkg/datetime"
+	"microservice/pkg/markdown"
+	"microservice/pkg/utils"
+	"microservice/pkg/validator"
 	"time"
 )
 
+// listDescriptionLimit the count of characters(runes) of the description in the list items
+const listDescriptionLimit = 20
+
+// NOTE: the `max` rule counts the characters(runes), the description is consistent with the VARCHAR(255) column
+
 type CreateRequest struct {
-	Description string `json:"description" validate:"required,ascii" example:"Create new todo item"`
-	DueDate     string `json:"dueDate" validate:"required" example:"2025-08-07 10:11:12"`
+	Description string `json:"description" binding:"required,max=255" example:"Create new todo item"`
+	DueDate     string `json:"dueDate" binding:"omitempty,dueDate" example:"tomorrow 9am"`    // RFC3339, date-only(all-day), "2025-08-07 10:11 Europe/Berlin" or relative like "next friday"
+	TimeZone    string `json:"timeZone" binding:"omitempty,timezone" example:"Europe/Berlin"` // IANA time zone of the zone-less due dates, default: UTC
+	Language    string `json:"language" binding:"omitempty,searchLanguage" example:"english"`
+	Notes       string `json:"notes" binding:"omitempty,max=20000" example:"## Steps\n- [ ] draft the **outline**"`                  // Markdown
+	Recurrence  string `json:"recurrence" binding:"omitempty,excluded_without=DueDate,max=255,rrule" example:"FREQ=WEEKLY;BYDAY=MO"` // RFC 5545 RRULE of the due date
 }
 
 func (dto *CreateRequest) ToDomain() *domain.Todo {
 	d := domain.NewTodo()
 	d.SetDescription(&dto.Description)
 
-	dateTime, _ := time.Parse(time.DateTime, dto.DueDate)
-	d.SetDueDate(&dateTime)
+	if notes := markdown.Sanitize(dto.Notes); len(notes) > 0 {
+		d.SetNotes(&notes)
+	}
+
+	if len(dto.Language) > 0 {
+		d.SetLanguage(&dto.Language)
+	}
+
+	if len(dto.Recurrence) > 0 {
+		d.SetRecurrence(&dto.Recurrence)
+	}
+
+	if len(dto.DueDate) > 0 {
+		due, _ := datetime.Parse(dto.DueDate, validator.DueLocation(dto.TimeZone), time.Now()) // validated by the `dueDate` rule
+		zone := due.Time.Location().String()
+
+		d.SetDueDate(&due.Time)
+		d.SetAllDay(due.AllDay)
+
+		// NOTE: the resolved zone is stored, so the all-day items are rendered on their date. the fixed offsets of RFC3339 have no zone name
+		if len(zone) > 0 {
+			d.SetDueTimeZone(&zone)
+		}
+	}
 
 	return d
 }
 
+// UpdateRequest replaces all fields of the item, the omitted optional fields are cleared(the language is kept)
+type UpdateRequest struct {
+	CreateRequest
+}
+
 type CreateResponse struct {
-	Uuid        string `json:"uuid" example:"e48c48a3-cb72-4d64-b035-5c30fc900ef6"`
-	Description string `json:"description" example:"Create new todo item"`
-	DueDate     string `json:"dueDate" example:"2025-08-07 10:11:12"`
+	Uuid        string  `json:"uuid" example:"e48c48a3-cb72-4d64-b035-5c30fc900ef6"`
+	Description string  `json:"description" example:"Create new todo item"`
+	DueDate     *string `json:"dueDate" example:"2025-08-07T09:00:00+02:00"` // RFC3339, date-only for the all-day items
+	AllDay      bool    `json:"allDay" example:"false"`
+	TimeZone    *string `json:"timeZone" example:"Europe/Berlin"`
+	Notes       *string `json:"notes" example:"## Steps\n- [ ] draft the **outline**"`
+	Recurrence  *string `json:"recurrence" example:"FREQ=WEEKLY;BYDAY=MO"`
 }
 
 func CreateResp(src *domain.Todo) *CreateResponse {
@@ -39,14 +82,18 @@ func CreateResp(src *domain.Todo) *CreateResponse {
 			return src.UUID().String()
 		}(),
 		Description: *src.Description(),
-		DueDate:     src.DueDate().Format(time.RFC3339),
+		DueDate:     formatDueDate(src),
+		AllDay:      src.AllDay(),
+		TimeZone:    src.DueTimeZone(),
+		Notes:       src.Notes(),
+		Recurrence:  src.Recurrence(),
 	}
 }
 
 //
 
 type DetailUriRequest struct {
-	Uuid string `param:"uuid" validate:"required,uuid" example:"bf56c6b6-dd02-47ba-8dc4-bd7d2843a77a"`
+	Uuid string `param:"uuid" binding:"required,uuid" example:"bf56c6b6-dd02-47ba-8dc4-bd7d2843a77a"`
 }
 
 func (dto *DetailUriRequest) ToDomain() *domain.Todo {
@@ -57,13 +104,37 @@ func (dto *DetailUriRequest) ToDomain() *domain.Todo {
 	return d
 }
 
+// RenderHtml the notes are rendered to the sanitized HTML
+const RenderHtml = "html"
+
+type DetailQryRequest struct {
+	Render string `form:"render" binding:"omitempty,oneof=html" json:"render"`
+}
+
+func (r *DetailQryRequest) ToDomain() *domain.TodoDetailReqQryParam {
+	qry := domain.NewTodoDetailReqQryParam()
+	qry.SetRenderNotes(r.Render == RenderHtml)
+
+	return qry
+}
+
 type DetailResponse struct {
-	Uuid        string `json:"uuid" example:"e48c48a3-cb72-4d64-b035-5c30fc900ef6"`
-	Description string `json:"description" example:"Create new todo item"`
-	DueDate     string `json:"dueDate" example:"2025-08-07 10:11:12"`
+	Uuid        string   `json:"uuid" example:"e48c48a3-cb72-4d64-b035-5c30fc900ef6"`
+	Description string   `json:"description" example:"Create new todo item"`
+	DueDate     *string  `json:"dueDate" example:"2025-08-07T09:00:00+02:00"` // RFC3339, date-only for the all-day items
+	AllDay      bool     `json:"allDay" example:"false"`
+	TimeZone    *string  `json:"timeZone" example:"Europe/Berlin"`
+	Notes       *string  `json:"notes" example:"## Steps\n- [ ] draft the **outline**"`
+	Recurrence  *string  `json:"recurrence" example:"FREQ=WEEKLY;BYDAY=MO"`  // RFC 5545 RRULE of the due date
+	CompletedAt *string  `json:"completedAt" example:"2025-08-07T08:15:00Z"` // RFC3339, null for the open items
+	Owner       *string  `json:"owner" example:"42"`                         // X-User-ID of the creator, null for the items accessible by everyone
+	Assignees   []string `json:"assignees" example:"42,7"`
+	Status      string   `json:"status" example:"in_progress"` // column of the board: todo, in_progress or done
+	BoardRank   string   `json:"boardRank" example:"a0V"`      // position in the column, the items are ordered by its byte order
+	NotesHtml   *string  `json:"notesHtml,omitempty" example:"<h2>Steps</h2>\n<ul>\n<li><input disabled=\"\" type=\"checkbox\"> draft the <strong>outline</strong></li>\n</ul>\n"`
 }
 
-func DetailResp(src *domain.Todo) *DetailResponse {
+func DetailResp(qry *domain.TodoDetailReqQryParam, src *domain.Todo) *DetailResponse {
 	return &DetailResponse{
 		Uuid: func() string {
 			if src.UUID() == uuid.Nil {
@@ -73,7 +144,28 @@ func DetailResp(src *domain.Todo) *DetailResponse {
 			return src.UUID().String()
 		}(),
 		Description: *src.Description(),
-		DueDate:     src.DueDate().Format(time.RFC3339),
+		DueDate:     formatDueDate(src),
+		AllDay:      src.AllDay(),
+		TimeZone:    src.DueTimeZone(),
+		Notes:       src.Notes(),
+		Recurrence:  src.Recurrence(),
+		CompletedAt: formatCompletedAt(src),
+		Owner:       src.Owner(),
+		Assignees:   assignees(src),
+		Status:      src.Status(),
+		BoardRank:   src.BoardRank(),
+		NotesHtml: func() *string {
+			if !qry.RenderNotes() || src.Notes() == nil {
+				return nil
+			}
+
+			html, err := markdown.ToSafeHTML(*src.Notes())
+			if err != nil {
+				return nil
+			}
+
+			return &html
+		}(),
 	}
 }
 
@@ -81,20 +173,71 @@ func DetailResp(src *domain.Todo) *DetailResponse {
 
 type TodoListQryRequest struct {
 	ListQryRequest
+	Lang       string `form:"lang" binding:"omitempty,searchLanguage" json:"lang"`
+	DueBefore  string `form:"due_before" binding:"omitempty,dateOrDateTime" json:"due_before"` // date-only or RFC3339, exclusive
+	DueAfter   string `form:"due_after" binding:"omitempty,dateOrDateTime" json:"due_after"`   // date-only or RFC3339, inclusive
+	Overdue    bool   `form:"overdue" binding:"omitempty" json:"overdue"`
+	View       string `form:"view" binding:"omitempty,oneof=today tomorrow this_week no_due_date" json:"view"`
+	Tz         string `form:"tz" binding:"omitempty,timezone" json:"tz"`            // IANA time zone, like "Europe/Berlin"
+	Assignee   string `form:"assignee" binding:"omitempty,max=255" json:"assignee"` // id of the user, or `me`
+	Unassigned bool   `form:"unassigned" binding:"omitempty,excluded_with=Assignee" json:"unassigned"`
+	Status     string `form:"status" binding:"omitempty,oneof=todo in_progress done" json:"status"` // column of the board
 }
 
 func (r *TodoListQryRequest) ToDomain() *domain.TodoListReqQryParam {
 	qry := domain.NewTodoListReqQryParam()
 	qry.ReqBaseQryParam = r.EvalBaseQry()
 
+	if len(r.Lang) > 0 {
+		qry.SetLanguage(&r.Lang)
+	}
+
+	if len(r.Tz) > 0 {
+		location, _ := time.LoadLocation(r.Tz) // validated by the `timezone` rule
+		qry.SetLocation(location)
+	}
+
+	if len(r.DueBefore) > 0 {
+		dueBefore := parseDateOrDateTime(r.DueBefore, qry.Location())
+		qry.SetDueBefore(&dueBefore)
+	}
+
+	if len(r.DueAfter) > 0 {
+		dueAfter := parseDateOrDateTime(r.DueAfter, qry.Location())
+		qry.SetDueAfter(&dueAfter)
+	}
+
+	if len(r.View) > 0 {
+		qry.SetView(&r.View)
+	}
+
+	if len(r.Assignee) > 0 {
+		qry.SetAssignee(&r.Assignee)
+	}
+
+	if len(r.Status) > 0 {
+		qry.SetStatus(&r.Status)
+	}
+
+	qry.SetOverdue(r.Overdue)
+	qry.SetUnassigned(r.Unassigned)
 	return qry
 }
 
 type (
 	TodoListItemDetail struct {
-		Uuid        string `json:"id" example:"02bda2f0-61e5-483c-a2d8-15eafb00b945"`
-		Description string `json:"name" example:"Create new todo..."`
-		DueDate     string `json:"dueDate" validate:"required,ascii" example:"2025-08-07 10:11:12"`
+		Uuid        string   `json:"id" example:"02bda2f0-61e5-483c-a2d8-15eafb00b945"`
+		Description string   `json:"name" example:"Create new todo..."`
+		DueDate     *string  `json:"dueDate" example:"2025-08-07T09:00:00+02:00"` // RFC3339, date-only for the all-day items
+		AllDay      bool     `json:"allDay" example:"false"`
+		Completed   bool     `json:"completed" example:"false"`
+		Owner       *string  `json:"owner" example:"42"`
+		Assignees   []string `json:"assignees" example:"42,7"`
+		Status      string   `json:"status" example:"in_progress"`
+		BoardRank   string   `json:"boardRank" example:"a0V"`
+		// search results
+		Rank      float64 `json:"rank,omitempty" example:"0.0607927"`
+		Highlight *string `json:"highlight,omitempty" example:"Create new <mark>todo</mark> item"`
 	}
 
 	TodoListResponse struct {
@@ -102,6 +245,7 @@ type (
 		Limit int                   `json:"limit" example:"10"`
 		Pages int                   `json:"pages" example:"3"`
 		Total int64                 `json:"total" example:"27"`
+		Fuzzy bool                  `json:"fuzzy,omitempty" example:"false"` // the items are similar to the searched phrase, not exact matches
 		Todos []*TodoListItemDetail `json:"todos"`
 	}
 )
@@ -112,23 +256,74 @@ func TodoListResp(qry *domain.TodoListReqQryParam, src *domain.TodoList) *TodoLi
 	list.Limit = qry.Limit()
 	list.Pages = int(math.Ceil(float64(src.Total()) / float64(qry.Limit())))
 	list.Total = src.Total()
+	list.Fuzzy = src.Fuzzy()
 	list.Todos = make([]*TodoListItemDetail, 0)
 
 	if len(src.List()) > 0 {
 		for _, todo := range src.List() {
-			desc := *todo.Description()
-
-			if len(desc) > 20 {
-				desc = fmt.Sprintf("%s...", desc[:20])
-			}
+			desc := utils.Truncate(*todo.Description(), listDescriptionLimit)
 
 			list.Todos = append(list.Todos, &TodoListItemDetail{
 				Uuid:        todo.UUID().String(),
 				Description: desc,
-				DueDate:     todo.DueDate().Format(time.RFC3339),
+				DueDate:     formatDueDate(todo),
+				AllDay:      todo.AllDay(),
+				Completed:   todo.Completed(),
+				Owner:       todo.Owner(),
+				Assignees:   assignees(todo),
+				Status:      todo.Status(),
+				BoardRank:   todo.BoardRank(),
+				Rank:        todo.Rank(),
+				Highlight:   todo.Highlight(),
 			})
 		}
 	}
 
 	return list
 }
+
+// HELPERS
+
+// formatDueDate formats the due date in its time zone, RFC3339 or date-only for the all-day items
+func formatDueDate(src *domain.Todo) *string {
+	if src.DueDate() == nil {
+		return nil
+	}
+
+	due := src.DueDate().In(src.DueLocation())
+	layout := time.RFC3339
+	if src.AllDay() {
+		layout = time.DateOnly
+	}
+
+	formatted := due.Format(layout)
+	return &formatted
+}
+
+// assignees the empty list for the items without assignee
+func assignees(src *domain.Todo) []string {
+	if src.Assignees() == nil {
+		return make([]string, 0)
+	}
+
+	return src.Assignees()
+}
+
+func formatCompletedAt(src *domain.Todo) *string {
+	if src.CompletedAt() == nil {
+		return nil
+	}
+
+	formatted := src.CompletedAt().UTC().Format(time.RFC3339)
+	return &formatted
+}
+
+// parseDateOrDateTime parses the validated RFC3339 time, or the start of the date-only value in the location
+func parseDateOrDateTime(value string, location *time.Location) time.Time {
+	if t, err := time.ParseInLocation(validator.DateOnly, value, location); err == nil {
+		return t
+	}
+
+	t, _ := time.Parse(time.RFC3339, value)
+	return t
+}
@@ -0,0 +1,36 @@
+package dto
+
+import (
+	"microservice/internal/core/domain"
+)
+
+// AssignRequest replaces the assignees of the item, the empty list unassigns everyone
+type AssignRequest struct {
+	Assignees []string `json:"assignees" binding:"max=20,unique,dive,required,max=255" example:"42,7"` // X-User-ID of the users
+}
+
+func (dto *AssignRequest) ToDomain() *domain.Todo {
+	d := domain.NewTodo()
+	d.SetAssignees(dto.Assignees)
+	return d
+}
+
+type WorkloadResponse struct {
+	Assignee *string `json:"assignee" example:"42"` // null for the unassigned items
+	Open     int64   `json:"open" example:"12"`
+	Overdue  int64   `json:"overdue" example:"3"`
+}
+
+func WorkloadResp(src []*domain.TodoWorkload) []*WorkloadResponse {
+	list := make([]*WorkloadResponse, 0, len(src))
+
+	for _, workload := range src {
+		list = append(list, &WorkloadResponse{
+			Assignee: workload.Assignee(),
+			Open:     workload.Open(),
+			Overdue:  workload.Overdue(),
+		})
+	}
+
+	return list
+}
@@ -0,0 +1,53 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"microservice/internal/core/domain"
+	"time"
+)
+
+type AttachmentUriRequest struct {
+	Uuid           string `param:"uuid" binding:"required,uuid" example:"bf56c6b6-dd02-47ba-8dc4-bd7d2843a77a"`
+	AttachmentUuid string `param:"attachment" binding:"required,uuid" example:"0d6c2b1e-4f3a-4b8e-9c7d-1a2b3c4d5e6f"`
+}
+
+func (dto *AttachmentUriRequest) ToDomain() *domain.TodoAttachment {
+	id := uuid.MustParse(dto.AttachmentUuid)
+
+	d := domain.NewTodoAttachment()
+	d.SetUUID(&id)
+	d.SetTodoUUID(uuid.MustParse(dto.Uuid))
+	return d
+}
+
+type AttachmentResponse struct {
+	Uuid        string  `json:"uuid" example:"0d6c2b1e-4f3a-4b8e-9c7d-1a2b3c4d5e6f"`
+	FileName    string  `json:"fileName" example:"outline.pdf"`
+	ContentType string  `json:"contentType" example:"application/pdf"`                                               // detected from the content
+	Size        int64   `json:"size" example:"52814"`                                                                // bytes
+	Checksum    string  `json:"checksum" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"` // SHA-256, hex
+	Uploader    *string `json:"uploader" example:"42"`                                                               // X-User-ID of the upload, null for the anonymous uploads
+	CreatedAt   string  `json:"createdAt" example:"2025-08-07T08:15:00Z"`
+}
+
+func AttachmentResp(src *domain.TodoAttachment) *AttachmentResponse {
+	return &AttachmentResponse{
+		Uuid:        src.UUID().String(),
+		FileName:    src.FileName(),
+		ContentType: src.ContentType(),
+		Size:        src.Size(),
+		Checksum:    src.Checksum(),
+		Uploader:    src.Uploader(),
+		CreatedAt:   src.CreatedAt().UTC().Format(time.RFC3339),
+	}
+}
+
+func AttachmentListResp(src []*domain.TodoAttachment) []*AttachmentResponse {
+	list := make([]*AttachmentResponse, 0, len(src))
+
+	for _, attachment := range src {
+		list = append(list, AttachmentResp(attachment))
+	}
+
+	return list
+}
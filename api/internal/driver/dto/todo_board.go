@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"microservice/internal/core/domain"
+)
+
+// MoveRequest places the item between the neighbours, the item is appended to the column without neighbours
+type MoveRequest struct {
+	Before string `json:"before" binding:"omitempty,uuid" example:"02bda2f0-61e5-483c-a2d8-15eafb00b945"`               // the item right below, in the target column
+	After  string `json:"after" binding:"omitempty,uuid,nefield=Before" example:"bf56c6b6-dd02-47ba-8dc4-bd7d2843a77a"` // the item right above, in the target column
+	Status string `json:"status" binding:"omitempty,oneof=todo in_progress done" example:"in_progress"`                 // target column, default: the column of the item
+}
+
+func (dto *MoveRequest) ToDomain() *domain.TodoMove {
+	d := domain.NewTodoMove(domain.NewTodo())
+
+	if len(dto.Before) > 0 {
+		id := uuid.MustParse(dto.Before)
+		d.SetBefore(&id)
+	}
+
+	if len(dto.After) > 0 {
+		id := uuid.MustParse(dto.After)
+		d.SetAfter(&id)
+	}
+
+	if len(dto.Status) > 0 {
+		d.SetStatus(&dto.Status)
+	}
+
+	return d
+}
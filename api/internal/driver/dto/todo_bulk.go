@@ -0,0 +1,110 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"microservice/internal/adapter/locale"
+	"microservice/internal/core/domain"
+	"microservice/internal/server/http/status"
+	"microservice/pkg/meta"
+	"net/http"
+)
+
+type (
+	BulkRequest struct {
+		Mode       string                  `json:"mode" binding:"omitempty,oneof=atomic best_effort" example:"atomic"` // default: atomic
+		Operations []*BulkOperationRequest `json:"operations" binding:"required,min=1,dive,required"`
+	}
+
+	// BulkOperationRequest the data is required by create and update, the uuid by the others
+	BulkOperationRequest struct {
+		Op      string         `json:"op" binding:"required,oneof=create update complete delete" example:"update"`
+		Uuid    string         `json:"uuid" binding:"required_unless=Op create,excluded_if=Op create,omitempty,uuid" example:"e48c48a3-cb72-4d64-b035-5c30fc900ef6"`
+		Version uint           `json:"version" example:"3"` // expected version(ETag) of the item, zero skips the check
+		Data    *CreateRequest `json:"data" binding:"required_if=Op create,required_if=Op update"`
+	}
+)
+
+func (dto *BulkRequest) ToDomain() *domain.TodoBulk {
+	d := domain.NewTodoBulk()
+	d.SetMode(dto.Mode)
+
+	ops := make([]*domain.TodoBulkOperation, 0, len(dto.Operations))
+	for _, item := range dto.Operations {
+		todo := domain.NewTodo()
+		if item.Data != nil {
+			todo = item.Data.ToDomain()
+		}
+
+		if len(item.Uuid) > 0 {
+			id := uuid.MustParse(item.Uuid) // validated by the `uuid` rule
+			todo.SetUUID(&id)
+		}
+
+		todo.SetVersion(item.Version)
+		ops = append(ops, domain.NewTodoBulkOperation(item.Op, todo))
+	}
+
+	d.SetOperations(ops)
+	return d
+}
+
+type (
+	BulkItemResponse struct {
+		Index   int     `json:"index" example:"0"`
+		Op      string  `json:"op" example:"update"`
+		Status  int     `json:"status" example:"200"`   // HTTP status of the operation
+		Applied bool    `json:"applied" example:"true"` // false for the failures and the rolled back(424) operations
+		Uuid    string  `json:"uuid,omitempty" example:"e48c48a3-cb72-4d64-b035-5c30fc900ef6"`
+		Version uint    `json:"version,omitempty" example:"4"`
+		Message *string `json:"message,omitempty" example:"record not found"` // reason of the failure
+	}
+
+	BulkResponse struct {
+		Mode      string              `json:"mode" example:"atomic"`
+		Succeeded int                 `json:"succeeded" example:"2"`
+		Failed    int                 `json:"failed" example:"1"`
+		Results   []*BulkItemResponse `json:"results"`
+	}
+)
+
+func BulkResp(l locale.ILocale, bulk *domain.TodoBulk, src []*domain.TodoBulkResult) *BulkResponse {
+	resp := &BulkResponse{Mode: bulk.Mode(), Results: make([]*BulkItemResponse, 0, len(src))}
+
+	for _, result := range src {
+		item := &BulkItemResponse{Index: result.Index(), Op: result.Op(), Applied: result.Applied()}
+
+		switch {
+		case result.Err() != nil:
+			item.Status, item.Message = itemStatus(l, meta.ErrStatus(result.Err()))
+			resp.Failed++
+		case !result.Applied():
+			item.Status, item.Message = itemStatus(l, status.BulkRolledBack)
+		case result.Op() == domain.BulkOpCreate:
+			item.Status = http.StatusCreated
+			resp.Succeeded++
+		default:
+			item.Status = http.StatusOK
+			resp.Succeeded++
+		}
+
+		if todo := result.Todo(); todo != nil && result.Applied() {
+			if todo.UUID() != uuid.Nil {
+				item.Uuid = todo.UUID().String()
+			}
+
+			if result.Op() != domain.BulkOpDelete {
+				item.Version = todo.Version()
+			}
+		}
+
+		resp.Results = append(resp.Results, item)
+	}
+
+	return resp
+}
+
+// itemStatus the HTTP status and the localized message of the failed operation
+func itemStatus(l locale.ILocale, st status.HttpMappedStatus) (int, *string) {
+	msg := l.Get(string(st))
+	return status.MappedStatuses[st], &msg
+}
@@ -0,0 +1,358 @@
+package dto
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"io"
+	"microservice/internal/core/domain"
+	"microservice/pkg/ical"
+	"sort"
+	"strings"
+	"time"
+)
+
+// FormatICS the iCalendar(RFC 5545) format, the items are exported as VTODO
+const FormatICS = "ics"
+
+// calendarProductID the PRODID of the exported calendars
+const calendarProductID = "-//microservice//todo//EN"
+
+// CalendarFeedPath the path of the feed of the token, the calendars subscribe to it
+func CalendarFeedPath(token string) string {
+	return "/ical/" + token + ".ics"
+}
+
+type CalendarFeedUriRequest struct {
+	FeedUuid string `param:"feed" binding:"required,uuid" example:"5c7e9a1b-3d5f-4a7b-9c1d-3e5f7a9b1c2d"`
+}
+
+func (dto *CalendarFeedUriRequest) ToDomain() *domain.TodoCalendarFeed {
+	id := uuid.MustParse(dto.FeedUuid)
+
+	d := domain.NewTodoCalendarFeed()
+	d.SetUUID(&id)
+	return d
+}
+
+type CalendarFeedCreateRequest struct {
+	Component string `json:"component" binding:"omitempty,oneof=vtodo vevent" example:"vtodo"` // `vevent` for the calendars without task support, default: vtodo
+}
+
+func (dto *CalendarFeedCreateRequest) ToDomain() *domain.TodoCalendarFeed {
+	d := domain.NewTodoCalendarFeed()
+	d.SetComponent(domain.CalendarTodo)
+
+	if len(dto.Component) > 0 {
+		d.SetComponent(dto.Component)
+	}
+
+	return d
+}
+
+// CalendarFileUriRequest the file of the feed, the token with the .ics extension
+type CalendarFileUriRequest struct {
+	File string `param:"file" binding:"required,max=68" example:"q0Zx3Jm2b6LhT9r1Vw8yNc4uEa7sKd5fGp0oRi2jHl.ics"`
+}
+
+func (dto *CalendarFileUriRequest) ToDomain() *domain.TodoCalendarFeed {
+	d := domain.NewTodoCalendarFeed()
+	d.SetToken(strings.TrimSuffix(dto.File, ".ics"))
+
+	return d
+}
+
+type CalendarFeedResponse struct {
+	Uuid      string  `json:"uuid" example:"5c7e9a1b-3d5f-4a7b-9c1d-3e5f7a9b1c2d"`
+	Component string  `json:"component" example:"vtodo"`
+	Token     *string `json:"token,omitempty" example:"q0Zx3Jm2b6LhT9r1Vw8yNc4uEa7sKd5fGp0oRi2jHl"`          // returned only once, by the create
+	Path      *string `json:"path,omitempty" example:"/ical/q0Zx3Jm2b6LhT9r1Vw8yNc4uEa7sKd5fGp0oRi2jHl.ics"` // the subscribed path, with the token
+	CreatedAt string  `json:"createdAt" example:"2025-08-07T08:15:00Z"`
+}
+
+func CalendarFeedResp(src *domain.TodoCalendarFeed) *CalendarFeedResponse {
+	resp := &CalendarFeedResponse{
+		Uuid:      src.UUID().String(),
+		Component: src.Component(),
+		CreatedAt: src.CreatedAt().UTC().Format(time.RFC3339),
+	}
+
+	if len(src.Token()) > 0 {
+		token, path := src.Token(), CalendarFeedPath(src.Token())
+		resp.Token, resp.Path = &token, &path
+	}
+
+	return resp
+}
+
+func CalendarFeedListResp(src []*domain.TodoCalendarFeed) []*CalendarFeedResponse {
+	list := make([]*CalendarFeedResponse, 0, len(src))
+
+	for _, feed := range src {
+		list = append(list, CalendarFeedResp(feed))
+	}
+
+	return list
+}
+
+//
+
+// calendarExportWriter streams the items as the components of a VCALENDAR.
+// the zoned due dates refer to the VTIMEZONE of their zone, the zones are written after the items, when their ranges are known
+type calendarExportWriter struct {
+	enc       *ical.Encoder
+	component string
+	name      string
+	started   bool
+	zones     map[string]*calendarZone
+}
+
+// calendarZone the range of the due dates in the zone
+type calendarZone struct {
+	location *time.Location
+	from, to time.Time
+}
+
+// NewCalendarWriter the writer of the VTODO or the VEVENT components, the name is the display name of the subscribed calendars
+func NewCalendarWriter(w io.Writer, component, name string) ExportWriter {
+	return &calendarExportWriter{enc: ical.NewEncoder(w), component: component, name: name, zones: make(map[string]*calendarZone)}
+}
+
+func (e *calendarExportWriter) Write(src *domain.Todo) error {
+	if err := e.begin(); err != nil {
+		return err
+	}
+
+	event := e.component == domain.CalendarEvent
+	if event && src.DueDate() == nil {
+		return nil // an event has a start
+	}
+
+	c := ical.NewComponent("VTODO")
+	if event {
+		c = ical.NewComponent("VEVENT")
+	}
+
+	c.Add("UID", src.UUID().String()).
+		Add("DTSTAMP", ical.DateTime(src.UpdatedAt().UTC())).
+		Add("CREATED", ical.DateTime(src.CreatedAt().UTC())).
+		Add("LAST-MODIFIED", ical.DateTime(src.UpdatedAt().UTC())).
+		Add("SUMMARY", ical.Text(*src.Description()))
+
+	if src.Notes() != nil {
+		c.Add("DESCRIPTION", ical.Text(*src.Notes()))
+	}
+
+	if src.DueDate() != nil {
+		// NOTE: the DUE of a VTODO must be later than its DTSTART(RFC 5545 3.8.2.3), the item has no start so only the DUE is sent
+		value, params := e.due(src)
+		switch {
+		case !event:
+			c.Add("DUE", value, params...)
+		case src.AllDay():
+			c.Add("DTSTART", value, params...).
+				Add("DTEND", ical.Date(src.DueDate().In(src.DueLocation()).AddDate(0, 0, 1)), params...)
+		default:
+			c.Add("DTSTART", value, params...)
+		}
+
+		if src.Recurrence() != nil {
+			c.Add("RRULE", *src.Recurrence())
+		}
+	}
+
+	switch {
+	case event:
+		c.Add("STATUS", "CONFIRMED")
+	case src.CompletedAt() != nil:
+		c.Add("STATUS", "COMPLETED").
+			Add("COMPLETED", ical.DateTime(src.CompletedAt().UTC())).
+			Add("PERCENT-COMPLETE", "100")
+	case src.Status() == domain.StatusInProgress:
+		c.Add("STATUS", "IN-PROCESS")
+	default:
+		c.Add("STATUS", "NEEDS-ACTION")
+	}
+
+	return e.enc.Encode(c)
+}
+
+func (e *calendarExportWriter) Close() error {
+	if err := e.begin(); err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(e.zones))
+	for name := range e.zones {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		zone := e.zones[name]
+		if err := e.enc.Encode(ical.Timezone(zone.location, zone.from, zone.to)); err != nil {
+			return err
+		}
+	}
+
+	return e.enc.End("VCALENDAR")
+}
+
+func (e *calendarExportWriter) begin() error {
+	if e.started {
+		return nil
+	}
+
+	e.started = true
+	calendar := ical.NewComponent("VCALENDAR").
+		Add("VERSION", "2.0").
+		Add("PRODID", calendarProductID).
+		Add("CALSCALE", "GREGORIAN").
+		Add("METHOD", "PUBLISH")
+
+	if len(e.name) > 0 {
+		calendar.Add("X-WR-CALNAME", ical.Text(e.name))
+	}
+
+	if err := e.enc.Begin(calendar.Name); err != nil {
+		return err
+	}
+
+	for _, p := range calendar.Properties {
+		if err := e.enc.Property(p); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// due the value of the due date with its parameters: DATE for the all-day items, the zone-less items in UTC
+func (e *calendarExportWriter) due(src *domain.Todo) (value string, params []ical.Param) {
+	location := src.DueLocation()
+	due := src.DueDate().In(location)
+
+	if src.AllDay() {
+		return ical.Date(due), []ical.Param{{Name: "VALUE", Value: "DATE"}}
+	}
+
+	if location == time.UTC {
+		return ical.DateTime(due), nil
+	}
+
+	// NOTE: the recurring items need the transitions of their next occurrences
+	to := due
+	if src.Recurrence() != nil {
+		to = due.AddDate(1, 0, 0)
+	}
+
+	zone, ok := e.zones[location.String()]
+	if !ok {
+		zone = &calendarZone{location: location, from: due, to: to}
+		e.zones[location.String()] = zone
+	}
+
+	if due.Before(zone.from) {
+		zone.from = due
+	}
+
+	if to.After(zone.to) {
+		zone.to = to
+	}
+
+	return ical.DateTime(due), []ical.Param{{Name: "TZID", Value: location.String()}}
+}
+
+//
+
+// icsImportReader reads the VTODO and the VEVENT components, the other components and the cancelled items are skipped.
+// the line of a row is the line of its BEGIN
+type icsImportReader struct {
+	dec *ical.Decoder
+}
+
+func (i *icsImportReader) next() (line int, row *ImportRow, err error) {
+	for {
+		c, decodeErr := i.dec.Next()
+		if decodeErr != nil {
+			var syntaxErr *ical.SyntaxError
+			if errors.As(decodeErr, &syntaxErr) {
+				return syntaxErr.Line, nil, &importLineErr{err: decodeErr, fatal: true}
+			}
+
+			return 0, nil, decodeErr
+		}
+
+		if c.Name != "VTODO" && c.Name != "VEVENT" {
+			continue
+		}
+
+		if s := c.Get("STATUS"); s != nil && strings.EqualFold(s.Value, "CANCELLED") {
+			continue
+		}
+
+		row, err = icsRow(c)
+		if err != nil {
+			return c.Line, nil, &importLineErr{err: err}
+		}
+
+		return c.Line, row, nil
+	}
+}
+
+// icsRow the row of the component, the due date is read from the DUE or the DTSTART
+func icsRow(c *ical.Component) (row *ImportRow, err error) {
+	row = new(ImportRow)
+
+	if p := c.Get("SUMMARY"); p != nil {
+		row.Description = p.Text()
+	}
+
+	if p := c.Get("DESCRIPTION"); p != nil {
+		row.Notes = p.Text()
+	}
+
+	if p := c.Get("RRULE"); p != nil {
+		row.Recurrence = p.Value
+	}
+
+	due := c.Get("DUE")
+	if due == nil {
+		due = c.Get("DTSTART")
+	}
+
+	if due != nil {
+		// NOTE: the floating times are zone-less, they are kept zone-less so they get the time zone of the row like the other zone-less due dates
+		t, allDay, timeErr := due.Time(time.Local)
+		switch {
+		case timeErr != nil:
+			return nil, timeErr
+		case allDay:
+			row.DueDate = t.Format(time.DateOnly)
+		case t.Location() == time.UTC:
+			row.DueDate = t.Format(time.RFC3339)
+		case len(due.Param("TZID")) > 0:
+			row.DueDate = t.Format("2006-01-02T15:04:05")
+			row.TimeZone = t.Location().String()
+		default:
+			row.DueDate = t.Format("2006-01-02T15:04:05")
+		}
+	}
+
+	status := c.Get("STATUS")
+	switch {
+	case status == nil:
+	case strings.EqualFold(status.Value, "COMPLETED"):
+		row.Status = domain.StatusDone
+
+		if completed := c.Get("COMPLETED"); completed != nil {
+			if t, _, timeErr := completed.Time(time.UTC); timeErr == nil {
+				row.CompletedAt = t.UTC().Format(time.RFC3339)
+			}
+		}
+	case strings.EqualFold(status.Value, "IN-PROCESS"):
+		row.Status = domain.StatusInProgress
+	}
+
+	return
+}
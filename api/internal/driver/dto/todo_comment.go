@@ -0,0 +1,194 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"math"
+	"microservice/internal/core/domain"
+	"microservice/pkg/markdown"
+	"time"
+)
+
+type CommentUriRequest struct {
+	Uuid        string `param:"uuid" binding:"required,uuid" example:"bf56c6b6-dd02-47ba-8dc4-bd7d2843a77a"`
+	CommentUuid string `param:"comment" binding:"required,uuid" example:"5b0e7f1c-3d4a-4c1e-9b8f-2a6d7e9c0f13"`
+}
+
+func (dto *CommentUriRequest) ToDomain() *domain.TodoComment {
+	id := uuid.MustParse(dto.CommentUuid)
+
+	d := domain.NewTodoComment()
+	d.SetUUID(&id)
+	d.SetTodoUUID(uuid.MustParse(dto.Uuid))
+	return d
+}
+
+type CommentCreateRequest struct {
+	Body       string `json:"body" binding:"required,max=10000" example:"@alice could you review the **outline**?"` // Markdown, the @mentions are parsed
+	ParentUuid string `json:"parentUuid" binding:"omitempty,uuid" example:"5b0e7f1c-3d4a-4c1e-9b8f-2a6d7e9c0f13"`   // the replied comment
+}
+
+func (dto *CommentCreateRequest) ToDomain() *domain.TodoComment {
+	d := domain.NewTodoComment()
+	d.SetBody(markdown.Sanitize(dto.Body))
+
+	if len(dto.ParentUuid) > 0 {
+		parent := uuid.MustParse(dto.ParentUuid) // validated by the `uuid` rule
+		d.SetParentUUID(&parent)
+	}
+
+	return d
+}
+
+type CommentUpdateRequest struct {
+	Body string `json:"body" binding:"required,max=10000" example:"@alice @bob could you review the **outline**?"` // Markdown, the @mentions are parsed
+}
+
+func (dto *CommentUpdateRequest) ToDomain() *domain.TodoComment {
+	d := domain.NewTodoComment()
+	d.SetBody(markdown.Sanitize(dto.Body))
+
+	return d
+}
+
+type CommentListQryRequest struct {
+	Page   int    `form:"page" binding:"omitempty,numeric" json:"page"`   // integer value
+	Limit  int    `form:"limit" binding:"omitempty,numeric" json:"limit"` // integer value
+	Order  string `form:"order" binding:"omitempty,oneof=asc desc" json:"order"`
+	Parent string `form:"parent" binding:"omitempty,uuid" json:"parent"` // the replies of the root comment
+}
+
+func (r *CommentListQryRequest) ToDomain() *domain.TodoCommentListReqQryParam {
+	qry := domain.NewTodoCommentListReqQryParam()
+	qry.ReqBaseQryParam = (&ListQryRequest{Page: r.Page, Limit: r.Limit, Order: r.Order}).EvalBaseQry()
+
+	if len(r.Parent) > 0 {
+		parent := uuid.MustParse(r.Parent) // validated by the `uuid` rule
+		qry.SetParent(&parent)
+	}
+
+	return qry
+}
+
+type (
+	CommentResponse struct {
+		Uuid       string   `json:"uuid" example:"5b0e7f1c-3d4a-4c1e-9b8f-2a6d7e9c0f13"`
+		ParentUuid *string  `json:"parentUuid" example:"2c4f9a7e-8b1d-4e3a-a6f5-0d9c8b7a6e51"` // null for the root comments
+		Author     *string  `json:"author" example:"42"`                                       // X-User-ID of the request, null for the anonymous comments
+		Body       string   `json:"body" example:"@alice could you review the **outline**?"`
+		Mentions   []string `json:"mentions" example:"alice"`
+		Replies    *int64   `json:"replies,omitempty" example:"2"` // reply count of the listed root comments
+		Edited     bool     `json:"edited" example:"false"`
+		CreatedAt  string   `json:"createdAt" example:"2025-08-07T08:15:00Z"`
+		UpdatedAt  string   `json:"updatedAt" example:"2025-08-07T08:15:00Z"`
+	}
+
+	CommentListResponse struct {
+		Page     int                `json:"page" example:"1"`
+		Limit    int                `json:"limit" example:"10"`
+		Pages    int                `json:"pages" example:"3"`
+		Total    int64              `json:"total" example:"27"`
+		Comments []*CommentResponse `json:"comments"`
+	}
+)
+
+func CommentResp(src *domain.TodoComment) *CommentResponse {
+	resp := &CommentResponse{
+		Uuid:      src.UUID().String(),
+		Author:    src.Author(),
+		Body:      src.Body(),
+		Mentions:  src.Mentions(),
+		Edited:    src.Edited(),
+		CreatedAt: src.CreatedAt().UTC().Format(time.RFC3339),
+		UpdatedAt: src.UpdatedAt().UTC().Format(time.RFC3339),
+	}
+
+	if resp.Mentions == nil {
+		resp.Mentions = make([]string, 0)
+	}
+
+	if src.ParentUUID() != nil {
+		parent := src.ParentUUID().String()
+		resp.ParentUuid = &parent
+	}
+
+	return resp
+}
+
+func CommentListResp(qry *domain.TodoCommentListReqQryParam, src *domain.TodoCommentList) *CommentListResponse {
+	list := new(CommentListResponse)
+	list.Page = qry.Page()
+	list.Limit = qry.Limit()
+	list.Pages = int(math.Ceil(float64(src.Total()) / float64(qry.Limit())))
+	list.Total = src.Total()
+	list.Comments = make([]*CommentResponse, 0, len(src.List()))
+
+	for _, comment := range src.List() {
+		item := CommentResp(comment)
+		if qry.Parent() == nil {
+			replies := comment.ReplyCount()
+			item.Replies = &replies
+		}
+
+		list.Comments = append(list.Comments, item)
+	}
+
+	return list
+}
+
+//
+
+// ActivityQryRequest the activities are ordered by their creation, the oldest first by default
+type ActivityQryRequest struct {
+	Page  int    `form:"page" binding:"omitempty,numeric" json:"page"`   // integer value
+	Limit int    `form:"limit" binding:"omitempty,numeric" json:"limit"` // integer value
+	Order string `form:"order" binding:"omitempty,oneof=asc desc" json:"order"`
+}
+
+func (r *ActivityQryRequest) ToDomain() *domain.TodoActivityReqQryParam {
+	qry := domain.NewTodoActivityReqQryParam()
+	qry.ReqBaseQryParam = (&ListQryRequest{Page: r.Page, Limit: r.Limit, Order: r.Order}).EvalBaseQry()
+
+	return qry
+}
+
+type (
+	ActivityItemResponse struct {
+		Type      string            `json:"type" example:"comment"` // `comment` or `change`
+		CreatedAt string            `json:"createdAt" example:"2025-08-07T08:15:00Z"`
+		Comment   *CommentResponse  `json:"comment,omitempty"`
+		Change    *RevisionResponse `json:"change,omitempty"`
+	}
+
+	ActivityResponse struct {
+		Page       int                     `json:"page" example:"1"`
+		Limit      int                     `json:"limit" example:"10"`
+		Pages      int                     `json:"pages" example:"3"`
+		Total      int64                   `json:"total" example:"27"`
+		Activities []*ActivityItemResponse `json:"activities"`
+	}
+)
+
+func ActivityResp(qry *domain.TodoActivityReqQryParam, src *domain.TodoActivityList) *ActivityResponse {
+	list := new(ActivityResponse)
+	list.Page = qry.Page()
+	list.Limit = qry.Limit()
+	list.Pages = int(math.Ceil(float64(src.Total()) / float64(qry.Limit())))
+	list.Total = src.Total()
+	list.Activities = make([]*ActivityItemResponse, 0, len(src.List()))
+
+	for _, activity := range src.List() {
+		item := &ActivityItemResponse{Type: activity.Kind(), CreatedAt: activity.CreatedAt().UTC().Format(time.RFC3339)}
+
+		if activity.Comment() != nil {
+			item.Comment = CommentResp(activity.Comment())
+		}
+
+		if activity.Revision() != nil {
+			item.Change = revisionResp(activity.Revision())
+		}
+
+		list.Activities = append(list.Activities, item)
+	}
+
+	return list
+}
@@ -0,0 +1,599 @@
+package dto
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"microservice/internal/core/domain"
+	"microservice/pkg/ical"
+	"microservice/pkg/todotxt"
+	"microservice/pkg/validator"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	goValidator "github.com/go-playground/validator/v10"
+)
+
+// the file formats of the export and the import
+const (
+	FormatCSV     = "csv"
+	FormatJSON    = "json"
+	FormatNDJSON  = "ndjson"
+	FormatTodoTxt = "todotxt"
+)
+
+const (
+	// importMaxErrors the reported invalid rows of an import, the rest is only counted
+	importMaxErrors = 100
+	// importMaxLine bytes of a line of the line-based formats, the notes of 20000 characters fit into it
+	importMaxLine = 1 << 20
+)
+
+// ErrImportTooLarge the imported file has more rows than the limit
+var ErrImportTooLarge = errors.New("the file has too many rows")
+
+// exportColumns the columns of the CSV export, in the order of the ExportItem fields
+var exportColumns = []string{
+	"uuid", "description", "dueDate", "allDay", "timeZone", "language", "notes", "recurrence", "status", "completedAt",
+	"owner", "createdAt",
+}
+
+type FormatQryRequest struct {
+	Format string `form:"format" binding:"required,oneof=csv json ndjson todotxt ics" json:"format"`
+}
+
+func (r *FormatQryRequest) ToDomain() *string {
+	return &r.Format
+}
+
+type DryRunQryRequest struct {
+	DryRun bool `form:"dry_run" binding:"omitempty" json:"dry_run"` // validates the file without importing it
+}
+
+func (r *DryRunQryRequest) ToDomain() *bool {
+	return &r.DryRun
+}
+
+// ExportItem an item of the JSON and the NDJSON exports, the import accepts it as a row
+type ExportItem struct {
+	Uuid        string  `json:"uuid" example:"e48c48a3-cb72-4d64-b035-5c30fc900ef6"`
+	Description string  `json:"description" example:"Create new todo item"`
+	DueDate     *string `json:"dueDate" example:"2025-08-07T09:00:00+02:00"` // RFC3339, date-only for the all-day items
+	AllDay      bool    `json:"allDay" example:"false"`
+	TimeZone    *string `json:"timeZone" example:"Europe/Berlin"`
+	Language    string  `json:"language" example:"english"`
+	Notes       *string `json:"notes" example:"## Steps\n- [ ] draft the **outline**"`
+	Recurrence  *string `json:"recurrence" example:"FREQ=WEEKLY;BYDAY=MO"`
+	Status      string  `json:"status" example:"in_progress"`
+	CompletedAt *string `json:"completedAt" example:"2025-08-07T08:15:00Z"`
+	Owner       *string `json:"owner" example:"42"`
+	CreatedAt   string  `json:"createdAt" example:"2025-08-01T10:11:12Z"`
+}
+
+func ExportItemResp(src *domain.Todo) *ExportItem {
+	return &ExportItem{
+		Uuid:        src.UUID().String(),
+		Description: *src.Description(),
+		DueDate:     formatDueDate(src),
+		AllDay:      src.AllDay(),
+		TimeZone:    src.DueTimeZone(),
+		Language:    src.Language(),
+		Notes:       src.Notes(),
+		Recurrence:  src.Recurrence(),
+		Status:      src.Status(),
+		CompletedAt: formatCompletedAt(src),
+		Owner:       src.Owner(),
+		CreatedAt:   src.CreatedAt().UTC().Format(time.RFC3339),
+	}
+}
+
+// ExportContentType the media type and the file name of the format
+func ExportContentType(format string) (contentType, fileName string) {
+	switch format {
+	case FormatCSV:
+		return "text/csv; charset=utf-8", "todos.csv"
+	case FormatNDJSON:
+		return "application/x-ndjson", "todos.ndjson"
+	case FormatTodoTxt:
+		return "text/plain; charset=utf-8", "todo.txt"
+	case FormatICS:
+		return "text/calendar; charset=utf-8", "todos.ics"
+	default:
+		return "application/json; charset=utf-8", "todos.json"
+	}
+}
+
+// ExportWriter encodes the exported items one by one
+type ExportWriter interface {
+	Write(src *domain.Todo) error
+	// Close completes the document, the empty export is a valid document as well
+	Close() error
+}
+
+// NewExportWriter the writer of the validated format
+func NewExportWriter(format string, w io.Writer) ExportWriter {
+	switch format {
+	case FormatCSV:
+		return &csvExportWriter{w: csv.NewWriter(w)}
+	case FormatNDJSON:
+		return &ndjsonExportWriter{enc: json.NewEncoder(w)}
+	case FormatTodoTxt:
+		return &todoTxtExportWriter{w: w}
+	case FormatICS:
+		return NewCalendarWriter(w, domain.CalendarTodo, "")
+	default:
+		return &jsonExportWriter{w: w}
+	}
+}
+
+type csvExportWriter struct {
+	w      *csv.Writer
+	header bool
+}
+
+func (e *csvExportWriter) Write(src *domain.Todo) error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+
+	item := ExportItemResp(src)
+	return e.w.Write([]string{
+		item.Uuid, item.Description, optional(item.DueDate), strconv.FormatBool(item.AllDay), optional(item.TimeZone),
+		item.Language, optional(item.Notes), optional(item.Recurrence), item.Status, optional(item.CompletedAt),
+		optional(item.Owner), item.CreatedAt,
+	})
+}
+
+func (e *csvExportWriter) Close() error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+
+	e.w.Flush()
+	return e.w.Error()
+}
+
+func (e *csvExportWriter) writeHeader() error {
+	if e.header {
+		return nil
+	}
+
+	e.header = true
+	return e.w.Write(exportColumns)
+}
+
+// jsonExportWriter writes the array item by item
+type jsonExportWriter struct {
+	w     io.Writer
+	count int
+}
+
+func (e *jsonExportWriter) Write(src *domain.Todo) error {
+	item, err := json.Marshal(ExportItemResp(src))
+	if err != nil {
+		return err
+	}
+
+	separator := ","
+	if e.count == 0 {
+		separator = "["
+	}
+
+	e.count++
+	_, err = e.w.Write(append([]byte(separator), item...))
+	return err
+}
+
+func (e *jsonExportWriter) Close() (err error) {
+	if e.count == 0 {
+		_, err = io.WriteString(e.w, "[]")
+		return
+	}
+
+	_, err = io.WriteString(e.w, "]")
+	return
+}
+
+type ndjsonExportWriter struct {
+	enc *json.Encoder
+}
+
+func (e *ndjsonExportWriter) Write(src *domain.Todo) error {
+	return e.enc.Encode(ExportItemResp(src))
+}
+
+func (e *ndjsonExportWriter) Close() error {
+	return nil
+}
+
+// todoTxtExportWriter writes a line per item, the notes and the assignees have no place in the format
+type todoTxtExportWriter struct {
+	w io.Writer
+}
+
+func (e *todoTxtExportWriter) Write(src *domain.Todo) error {
+	created := src.CreatedAt().UTC()
+	task := todotxt.Task{
+		Completed:   src.Completed(),
+		CreatedOn:   &created,
+		Description: *src.Description(),
+		Tags:        make(map[string]string),
+	}
+
+	if src.CompletedAt() != nil {
+		completed := src.CompletedAt().UTC()
+		task.CompletedOn = &completed
+	}
+
+	if due := formatDueDate(src); due != nil {
+		task.Tags["due"] = *due
+	}
+
+	if src.Status() == domain.StatusInProgress {
+		task.Tags["status"] = src.Status() // the todo and the done columns follow the completion
+	}
+
+	_, err := io.WriteString(e.w, task.String()+"\n")
+	return err
+}
+
+func (e *todoTxtExportWriter) Close() error {
+	return nil
+}
+
+//
+
+// ImportRow a row of the imported file, the other columns of the export are ignored.
+// the completed rows are placed into the done column, the rows of the done column are completed
+type ImportRow struct {
+	CreateRequest
+	Status      string `json:"status" binding:"omitempty,oneof=todo in_progress done" example:"in_progress"`
+	CompletedAt string `json:"completedAt" binding:"omitempty,dateOrDateTime" example:"2025-08-07T08:15:00Z"` // date-only or RFC3339
+}
+
+func (r *ImportRow) ToDomain() *domain.Todo {
+	d := r.CreateRequest.ToDomain()
+
+	column := r.Status
+	if len(r.CompletedAt) > 0 {
+		completedAt := parseDateOrDateTime(r.CompletedAt, time.UTC)
+		d.SetCompletedAt(&completedAt)
+		column = domain.StatusDone
+	} else if column == domain.StatusDone {
+		now := time.Now().UTC()
+		d.SetCompletedAt(&now)
+	}
+
+	if len(column) > 0 {
+		d.SetStatus(&column)
+	}
+
+	return d
+}
+
+type (
+	// ImportLineError the invalid row, the line is the position of the item for the JSON array
+	ImportLineError struct {
+		Line    int    `json:"line" example:"3"`
+		Field   string `json:"field,omitempty" example:"dueDate"`
+		Message string `json:"message" example:"the value does not satisfy the dueDate rule"`
+	}
+
+	ImportResponse struct {
+		DryRun   bool               `json:"dryRun" example:"false"`
+		Total    int                `json:"total" example:"27"` // rows of the file
+		Imported int                `json:"imported" example:"27"`
+		Invalid  int                `json:"invalid" example:"0"`
+		Errors   []*ImportLineError `json:"errors"` // the first 100 invalid rows
+	}
+)
+
+// DecodeImport reads and validates the rows of the file, the invalid rows are reported in the response.
+// it fails with ErrImportTooLarge for more than maxRows rows, and with the error of the reader(like the size limit)
+func DecodeImport(ctx context.Context, format string, r io.Reader, maxRows int) (ents []*domain.Todo, resp *ImportResponse, err error) {
+	resp = &ImportResponse{Errors: make([]*ImportLineError, 0)}
+	reader := newImportReader(format, r)
+
+	for {
+		line, row, readErr := reader.next()
+		if errors.Is(readErr, io.EOF) {
+			return
+		}
+
+		var lineErr *importLineErr
+		if readErr != nil && !errors.As(readErr, &lineErr) {
+			err = readErr
+			return
+		}
+
+		if resp.Total++; resp.Total > maxRows {
+			err = ErrImportTooLarge
+			return
+		}
+
+		if lineErr != nil {
+			resp.fail(&ImportLineError{Line: line, Message: lineErr.Error()})
+
+			if lineErr.fatal {
+				return // the rest of the file is not readable
+			}
+
+			continue
+		}
+
+		if validateErr := validator.ValidateStruct(ctx, row); validateErr != nil {
+			resp.fail(importFieldErrors(line, validateErr)...)
+			continue
+		}
+
+		ents = append(ents, row.ToDomain())
+	}
+}
+
+func (resp *ImportResponse) fail(errs ...*ImportLineError) {
+	resp.Invalid++
+
+	for _, err := range errs {
+		if len(resp.Errors) < importMaxErrors {
+			resp.Errors = append(resp.Errors, err)
+		}
+	}
+}
+
+// HELPERS
+
+// importLineErr the malformed row, the fatal error ends the file
+type importLineErr struct {
+	err   error
+	fatal bool
+}
+
+func (e *importLineErr) Error() string { return e.err.Error() }
+
+func (e *importLineErr) Unwrap() error { return e.err }
+
+type importReader interface {
+	// next the next row with its line, io.EOF at the end of the file and *importLineErr for the malformed rows
+	next() (line int, row *ImportRow, err error)
+}
+
+func newImportReader(format string, r io.Reader) importReader {
+	switch format {
+	case FormatCSV:
+		reader := csv.NewReader(r)
+		reader.FieldsPerRecord = -1
+		reader.ReuseRecord = true
+		return &csvImportReader{r: reader}
+	case FormatNDJSON:
+		return &ndjsonImportReader{s: lineScanner(r)}
+	case FormatTodoTxt:
+		return &todoTxtImportReader{s: lineScanner(r)}
+	case FormatICS:
+		return &icsImportReader{dec: ical.NewDecoder(r)}
+	default:
+		return &jsonImportReader{dec: json.NewDecoder(r)}
+	}
+}
+
+// csvImportReader maps the columns by the header row, its names are case-insensitive
+type csvImportReader struct {
+	r       *csv.Reader
+	columns map[string]int
+}
+
+func (i *csvImportReader) next() (line int, row *ImportRow, err error) {
+	if i.columns == nil {
+		if err = i.header(); err != nil {
+			return 1, nil, err
+		}
+	}
+
+	record, readErr := i.r.Read()
+	if readErr != nil {
+		var parseErr *csv.ParseError
+		if errors.As(readErr, &parseErr) {
+			return parseErr.Line, nil, &importLineErr{err: readErr, fatal: true}
+		}
+
+		return 0, nil, readErr
+	}
+
+	line, _ = i.r.FieldPos(0)
+	value := func(column string) string {
+		if index, ok := i.columns[column]; ok && index < len(record) {
+			return record[index]
+		}
+
+		return ""
+	}
+
+	row = new(ImportRow)
+	row.Description = value("description")
+	row.DueDate = value("duedate")
+	row.TimeZone = value("timezone")
+	row.Language = value("language")
+	row.Notes = value("notes")
+	row.Recurrence = value("recurrence")
+	row.Status = value("status")
+	row.CompletedAt = value("completedat")
+	return
+}
+
+func (i *csvImportReader) header() error {
+	record, err := i.r.Read()
+	if errors.Is(err, io.EOF) {
+		return err
+	}
+
+	if err != nil {
+		return &importLineErr{err: err, fatal: true}
+	}
+
+	i.columns = make(map[string]int, len(record))
+	for index, column := range record {
+		column = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(column, "\ufeff"))) // the BOM of the spreadsheet exports
+		i.columns[column] = index
+	}
+
+	if _, ok := i.columns["description"]; !ok {
+		return &importLineErr{err: errors.New("the header row has no description column"), fatal: true}
+	}
+
+	return nil
+}
+
+// jsonImportReader reads the items of the array one by one, the syntax errors end the file
+type jsonImportReader struct {
+	dec      *json.Decoder
+	position int
+}
+
+func (i *jsonImportReader) next() (line int, row *ImportRow, err error) {
+	if i.position == 0 {
+		token, tokenErr := i.dec.Token()
+		if errors.Is(tokenErr, io.EOF) {
+			return 0, nil, tokenErr
+		}
+
+		if delim, ok := token.(json.Delim); tokenErr != nil || !ok || delim != '[' {
+			return 1, nil, &importLineErr{err: errors.New("the file is not a JSON array"), fatal: true}
+		}
+	}
+
+	if !i.dec.More() {
+		return 0, nil, io.EOF
+	}
+
+	i.position++
+	row = new(ImportRow)
+	if decodeErr := i.dec.Decode(row); decodeErr != nil {
+		var typeErr *json.UnmarshalTypeError
+		return i.position, nil, &importLineErr{err: decodeErr, fatal: !errors.As(decodeErr, &typeErr)}
+	}
+
+	return i.position, row, nil
+}
+
+// ndjsonImportReader reads a JSON object per line, the blank lines are skipped
+type ndjsonImportReader struct {
+	s    *bufio.Scanner
+	line int
+}
+
+func (i *ndjsonImportReader) next() (line int, row *ImportRow, err error) {
+	text, line, err := scanLine(i.s, &i.line)
+	if err != nil {
+		return
+	}
+
+	row = new(ImportRow)
+	if decodeErr := json.Unmarshal(text, row); decodeErr != nil {
+		return line, nil, &importLineErr{err: decodeErr}
+	}
+
+	return
+}
+
+// todoTxtImportReader reads a task per line, the due date and the column are read from the due: and status: tags
+type todoTxtImportReader struct {
+	s    *bufio.Scanner
+	line int
+}
+
+func (i *todoTxtImportReader) next() (line int, row *ImportRow, err error) {
+	text, line, err := scanLine(i.s, &i.line)
+	if err != nil {
+		return
+	}
+
+	task, parseErr := todotxt.Parse(string(text))
+	if parseErr != nil {
+		return line, nil, &importLineErr{err: parseErr}
+	}
+
+	row = new(ImportRow)
+	row.Description = task.Description
+	row.DueDate = task.Tags["due"]
+	row.Status = task.Tags["status"]
+
+	switch {
+	case task.CompletedOn != nil:
+		row.CompletedAt = task.CompletedOn.Format(time.DateOnly)
+	case task.Completed:
+		row.Status = domain.StatusDone
+	}
+
+	return
+}
+
+func lineScanner(r io.Reader) *bufio.Scanner {
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 0, 64*1024), importMaxLine)
+	return s
+}
+
+// scanLine the next non-blank line, the line counter is advanced by the skipped lines too
+func scanLine(s *bufio.Scanner, counter *int) (text []byte, line int, err error) {
+	for s.Scan() {
+		*counter++
+
+		if text = bytes.TrimSpace(s.Bytes()); len(text) > 0 {
+			return text, *counter, nil
+		}
+	}
+
+	if err = s.Err(); err != nil {
+		if errors.Is(err, bufio.ErrTooLong) {
+			return nil, *counter + 1, &importLineErr{err: err, fatal: true}
+		}
+
+		return
+	}
+
+	return nil, 0, io.EOF
+}
+
+// importFieldErrors an error per failed field, named by its JSON key
+func importFieldErrors(line int, err error) []*ImportLineError {
+	var validationErrs goValidator.ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		return []*ImportLineError{{Line: line, Message: err.Error()}}
+	}
+
+	errs := make([]*ImportLineError, 0, len(validationErrs))
+	for _, fieldErr := range validationErrs {
+		errs = append(errs, &ImportLineError{
+			Line:    line,
+			Field:   jsonName(reflect.TypeOf(ImportRow{}), fieldErr.StructField()),
+			Message: fmt.Sprintf("the value does not satisfy the %s rule", fieldErr.Tag()),
+		})
+	}
+
+	return errs
+}
+
+// jsonName the JSON key of the struct field, including the promoted fields
+func jsonName(t reflect.Type, field string) string {
+	if f, ok := t.FieldByName(field); ok {
+		if name, _, _ := strings.Cut(f.Tag.Get("json"), ","); len(name) > 0 {
+			return name
+		}
+	}
+
+	return field
+}
+
+func optional(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
@@ -0,0 +1,86 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"math"
+	"microservice/internal/core/domain"
+	"strconv"
+	"time"
+)
+
+// HistoryQryRequest the revisions are ordered by the revision number, the newest first by default
+type HistoryQryRequest struct {
+	Page  int    `form:"page" binding:"omitempty,numeric" json:"page"`   // integer value
+	Limit int    `form:"limit" binding:"omitempty,numeric" json:"limit"` // integer value
+	Order string `form:"order" binding:"omitempty,oneof=asc desc" json:"order"`
+}
+
+func (r *HistoryQryRequest) ToDomain() *domain.ReqBaseQryParam {
+	qry := (&ListQryRequest{Page: r.Page, Limit: r.Limit, Order: r.Order}).EvalBaseQry()
+	return &qry
+}
+
+type RevisionUriRequest struct {
+	Uuid     string `param:"uuid" binding:"required,uuid" example:"bf56c6b6-dd02-47ba-8dc4-bd7d2843a77a"`
+	Revision string `param:"revision" binding:"required,numeric" example:"12"`
+}
+
+func (dto *RevisionUriRequest) ToDomain() *domain.TodoRevision {
+	revision, _ := strconv.ParseUint(dto.Revision, 10, 64) // validated by the `numeric` rule
+
+	d := new(domain.TodoRevision)
+	d.SetID(uint(revision))
+	d.SetTodoUUID(uuid.MustParse(dto.Uuid))
+	return d
+}
+
+type (
+	RevisionResponse struct {
+		Revision  uint                         `json:"revision" example:"12"`
+		Action    string                       `json:"action" example:"update"` // create, update, complete, delete, restore, revert, move or assign
+		Actor     *string                      `json:"actor" example:"42"`      // X-User-ID of the request, null for the anonymous requests
+		RequestId *string                      `json:"requestId" example:"6f1c2a5e-0d7b-4d5e-9a43-3b1f0e2c7a10"`
+		CreatedAt string                       `json:"createdAt" example:"2025-08-07T08:15:00Z"`
+		Before    *domain.TodoSnapshot         `json:"before"` // null for the creates
+		After     *domain.TodoSnapshot         `json:"after"`  // null for the deletes
+		Diff      map[string]domain.TodoChange `json:"diff"`   // changed fields of the snapshots
+	}
+
+	HistoryResponse struct {
+		Page      int                 `json:"page" example:"1"`
+		Limit     int                 `json:"limit" example:"10"`
+		Pages     int                 `json:"pages" example:"3"`
+		Total     int64               `json:"total" example:"27"`
+		Revisions []*RevisionResponse `json:"revisions"`
+	}
+)
+
+func HistoryResp(qry *domain.ReqBaseQryParam, src *domain.TodoRevisionList) *HistoryResponse {
+	list := new(HistoryResponse)
+	list.Page = qry.Page()
+	list.Limit = qry.Limit()
+	list.Pages = int(math.Ceil(float64(src.Total()) / float64(qry.Limit())))
+	list.Total = src.Total()
+	list.Revisions = make([]*RevisionResponse, 0, len(src.List()))
+
+	for _, rev := range src.List() {
+		list.Revisions = append(list.Revisions, revisionResp(rev))
+	}
+
+	return list
+}
+
+// HELPERS
+
+func revisionResp(src *domain.TodoRevision) *RevisionResponse {
+	return &RevisionResponse{
+		Revision:  src.ID(),
+		Action:    src.Action(),
+		Actor:     src.Actor(),
+		RequestId: src.RequestID(),
+		CreatedAt: src.CreatedAt().UTC().Format(time.RFC3339),
+		Before:    src.Before(),
+		After:     src.After(),
+		Diff:      src.Diff(),
+	}
+}
@@ -0,0 +1,148 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"microservice/internal/core/domain"
+	"time"
+)
+
+type ShareUriRequest struct {
+	Uuid      string `param:"uuid" binding:"required,uuid" example:"bf56c6b6-dd02-47ba-8dc4-bd7d2843a77a"`
+	ShareUuid string `param:"share" binding:"required,uuid" example:"7a1d3c5e-9b2f-4e6a-8c0d-1f2e3d4c5b6a"`
+}
+
+func (dto *ShareUriRequest) ToDomain() *domain.TodoShare {
+	id := uuid.MustParse(dto.ShareUuid)
+
+	d := domain.NewTodoShare()
+	d.SetUUID(&id)
+	d.SetTodoUUID(uuid.MustParse(dto.Uuid))
+	return d
+}
+
+type ShareGrantRequest struct {
+	Type       string `json:"type" binding:"required,oneof=user group" example:"user"`
+	Grantee    string `json:"grantee" binding:"required,max=255" example:"42"` // id of the user or the group
+	Permission string `json:"permission" binding:"required,oneof=read comment edit" example:"comment"`
+}
+
+func (dto *ShareGrantRequest) ToDomain() *domain.TodoShare {
+	d := domain.NewTodoShare()
+	d.SetGranteeType(dto.Type)
+	d.SetGrantee(dto.Grantee)
+	d.SetPermission(dto.Permission)
+
+	return d
+}
+
+type ShareResponse struct {
+	Uuid       string  `json:"uuid" example:"7a1d3c5e-9b2f-4e6a-8c0d-1f2e3d4c5b6a"`
+	Type       string  `json:"type" example:"user"` // `user` or `group`
+	Grantee    string  `json:"grantee" example:"42"`
+	Permission string  `json:"permission" example:"comment"`
+	GrantedBy  *string `json:"grantedBy" example:"7"`
+	CreatedAt  string  `json:"createdAt" example:"2025-08-07T08:15:00Z"`
+	UpdatedAt  string  `json:"updatedAt" example:"2025-08-07T08:15:00Z"`
+}
+
+func ShareResp(src *domain.TodoShare) *ShareResponse {
+	return &ShareResponse{
+		Uuid:       src.UUID().String(),
+		Type:       src.GranteeType(),
+		Grantee:    src.Grantee(),
+		Permission: src.Permission(),
+		GrantedBy:  src.GrantedBy(),
+		CreatedAt:  src.CreatedAt().UTC().Format(time.RFC3339),
+		UpdatedAt:  src.UpdatedAt().UTC().Format(time.RFC3339),
+	}
+}
+
+func ShareListResp(src []*domain.TodoShare) []*ShareResponse {
+	list := make([]*ShareResponse, 0, len(src))
+
+	for _, share := range src {
+		list = append(list, ShareResp(share))
+	}
+
+	return list
+}
+
+//
+
+type ShareLinkUriRequest struct {
+	Uuid     string `param:"uuid" binding:"required,uuid" example:"bf56c6b6-dd02-47ba-8dc4-bd7d2843a77a"`
+	LinkUuid string `param:"link" binding:"required,uuid" example:"3e5f7a9b-1c2d-4e6f-8a0b-2c4d6e8f0a1b"`
+}
+
+func (dto *ShareLinkUriRequest) ToDomain() *domain.TodoShareLink {
+	id := uuid.MustParse(dto.LinkUuid)
+
+	d := domain.NewTodoShareLink()
+	d.SetUUID(&id)
+	d.SetTodoUUID(uuid.MustParse(dto.Uuid))
+	return d
+}
+
+type ShareLinkCreateRequest struct {
+	ExpiresIn int `json:"expiresIn" binding:"omitempty,min=60,max=31536000" example:"604800"` // seconds, the link never expires when it is omitted
+}
+
+func (dto *ShareLinkCreateRequest) ToDomain() *domain.TodoShareLink {
+	d := domain.NewTodoShareLink()
+
+	if dto.ExpiresIn > 0 {
+		expiresAt := time.Now().Add(time.Duration(dto.ExpiresIn) * time.Second)
+		d.SetExpiresAt(&expiresAt)
+	}
+
+	return d
+}
+
+type ShareTokenUriRequest struct {
+	Token string `param:"token" binding:"required,max=64" example:"q0Zx3Jm2b6LhT9r1Vw8yNc4uEa7sKd5fGp0oRi2jHl"`
+}
+
+func (dto *ShareTokenUriRequest) ToDomain() *domain.TodoShareLink {
+	d := domain.NewTodoShareLink()
+	d.SetToken(dto.Token)
+
+	return d
+}
+
+type ShareLinkResponse struct {
+	Uuid      string  `json:"uuid" example:"3e5f7a9b-1c2d-4e6f-8a0b-2c4d6e8f0a1b"`
+	Token     *string `json:"token,omitempty" example:"q0Zx3Jm2b6LhT9r1Vw8yNc4uEa7sKd5fGp0oRi2jHl"` // returned only once, by the create
+	ExpiresAt *string `json:"expiresAt" example:"2025-08-14T08:15:00Z"`                             // null for the links without expiry
+	CreatedBy *string `json:"createdBy" example:"7"`
+	CreatedAt string  `json:"createdAt" example:"2025-08-07T08:15:00Z"`
+}
+
+func ShareLinkResp(src *domain.TodoShareLink) *ShareLinkResponse {
+	resp := &ShareLinkResponse{
+		Uuid:      src.UUID().String(),
+		CreatedBy: src.CreatedBy(),
+		CreatedAt: src.CreatedAt().UTC().Format(time.RFC3339),
+	}
+
+	if len(src.Token()) > 0 {
+		token := src.Token()
+		resp.Token = &token
+	}
+
+	if src.ExpiresAt() != nil {
+		expiresAt := src.ExpiresAt().UTC().Format(time.RFC3339)
+		resp.ExpiresAt = &expiresAt
+	}
+
+	return resp
+}
+
+func ShareLinkListResp(src []*domain.TodoShareLink) []*ShareLinkResponse {
+	list := make([]*ShareLinkResponse, 0, len(src))
+
+	for _, link := range src {
+		list = append(list, ShareLinkResp(link))
+	}
+
+	return list
+}
@@ -0,0 +1,42 @@
+package middlewares
+
+import (
+	"microservice/internal/adapter/logger"
+	"microservice/pkg/reqctx"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// AccessLog writes one line per request, the route is the pattern of the matched route.
+// the raw path is not logged, as the params of the routes like the share and the feed tokens are secrets.
+// the server errors are logged as errors, so they reach the error log
+func AccessLog(lgr logger.ILogger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		// NOTE: the request of the context is replaced by the next middlewares, it has the actor and the span of the request
+		ctx := c.Request.Context()
+		code := c.Writer.Status()
+
+		fields := []zap.Field{
+			zap.String("method", c.Request.Method),
+			zap.String("route", c.FullPath()),
+			zap.Int("status", code),
+			zap.Duration("latency", time.Since(start)),
+			zap.Int("bytes", c.Writer.Size()),
+			zap.String("client_ip", c.ClientIP()),
+			zap.String("user_id", reqctx.Actor(ctx)),
+		}
+
+		if code >= http.StatusInternalServerError {
+			lgr.WithContext(ctx).Error("http.access", fields...)
+			return
+		}
+
+		lgr.WithContext(ctx).Info("http.access", fields...)
+	}
+}
@@ -0,0 +1,11 @@
+package middlewares
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+func AdminAuth(username, password string) gin.HandlerFunc {
+	return gin.BasicAuth(gin.Accounts{
+		username: password,
+	})
+}
@@ -1,19 +1,27 @@
 package middlewares
 
 import (
+	"fmt"
+	"microservice/internal/adapter/locale"
+	"microservice/internal/adapter/logger"
+	"microservice/internal/server/http/status"
+	"microservice/pkg/meta"
+
 	"github.com/gin-gonic/gin"
-	"net/http"
+	"go.uber.org/zap"
 )
 
-func ErrorHandler(c *gin.Context, caughtErr any) {
-	if err, ok := caughtErr.(error); ok {
-		c.AbortWithStatusJSON(http.StatusBadRequest, err.Error)
-		return
-	}
+// ErrorHandler recovers the panics of the handlers, the panic is logged with the request id and the client gets its reference
+func ErrorHandler(lgr logger.ILogger, l locale.ILocale) gin.RecoveryFunc {
+	return func(c *gin.Context, caughtErr any) {
+		err, ok := caughtErr.(error)
+		if !ok {
+			err = fmt.Errorf("%v", caughtErr)
+		}
 
-	c.AbortWithStatusJSON(
-		http.StatusInternalServerError,
-		caughtErr,
-	)
-	return
+		lgr.WithContext(c).Error("http.panic", zap.Error(err), zap.StackSkip("stack", 2))
+
+		meta.Resp(c, l).ServiceErr(meta.InternalErr(status.Internal, err)).Json()
+		c.Abort()
+	}
 }
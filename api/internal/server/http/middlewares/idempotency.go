@@ -0,0 +1,191 @@
+package middlewares
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"microservice/internal/adapter/locale"
+	"microservice/internal/core/domain"
+	"microservice/internal/core/port"
+	"microservice/internal/server/http/status"
+	"microservice/pkg/meta"
+	"microservice/pkg/reqctx"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	HeaderIdempotencyKey     = "Idempotency-Key"
+	HeaderIdempotentReplayed = "Idempotent-Replayed"
+
+	idempotencyKeyMaxLength = 255
+	// idempotencyPollInterval the concurrent duplicates check the first request with this interval
+	idempotencyPollInterval = 100 * time.Millisecond
+)
+
+var errInvalidIdempotencyKey = errors.New("invalid idempotency key")
+
+// Idempotency replays the stored response of the requests with the same `Idempotency-Key` header during the ttl.
+// the duplicates wait for the in-progress request up to the lock duration, a different request body is rejected.
+// only the successful responses are stored, the failed requests can be retried with the same key.
+// the body is buffered to be hashed, so it is limited by the max body before the limits of the handlers
+func Idempotency(repo port.IIdempotencyRepository, l locale.ILocale, ttl, lock time.Duration, maxBody int64) gin.HandlerFunc {
+	var lastPurge atomic.Int64
+
+	return func(c *gin.Context) {
+		key := c.GetHeader(HeaderIdempotencyKey)
+		if len(key) == 0 {
+			c.Next()
+			return
+		}
+
+		if len(key) > idempotencyKeyMaxLength {
+			meta.Resp(c, l).Status(status.Validate).Msg(meta.Locale(c, l).Get("invalid_idempotency_key")).Err(errInvalidIdempotencyKey).Json()
+			c.Abort()
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxBody))
+		var maxBytesErr *http.MaxBytesError
+		switch {
+		case errors.As(err, &maxBytesErr):
+			meta.Resp(c, l).Status(status.RequestTooLarge).Err(fmt.Errorf("at most %d bytes are allowed", maxBytesErr.Limit)).Json()
+			c.Abort()
+			return
+		case err != nil:
+			meta.Resp(c, l).Status(status.Failed).Json()
+			c.Abort()
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		now := time.Now()
+		purgeExpired(repo, &lastPurge, now, ttl)
+
+		ent := domain.NewIdempotencyKey()
+		ent.SetKey(key)
+		ent.SetScope(idempotencyScope(c))
+		ent.SetRequestHash(requestHash(c.Request.URL.RequestURI(), body))
+		ent.SetLockedUntil(now.Add(lock))
+		ent.SetExpiresAt(now.Add(ttl))
+
+		deadline := now.Add(lock)
+		for {
+			existing, acquired, acquireErr := repo.Acquire(c.Request.Context(), ent)
+			if acquireErr != nil {
+				meta.Resp(c, l).ServiceErr(acquireErr).Json()
+				c.Abort()
+				return
+			}
+
+			if acquired {
+				break
+			}
+
+			if existing != nil {
+				if existing.RequestHash() != ent.RequestHash() {
+					meta.Resp(c, l).Status(status.IdempotencyKeyReused).Json()
+					c.Abort()
+					return
+				}
+
+				if existing.Completed() {
+					replay(c, existing)
+					return
+				}
+			}
+
+			if time.Now().After(deadline) {
+				meta.Resp(c, l).Status(status.IdempotencyInProgress).Json()
+				c.Abort()
+				return
+			}
+
+			select {
+			case <-c.Request.Context().Done():
+				c.Abort()
+				return
+			case <-time.After(idempotencyPollInterval):
+			}
+		}
+
+		recorder := &responseRecorder{ResponseWriter: c.Writer}
+		c.Writer = recorder
+
+		c.Next()
+
+		// the response is already sent, the outcome is stored even if the client is gone
+		ctx := context.WithoutCancel(c.Request.Context())
+		if recorder.Status() < http.StatusOK || recorder.Status() >= http.StatusMultipleChoices {
+			_ = repo.Release(ctx, ent)
+			return
+		}
+
+		ent.SetStatusCode(recorder.Status())
+		ent.SetResponse(recorder.body.Bytes())
+		ent.SetETag(recorder.Header().Get(meta.HeaderETag))
+		ent.SetCompleted(true)
+		_ = repo.Complete(ctx, ent)
+	}
+}
+
+// HELPERS
+
+// responseRecorder keeps a copy of the response body to be stored
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseRecorder) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *responseRecorder) WriteString(data string) (int, error) {
+	w.body.WriteString(data)
+	return w.ResponseWriter.WriteString(data)
+}
+
+// idempotencyScope the actor, the method and the route of the request,
+// the same key sent by another user never replays the response stored for the first one
+func idempotencyScope(c *gin.Context) string {
+	return reqctx.Actor(c.Request.Context()) + " " + c.Request.Method + " " + c.FullPath()
+}
+
+// requestHash fingerprint of the target(with the route params and the query) and the body of the request
+func requestHash(uri string, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(uri))
+	hash.Write([]byte{'\n'})
+	hash.Write(body)
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func replay(c *gin.Context, ent *domain.IdempotencyKey) {
+	if len(ent.ETag()) > 0 {
+		c.Header(meta.HeaderETag, ent.ETag())
+	}
+
+	c.Header(HeaderIdempotentReplayed, "true")
+	c.Data(ent.StatusCode(), gin.MIMEJSON+"; charset=utf-8", ent.Response())
+	c.Abort()
+}
+
+// purgeExpired deletes the expired keys in the background, at most once per ttl
+func purgeExpired(repo port.IIdempotencyRepository, lastPurge *atomic.Int64, now time.Time, ttl time.Duration) {
+	last := lastPurge.Load()
+	if now.Sub(time.Unix(0, last)) < ttl || !lastPurge.CompareAndSwap(last, now.UnixNano()) {
+		return
+	}
+
+	go func() { _ = repo.DeleteExpired(context.Background(), now) }()
+}
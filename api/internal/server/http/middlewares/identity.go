@@ -0,0 +1,111 @@
+package middlewares
+
+import (
+	"microservice/pkg/reqctx"
+	"net/netip"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	HeaderUserID     = "X-User-ID"
+	HeaderUserGroups = "X-User-Groups" // comma separated ids of the groups of the user
+	HeaderRequestID  = "X-Request-ID"
+	HeaderUserLocale = "X-User-Locale" // the preferred language of the user, it overrides the Accept-Language
+
+	identityMaxLength = 255
+	groupsMaxCount    = 100
+)
+
+// Identity stores the caller with the groups of the headers in the request context.
+// NOTE: the user id and the groups are set by the gateway, they are trusted only when the request comes directly from one of the proxies,
+// the headers of the other peers are ignored and the request is anonymous. no proxy disables the headers,
+// until the JWT validation of CheckAuth is implemented
+func Identity(proxies []netip.Prefix) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		actor := c.GetHeader(HeaderUserID)
+		if len(actor) > 0 && len(actor) <= identityMaxLength && trustedPeer(c, proxies) {
+			ctx = reqctx.WithActor(ctx, actor)
+
+			if groups := parseGroups(c.GetHeader(HeaderUserGroups)); len(groups) > 0 {
+				ctx = reqctx.WithGroups(ctx, groups)
+			}
+		}
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
+
+// ParseProxies the comma separated IPs or CIDRs of the gateways, an IP is a single address range
+func ParseProxies(list string) ([]netip.Prefix, error) {
+	proxies := make([]netip.Prefix, 0)
+
+	for _, entry := range strings.Split(list, ",") {
+		entry = strings.TrimSpace(entry)
+		if len(entry) == 0 {
+			continue
+		}
+
+		if !strings.Contains(entry, "/") {
+			addr, err := netip.ParseAddr(entry)
+			if err != nil {
+				return nil, err
+			}
+
+			proxies = append(proxies, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(entry)
+		if err != nil {
+			return nil, err
+		}
+
+		proxies = append(proxies, prefix.Masked())
+	}
+
+	return proxies, nil
+}
+
+// trustedPeer the direct peer of the connection is one of the proxies, the forwarded-for headers are not considered
+func trustedPeer(c *gin.Context, proxies []netip.Prefix) bool {
+	if len(proxies) == 0 {
+		return false
+	}
+
+	addr, err := netip.ParseAddr(c.RemoteIP())
+	if err != nil {
+		return false
+	}
+
+	addr = addr.Unmap()
+	for _, proxy := range proxies {
+		if proxy.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseGroups the valid ids of the header, at most groupsMaxCount of them
+func parseGroups(header string) []string {
+	groups := make([]string, 0)
+
+	for _, group := range strings.Split(header, ",") {
+		group = strings.TrimSpace(group)
+		if len(group) == 0 || len(group) > identityMaxLength {
+			continue
+		}
+
+		if groups = append(groups, group); len(groups) == groupsMaxCount {
+			break
+		}
+	}
+
+	return groups
+}
@@ -0,0 +1,21 @@
+package middlewares
+
+import (
+	"microservice/internal/adapter/locale"
+	"microservice/pkg/reqctx"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Language negotiates the language of the response by the user preference or the Accept-Language header,
+// the language is stored in the request context and returned in the Content-Language header
+func Language(l locale.ILocale) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		lang := l.Match(c.GetHeader(HeaderUserLocale), c.GetHeader("Accept-Language"))
+
+		c.Request = c.Request.WithContext(reqctx.WithLanguage(c.Request.Context(), lang))
+		c.Header("Content-Language", lang)
+		c.Writer.Header().Add("Vary", "Accept-Language, "+HeaderUserLocale)
+		c.Next()
+	}
+}
@@ -0,0 +1,24 @@
+package middlewares
+
+import (
+	"microservice/internal/adapter/metrics"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Metrics observes the count and the latency of the requests by their route pattern and status
+func Metrics(m metrics.IMetrics) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		m.ObserveRequest(c.Request.Method, c.FullPath(), c.Writer.Status(), time.Since(start))
+	}
+}
+
+func MetricsAuth(username, password string) gin.HandlerFunc {
+	return gin.BasicAuth(gin.Accounts{
+		username: password,
+	})
+}
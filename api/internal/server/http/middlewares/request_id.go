@@ -0,0 +1,40 @@
+package middlewares
+
+import (
+	"microservice/pkg/reqctx"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const requestIDMaxLength = 255
+
+// RequestID propagates the X-Request-ID header of the caller or generates one, the id is stored in the request context
+// and returned in the response header, so the logs of the request are correlated across the services
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(HeaderRequestID)
+		if validRequestID(id) == false {
+			id = uuid.NewString()
+		}
+
+		c.Request = c.Request.WithContext(reqctx.WithRequestID(c.Request.Context(), id))
+		c.Header(HeaderRequestID, id)
+		c.Next()
+	}
+}
+
+// validRequestID the ids of the printable ASCII characters are propagated, so they are safe to log and to return
+func validRequestID(id string) bool {
+	if len(id) == 0 || len(id) > requestIDMaxLength {
+		return false
+	}
+
+	for i := 0; i < len(id); i++ {
+		if id[i] < 0x21 || id[i] > 0x7e {
+			return false
+		}
+	}
+
+	return true
+}
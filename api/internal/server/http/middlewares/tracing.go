@@ -0,0 +1,58 @@
+package middlewares
+
+import (
+	"fmt"
+	"microservice/internal/adapter/tracing"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName the instrumentation scope of the server spans
+const tracerName = "microservice/http"
+
+// Tracing starts the server span of the request as the child of the W3C traceparent header, when it is sent.
+// the span is named by the route pattern, so the names stay bounded.
+// the raw path is not recorded, as the params of the routes like the share and the feed tokens are secrets
+func Tracing(t tracing.ITracing) gin.HandlerFunc {
+	tracer := t.Tracer(tracerName)
+	propagator := t.Propagator()
+
+	return func(c *gin.Context) {
+		ctx := propagator.Extract(c.Request.Context(), propagation.HeaderCarrier(c.Request.Header))
+
+		route := c.FullPath()
+		name := fmt.Sprintf("%s %s", c.Request.Method, route)
+		if len(route) == 0 {
+			name = c.Request.Method
+		}
+
+		ctx, span := tracer.Start(ctx, name,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(c.Request.Method),
+				semconv.HTTPRouteKey.String(route),
+			),
+		)
+		defer span.End()
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+
+		code := c.Writer.Status()
+		span.SetAttributes(semconv.HTTPResponseStatusCodeKey.Int(code))
+
+		// NOTE: the client errors are not the errors of the server span
+		if code >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(code))
+		}
+
+		for _, err := range c.Errors {
+			span.RecordError(err.Err)
+		}
+	}
+}
@@ -1,7 +1,9 @@
 package http
 
 import (
+	"microservice/internal/server/http/middlewares"
 	"microservice/internal/server/http/routes"
+	"time"
 )
 
 func (s *Server) SetRoutes() {
@@ -12,17 +14,56 @@ func (s *Server) SetRoutes() {
 
 	{
 		router.GET("handshake", routes.Handshake)
+		routes.HealthRoutes(router, s.health, s.lgr)
+		routes.AdminRoutes(router, &s.admin, s.lgr, s.l)
 		routes.SwaggerRoute(router, &s.swagger)
+		routes.MetricsRoute(router, &s.metrics, s.collector)
+		routes.CalendarFeedRoute(router, s.handlers.TodoCalendarHandler)
 	}
 
+	idempotent := middlewares.Idempotency(
+		s.repositories.IdempotencyRepo, s.l, s.idempotencyTTL(), s.idempotencyLock(), s.idempotencyMaxBody(),
+	)
+
 	// routes groups
 
 	api := router.Group("api")
 	{
 		v1 := api.Group("/v1")
 		{
-			routes.TodoRoutes(v1, s.handlers.TodoHandler, s.l)
+			routes.TodoRoutes(v1, s.handlers.TodoHandler, s.l, idempotent)
+			routes.TodoCommentRoutes(v1, s.handlers.TodoCommentHandler, s.l, idempotent)
+			routes.TodoAttachmentRoutes(v1, s.handlers.TodoAttachmentHandler, s.l)
+			routes.TodoShareRoutes(v1, s.handlers.TodoShareHandler, s.l, idempotent)
+			routes.TodoCalendarRoutes(v1, s.handlers.TodoCalendarHandler, s.l, idempotent)
 			// NOTE: set other routes as above
 		}
 	}
 }
+
+// idempotencyTTL default: 24 hours
+func (s *Server) idempotencyTTL() time.Duration {
+	if s.config.IdempotencyTTL > 0 {
+		return s.config.IdempotencyTTL
+	}
+
+	return 24 * time.Hour
+}
+
+// idempotencyMaxBody default: 5 MiB, like the imported files
+func (s *Server) idempotencyMaxBody() int64 {
+	if s.config.IdempotencyMaxBody > 0 {
+		return s.config.IdempotencyMaxBody
+	}
+
+	return 5 << 20
+}
+
+// idempotencyLock the duplicates wait for the in-progress request as long as a request may take
+func (s *Server) idempotencyLock() time.Duration {
+	if s.config.WriteTimeout > 0 {
+		return s.config.WriteTimeout
+	}
+
+	return time.Minute
+}
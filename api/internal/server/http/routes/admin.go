@@ -0,0 +1,81 @@
+package routes
+
+import (
+	"microservice/config"
+	"microservice/internal/adapter/locale"
+	"microservice/internal/adapter/logger"
+	"microservice/internal/server/http/middlewares"
+	"microservice/internal/server/http/status"
+	"microservice/pkg/meta"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type logLevelRequest struct {
+	Level string `json:"level" binding:"required,oneof=debug info warn error" example:"debug"`
+}
+
+type logLevelResponse struct {
+	Level string `json:"level" example:"info"`
+}
+
+type adminHandler struct {
+	lgr logger.ILogger
+	l   locale.ILocale
+}
+
+// AdminRoutes the operational endpoints, they are disabled when the admin credentials are not set
+func AdminRoutes(r *gin.RouterGroup, conf *config.Admin, lgr logger.ILogger, l locale.ILocale) {
+	if len(conf.Username) == 0 {
+		return
+	}
+
+	handler := &adminHandler{lgr: lgr, l: l}
+
+	admin := r.Group("admin", middlewares.AdminAuth(conf.Username, conf.Password))
+	{
+		admin.GET("log-level", handler.GetLogLevel)
+		admin.PUT("log-level", handler.SetLogLevel)
+	}
+}
+
+// GetLogLevel godoc
+// @Summary Log Level
+// @Description The minimum enabled level of the logs
+// @Tags Admin
+// @Produce json
+// @Success 200 {object} meta.Response{data=logLevelResponse, error=nil} "success response"
+// @SetID GET-admin-log-level
+// @Router /admin/log-level [get]
+func (ah *adminHandler) GetLogLevel(ctx *gin.Context) {
+	meta.Resp(ctx, ah.l).Status(status.Success).Data(logLevelResponse{Level: ah.lgr.Level()}).Json()
+}
+
+// SetLogLevel godoc
+// @Summary Change Log Level
+// @Description Changes the minimum enabled level of the logs at runtime, it is reset by the restart
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param request body logLevelRequest true "the level"
+// @Success 200 {object} meta.Response{data=logLevelResponse, error=nil} "success response"
+// @Failure	400 {object} meta.Response{data=nil} "invalid level"
+// @SetID PUT-admin-log-level
+// @Router /admin/log-level [put]
+func (ah *adminHandler) SetLogLevel(ctx *gin.Context) {
+	var req logLevelRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		meta.Resp(ctx, ah.l).Status(status.Failed).Err(err).Json()
+		return
+	}
+
+	previous := ah.lgr.Level()
+	if err := ah.lgr.SetLevel(req.Level); err != nil {
+		meta.Resp(ctx, ah.l).Status(status.Failed).Err(err).Json()
+		return
+	}
+
+	ah.lgr.WithContext(ctx).Warn("admin.log_level", zap.String("from", previous), zap.String("to", req.Level))
+	meta.Resp(ctx, ah.l).Status(status.Success).Data(logLevelResponse{Level: ah.lgr.Level()}).Json()
+}
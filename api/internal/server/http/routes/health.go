@@ -0,0 +1,85 @@
+package routes
+
+import (
+	"microservice/internal/adapter/health"
+	"microservice/internal/adapter/logger"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type healthCheckResponse struct {
+	Name      string  `json:"name" example:"database"`
+	Status    string  `json:"status" example:"up"`
+	LatencyMs float64 `json:"latencyMs" example:"1.25"`
+}
+
+type healthResponse struct {
+	Status string                `json:"status" example:"up"` // up, down or draining
+	Checks []healthCheckResponse `json:"checks"`
+}
+
+type healthHandler struct {
+	lgr    logger.ILogger
+	health health.IHealth
+}
+
+func HealthRoutes(r *gin.RouterGroup, h health.IHealth, lgr logger.ILogger) {
+	handler := &healthHandler{lgr: lgr, health: h}
+
+	r.GET("healthz", handler.Live)
+	r.GET("readyz", handler.Ready)
+}
+
+// Live godoc
+// @Summary Liveness
+// @Description The process is alive, the dependencies are not checked
+// @Tags Health
+// @Produce json
+// @Success 200 {object} healthResponse
+// @SetID GET-healthz
+// @Router /healthz [get]
+func (hh *healthHandler) Live(ctx *gin.Context) {
+	hh.report(ctx, hh.health.Live())
+}
+
+// Ready godoc
+// @Summary Readiness
+// @Description The dependencies of the service are up: the database, its migrations and the storage. It fails during the graceful shutdown
+// @Tags Health
+// @Produce json
+// @Success 200 {object} healthResponse
+// @Failure 503 {object} healthResponse
+// @SetID GET-readyz
+// @Router /readyz [get]
+func (hh *healthHandler) Ready(ctx *gin.Context) {
+	hh.report(ctx, hh.health.Ready(ctx))
+}
+
+// report the endpoints are public, so the errors of the checks are logged with the request id instead of being returned
+func (hh *healthHandler) report(ctx *gin.Context, src *health.Report) {
+	resp := healthResponse{Status: src.Status, Checks: make([]healthCheckResponse, 0, len(src.Checks))}
+
+	for _, result := range src.Checks {
+		check := healthCheckResponse{
+			Name:      result.Name,
+			Status:    result.Status,
+			LatencyMs: float64(result.Latency.Microseconds()) / 1000,
+		}
+
+		if result.Err != nil {
+			hh.lgr.Error("health.check", zap.String("check", result.Name), zap.Error(result.Err), logger.Context(ctx))
+		}
+
+		resp.Checks = append(resp.Checks, check)
+	}
+
+	code := http.StatusOK
+	if src.Status != health.StatusUp {
+		code = http.StatusServiceUnavailable
+	}
+
+	ctx.Header("Cache-Control", "no-store")
+	ctx.JSON(code, resp)
+}
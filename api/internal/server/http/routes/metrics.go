@@ -0,0 +1,30 @@
+package routes
+
+import (
+	"microservice/config"
+	"microservice/internal/adapter/metrics"
+	"microservice/internal/server/http/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+// defaultMetricsPath the path of the metrics, when the config is not set
+const defaultMetricsPath = "metrics"
+
+func MetricsRoute(r *gin.RouterGroup, conf *config.Metrics, m metrics.IMetrics) {
+	if conf.Enable == false {
+		return
+	}
+
+	path := conf.Path
+	if len(path) == 0 {
+		path = defaultMetricsPath
+	}
+
+	handlers := []gin.HandlerFunc{gin.WrapH(m.Handler())}
+	if len(conf.Username) > 0 {
+		handlers = append([]gin.HandlerFunc{middlewares.MetricsAuth(conf.Username, conf.Password)}, handlers...)
+	}
+
+	r.GET(path, handlers...)
+}
@@ -7,9 +7,20 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-func TodoRoutes(r *gin.RouterGroup, h delivery.ITodoHandler, l locale.ILocale) {
+func TodoRoutes(r *gin.RouterGroup, h delivery.ITodoHandler, l locale.ILocale, idempotent gin.HandlerFunc) {
 	todo := r.Group("/todo") //.Use(middlewares.CheckAuth(l))
-	todo.POST("/create", h.Create)
+	todo.POST("/create", idempotent, h.Create)
 	todo.GET("/:uuid", h.GetDetails)
 	todo.GET("/list", h.GetList)
+	todo.GET("/workload", h.Workload)
+	todo.GET("/export", h.Export)
+	todo.POST("/import", idempotent, h.Import)
+	todo.POST("/bulk", idempotent, h.Bulk)
+	todo.PUT("/:uuid", h.Update)
+	todo.DELETE("/:uuid", h.Delete)
+	todo.POST("/:uuid/restore", h.Restore)
+	todo.GET("/:uuid/history", h.History)
+	todo.POST("/:uuid/history/:revision/revert", h.Revert)
+	todo.PUT("/:uuid/assignees", h.Assign)
+	todo.POST("/:uuid/move", h.Move)
 }
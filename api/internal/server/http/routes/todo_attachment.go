@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"microservice/internal/adapter/locale"
+	"microservice/internal/driver/delivery"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TodoAttachmentRoutes(r *gin.RouterGroup, h delivery.ITodoAttachmentHandler, l locale.ILocale) {
+	todo := r.Group("/todo/:uuid") //.Use(middlewares.CheckAuth(l))
+	// NOTE: the uploads are not idempotent, the idempotency middleware buffers the whole body to hash it
+	todo.POST("/attachments", h.Upload)
+	todo.GET("/attachments", h.GetList)
+	todo.GET("/attachments/:attachment", h.Download)
+	todo.DELETE("/attachments/:attachment", h.Delete)
+}
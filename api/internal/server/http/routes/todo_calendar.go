@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"microservice/internal/adapter/locale"
+	"microservice/internal/driver/delivery"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TodoCalendarRoutes(r *gin.RouterGroup, h delivery.ITodoCalendarHandler, l locale.ILocale, idempotent gin.HandlerFunc) {
+	calendar := r.Group("/todo/calendar") //.Use(middlewares.CheckAuth(l))
+	calendar.POST("/feeds", idempotent, h.CreateFeed)
+	calendar.GET("/feeds", h.GetFeeds)
+	calendar.DELETE("/feeds/:feed", h.RevokeFeed)
+}
+
+// CalendarFeedRoute public, the token of the file grants the read-only access
+func CalendarFeedRoute(r *gin.RouterGroup, h delivery.ITodoCalendarHandler) {
+	r.GET("ical/:file", h.Feed)
+}
@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"microservice/internal/adapter/locale"
+	"microservice/internal/driver/delivery"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TodoCommentRoutes(r *gin.RouterGroup, h delivery.ITodoCommentHandler, l locale.ILocale, idempotent gin.HandlerFunc) {
+	todo := r.Group("/todo/:uuid") //.Use(middlewares.CheckAuth(l))
+	todo.POST("/comments", idempotent, h.Create)
+	todo.GET("/comments", h.GetList)
+	todo.PUT("/comments/:comment", h.Update)
+	todo.DELETE("/comments/:comment", h.Delete)
+	todo.GET("/activity", h.Activity)
+}
@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"microservice/internal/adapter/locale"
+	"microservice/internal/driver/delivery"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TodoShareRoutes(r *gin.RouterGroup, h delivery.ITodoShareHandler, l locale.ILocale, idempotent gin.HandlerFunc) {
+	r.GET("/todo/shared", h.SharedWithMe)
+	r.GET("/shared/:token", h.ByLink) // public, the token grants the read-only access
+
+	todo := r.Group("/todo/:uuid") //.Use(middlewares.CheckAuth(l))
+	todo.PUT("/shares", h.Grant)
+	todo.GET("/shares", h.GetList)
+	todo.DELETE("/shares/:share", h.Revoke)
+	todo.POST("/share-links", idempotent, h.CreateLink)
+	todo.GET("/share-links", h.GetLinks)
+	todo.DELETE("/share-links/:link", h.RevokeLink)
+}
@@ -9,19 +9,33 @@ import (
 	"log"
 	"microservice/app"
 	"microservice/config"
+	"microservice/internal/adapter/health"
 	"microservice/internal/adapter/locale"
+	"microservice/internal/adapter/logger"
+	"microservice/internal/adapter/metrics"
 	"microservice/internal/adapter/registry"
+	"microservice/internal/adapter/tracing"
 	"microservice/internal/server/http/middlewares"
 	_ "microservice/pkg/validator"
 	"net/http"
+	"net/netip"
 	"os"
+	"time"
 )
 
 type Server struct {
+	lgr          logger.ILogger
 	l            locale.ILocale
 	service      config.Service
 	swagger      config.Swagger
+	metrics      config.Metrics
+	healthConfig config.Health
+	admin        config.Admin
+	identity     config.Identity
 	config       config.Http
+	collector    metrics.IMetrics
+	tracing      tracing.ITracing
+	health       health.IHealth
 	repositories *app.Repositories
 	handlers     *app.HttpHandlers
 	engine       *gin.Engine
@@ -30,23 +44,38 @@ type Server struct {
 
 func New(
 	registry registry.IRegistry,
+	lgr logger.ILogger,
 	locale locale.ILocale,
+	collector metrics.IMetrics,
+	tracer tracing.ITracing,
+	checks health.IHealth,
 	repositories *app.Repositories,
 	handlers *app.HttpHandlers,
 ) IHttpServer {
 	server := new(Server)
 	registry.Parse(&server.service)
 	registry.Parse(&server.swagger)
+	registry.Parse(&server.metrics)
+	registry.Parse(&server.healthConfig)
+	registry.Parse(&server.admin)
+	registry.Parse(&server.identity)
 	registry.Parse(&server.config)
 
 	if server.service.Debug == false {
 		server.swagger.Host = os.Getenv("SWAGGER_HOST")
 	}
 
+	server.lgr = lgr
 	server.l = locale
+	server.collector = collector
+	server.tracing = tracer
+	server.health = checks
 	server.handlers = handlers
 	server.repositories = repositories
-	server.engine = gin.Default()
+	// NOTE: the default logger of gin prints the raw paths with the share and the feed tokens, the requests are logged by AccessLog
+	server.engine = gin.New()
+	// the handlers pass the gin context to the lower layers, so the values of the request context are visible
+	server.engine.ContextWithFallback = true
 
 	return server
 }
@@ -61,6 +90,13 @@ func (s *Server) Init() {
 		log.Panicf("[http] set trusted proxy failed: %s\n", err.Error())
 	}
 
+	var proxies []netip.Prefix
+	if s.identity.TrustHeaders {
+		if proxies, err = middlewares.ParseProxies(s.identity.Proxies); err != nil {
+			log.Panicf("[http] parse identity proxies failed: %s\n", err.Error())
+		}
+	}
+
 	if s.service.Debug == false {
 		gin.SetMode(gin.ReleaseMode)
 		gin.DefaultWriter = io.Discard
@@ -69,34 +105,62 @@ func (s *Server) Init() {
 	}
 
 	s.engine.Use(
-		//gin.Logger(),
-		gin.Recovery(), middlewares.Cors(),
-		gin.CustomRecovery(middlewares.ErrorHandler),
+		gin.Recovery(),
+		middlewares.RequestID(), middlewares.AccessLog(s.lgr),
+		middlewares.Cors(), middlewares.Language(s.l),
+		gin.CustomRecovery(middlewares.ErrorHandler(s.lgr, s.l)),
+		middlewares.Identity(proxies),
+		middlewares.Tracing(s.tracing),
 	)
+
+	if s.metrics.Enable {
+		s.engine.Use(middlewares.Metrics(s.collector))
+	}
 }
 
 func (s *Server) Start() {
 	s.server = &http.Server{
-		Addr:    fmt.Sprintf("%s:%s", s.config.Host, s.config.Port),
-		Handler: s.engine,
+		Addr:         fmt.Sprintf("%s:%s", s.config.Host, s.config.Port),
+		Handler:      s.engine,
+		ReadTimeout:  s.config.ReadTimeout,
+		WriteTimeout: s.config.WriteTimeout,
 	}
 
-	fmt.Printf("\n[http] started on port %s\n", s.config.Port)
+	fmt.Printf("\n[http] started on port %s, tls: %t\n", s.config.Port, s.config.Tls)
 
 	go func() {
-		if err := s.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		var err error
+		if s.config.Tls {
+			err = s.server.ListenAndServeTLS(s.config.TlsCert, s.config.TlsKey)
+		} else {
+			err = s.server.ListenAndServe()
+		}
+
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
 			log.Panicf("[http] failed to start usecase: %s\n", err.Error())
 		}
 	}()
 }
 
 func (s *Server) Stop(ctx context.Context) {
+	// the readiness fails first, so the load balancers drain the traffic while the in-flight requests are served
+	s.health.Drain()
+
 	if s.service.Debug == true {
 		_ = s.server.Close()
 		log.Printf("[http] server stopped succesfully")
 		return
 	}
 
+	if s.healthConfig.DrainDelay > 0 {
+		log.Printf("[http] draining for %s", s.healthConfig.DrainDelay)
+
+		select {
+		case <-time.After(s.healthConfig.DrainDelay):
+		case <-ctx.Done():
+		}
+	}
+
 	shutdownErr := make(chan error)
 	go func() { shutdownErr <- s.server.Shutdown(ctx) }()
 
@@ -12,4 +12,25 @@ var MappedStatuses = map[HttpMappedStatus]int{
 	Unauthorized: http.StatusUnauthorized,
 	Conflict:     http.StatusConflict,
 	ItemExist:    http.StatusConflict,
+	Deleted:      http.StatusOK,
+	NotModified:  http.StatusNotModified,
+
+	PreconditionFailed:       http.StatusPreconditionFailed,
+	IdempotencyKeyReused:     http.StatusUnprocessableEntity,
+	IdempotencyInProgress:    http.StatusConflict,
+	BulkPartial:              http.StatusMultiStatus,
+	BulkRolledBack:           http.StatusFailedDependency,
+	RevisionNotRevertible:    http.StatusUnprocessableEntity,
+	NotDeleted:               http.StatusConflict,
+	NotCommentAuthor:         http.StatusForbidden,
+	InvalidParentComment:     http.StatusUnprocessableEntity,
+	AttachmentTooLarge:       http.StatusRequestEntityTooLarge,
+	AttachmentTypeNotAllowed: http.StatusUnsupportedMediaType,
+	Forbidden:                http.StatusForbidden,
+	ShareLinkExpired:         http.StatusGone,
+	InvalidGrantee:           http.StatusUnprocessableEntity,
+	InvalidMove:              http.StatusConflict,
+	ImportTooLarge:           http.StatusRequestEntityTooLarge,
+	RequestTooLarge:          http.StatusRequestEntityTooLarge,
+	Internal:                 http.StatusInternalServerError,
 }
@@ -14,4 +14,42 @@ const (
 	Unauthorized HttpMappedStatus = "unauthorized"
 	Conflict     HttpMappedStatus = "conflict"
 	ItemExist    HttpMappedStatus = "item_exist"
+	Deleted      HttpMappedStatus = "delete_done"
+	NotModified  HttpMappedStatus = "not_modified"
+	// PreconditionFailed the `If-Match` version is outdated
+	PreconditionFailed HttpMappedStatus = "precondition_failed"
+	// IdempotencyKeyReused the `Idempotency-Key` is reused with a different request
+	IdempotencyKeyReused HttpMappedStatus = "idempotency_key_reused"
+	// IdempotencyInProgress the first request of the `Idempotency-Key` is still in progress
+	IdempotencyInProgress HttpMappedStatus = "idempotency_in_progress"
+	// BulkPartial some operations of the best-effort bulk request are failed
+	BulkPartial HttpMappedStatus = "bulk_partial"
+	// BulkRolledBack the operation is not applied, as another operation of the atomic bulk request is failed
+	BulkRolledBack HttpMappedStatus = "bulk_rolled_back"
+	// NotDeleted the restored item is not deleted
+	NotDeleted HttpMappedStatus = "not_deleted"
+	// RevisionNotRevertible the revision of a delete has no state to be reverted to
+	RevisionNotRevertible HttpMappedStatus = "revision_not_revertible"
+	// NotCommentAuthor the comment is edited or deleted by another user than its author
+	NotCommentAuthor HttpMappedStatus = "not_comment_author"
+	// InvalidParentComment the replied comment is not a comment of the item
+	InvalidParentComment HttpMappedStatus = "invalid_parent_comment"
+	// AttachmentTooLarge the uploaded file exceeds the size limit
+	AttachmentTooLarge HttpMappedStatus = "attachment_too_large"
+	// AttachmentTypeNotAllowed the sniffed MIME type of the uploaded file is not allowed
+	AttachmentTypeNotAllowed HttpMappedStatus = "attachment_type_not_allowed"
+	// Forbidden the actor has no permission for the operation on the readable item
+	Forbidden HttpMappedStatus = "forbidden"
+	// ShareLinkExpired the share-link is expired
+	ShareLinkExpired HttpMappedStatus = "share_link_expired"
+	// InvalidGrantee the permission is granted to the owner of the item
+	InvalidGrantee HttpMappedStatus = "invalid_grantee"
+	// InvalidMove the neighbours of the moved item are not in the target column, or not in order
+	InvalidMove HttpMappedStatus = "invalid_move"
+	// RequestTooLarge the body of the idempotent request exceeds the size limit, it is buffered to be hashed
+	RequestTooLarge HttpMappedStatus = "request_too_large"
+	// ImportTooLarge the imported file exceeds the size or the row limit
+	ImportTooLarge HttpMappedStatus = "import_too_large"
+	// Internal the unexpected failure of the server, like a panic
+	Internal HttpMappedStatus = "internal_error"
 )
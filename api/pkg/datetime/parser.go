@@ -0,0 +1,243 @@
+package datetime
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrUnrecognized = errors.New("unrecognized date-time expression")
+
+// Value the parsed date-time, the all-day values are the start of the day in the location
+type Value struct {
+	Time   time.Time
+	AllDay bool
+}
+
+// layouts of the absolute values without the zone offset, they are parsed in the given location
+var (
+	dateTimeLayouts = []string{
+		"2006-01-02T15:04:05",
+		"2006-01-02T15:04",
+		time.DateTime,
+		"2006-01-02 15:04",
+	}
+
+	weekdays = map[string]time.Weekday{
+		"sunday": time.Sunday, "monday": time.Monday, "tuesday": time.Tuesday, "wednesday": time.Wednesday,
+		"thursday": time.Thursday, "friday": time.Friday, "saturday": time.Saturday,
+		"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday,
+		"thu": time.Thursday, "fri": time.Friday, "sat": time.Saturday,
+	}
+
+	// clockExp like "9am", "9:30 pm", "17:30"
+	clockExp = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?\s*(am|pm)?$`)
+	// offsetExp like "in 3 days", "in 2 hours"
+	offsetExp = regexp.MustCompile(`^in (\d+) (minute|hour|day|week|month)s?$`)
+)
+
+// Parse parses the due date expressions:
+//   - RFC3339: "2025-08-07T10:11:12+02:00"
+//   - date-time in the location: "2025-08-07 10:11:12", "2025-08-07T10:11"
+//   - date-only(all-day): "2025-08-07"
+//   - relative to now: "today", "tomorrow 9am", "next friday", "friday at 17:30", "next week", "in 3 days"
+//
+// a trailing IANA zone overrides the location, like "2025-08-07 10:00 Europe/Berlin" or "tomorrow 9am Asia/Tokyo"
+func Parse(input string, location *time.Location, now time.Time) (value Value, err error) {
+	input = strings.Join(strings.Fields(input), " ")
+	if len(input) == 0 {
+		err = ErrUnrecognized
+		return
+	}
+
+	if location == nil {
+		location = time.Local
+	}
+
+	input, location = splitZone(input, location)
+	now = now.In(location)
+
+	if t, parseErr := time.Parse(time.RFC3339, input); parseErr == nil {
+		value.Time = t
+		return
+	}
+
+	for _, layout := range dateTimeLayouts {
+		if t, parseErr := time.ParseInLocation(layout, input, location); parseErr == nil {
+			value.Time = t
+			return
+		}
+	}
+
+	if t, parseErr := time.ParseInLocation(time.DateOnly, input, location); parseErr == nil {
+		value.Time = t
+		value.AllDay = true
+		return
+	}
+
+	return parseRelative(strings.ToLower(input), now)
+}
+
+// HELPERS
+
+// splitZone separates the trailing IANA zone of the input
+func splitZone(input string, location *time.Location) (string, *time.Location) {
+	idx := strings.LastIndex(input, " ")
+	if idx < 0 {
+		return input, location
+	}
+
+	name := input[idx+1:]
+	if !strings.Contains(name, "/") && name != "UTC" {
+		return input, location
+	}
+
+	zone, err := time.LoadLocation(name)
+	if err != nil {
+		return input, location
+	}
+
+	return input[:idx], zone
+}
+
+func parseRelative(input string, now time.Time) (value Value, err error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	if match := offsetExp.FindStringSubmatch(input); match != nil {
+		count, _ := strconv.Atoi(match[1])
+
+		switch match[2] {
+		case "minute":
+			value.Time = now.Add(time.Duration(count) * time.Minute)
+		case "hour":
+			value.Time = now.Add(time.Duration(count) * time.Hour)
+		case "day":
+			value.Time, value.AllDay = today.AddDate(0, 0, count), true
+		case "week":
+			value.Time, value.AllDay = today.AddDate(0, 0, 7*count), true
+		case "month":
+			value.Time, value.AllDay = today.AddDate(0, count, 0), true
+		}
+
+		return
+	}
+
+	day, clock, found := strings.Cut(input, " at ")
+	if !found {
+		day, clock = splitClock(input)
+	}
+
+	date, ok := parseDay(day, today)
+	if !ok {
+		err = ErrUnrecognized
+		return
+	}
+
+	if len(clock) == 0 {
+		value.Time, value.AllDay = date, true
+		return
+	}
+
+	hour, minute, ok := parseClock(clock)
+	if !ok {
+		err = ErrUnrecognized
+		return
+	}
+
+	value.Time = time.Date(date.Year(), date.Month(), date.Day(), hour, minute, 0, 0, date.Location())
+	return
+}
+
+// splitClock separates the trailing clock of the input, like "tomorrow 9am"
+func splitClock(input string) (day, clock string) {
+	if _, _, ok := parseClock(input); ok {
+		return "today", input
+	}
+
+	fields := strings.Fields(input)
+	for i := len(fields) - 1; i > 0; i-- {
+		if _, _, ok := parseClock(strings.Join(fields[i:], " ")); ok {
+			return strings.Join(fields[:i], " "), strings.Join(fields[i:], " ")
+		}
+	}
+
+	return input, ""
+}
+
+func parseDay(day string, today time.Time) (time.Time, bool) {
+	switch day {
+	case "today":
+		return today, true
+	case "tomorrow":
+		return today.AddDate(0, 0, 1), true
+	case "next week":
+		// the Monday of the next week
+		return today.AddDate(0, 0, 7-((int(today.Weekday())+6)%7)), true
+	case "next month":
+		return time.Date(today.Year(), today.Month()+1, 1, 0, 0, 0, 0, today.Location()), true
+	}
+
+	name, next := strings.CutPrefix(day, "next ")
+	name = strings.TrimPrefix(name, "this ")
+
+	weekday, ok := weekdays[name]
+	if !ok {
+		return time.Time{}, false
+	}
+
+	// the upcoming weekday, the "next" one is never today
+	days := (int(weekday) - int(today.Weekday()) + 7) % 7
+	if days == 0 && next {
+		days = 7
+	}
+
+	return today.AddDate(0, 0, days), true
+}
+
+func parseClock(clock string) (hour, minute int, ok bool) {
+	switch clock {
+	case "noon":
+		return 12, 0, true
+	case "midnight":
+		return 0, 0, true
+	}
+
+	match := clockExp.FindStringSubmatch(clock)
+	if match == nil {
+		return
+	}
+
+	hour, _ = strconv.Atoi(match[1])
+	if len(match[2]) > 0 {
+		minute, _ = strconv.Atoi(match[2])
+	}
+
+	switch match[3] {
+	case "am":
+		if hour < 1 || hour > 12 {
+			return
+		}
+
+		if hour == 12 {
+			hour = 0
+		}
+	case "pm":
+		if hour < 1 || hour > 12 {
+			return
+		}
+
+		if hour != 12 {
+			hour += 12
+		}
+	default:
+		// 24-hour clock needs the minutes, a bare number is ambiguous
+		if len(match[2]) == 0 {
+			return
+		}
+	}
+
+	ok = hour < 24 && minute < 60
+	return
+}
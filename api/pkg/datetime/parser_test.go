@@ -0,0 +1,53 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	now := time.Date(2025, 8, 6, 15, 4, 5, 0, berlin) // Wednesday
+
+	cases := []struct {
+		input  string
+		time   time.Time
+		allDay bool
+	}{
+		{"2025-08-07T10:11:12Z", time.Date(2025, 8, 7, 10, 11, 12, 0, time.UTC), false},
+		{"2025-08-07 10:11:12", time.Date(2025, 8, 7, 10, 11, 12, 0, berlin), false},
+		{"2025-08-07T10:11", time.Date(2025, 8, 7, 10, 11, 0, 0, berlin), false},
+		{"2025-08-07", time.Date(2025, 8, 7, 0, 0, 0, 0, berlin), true},
+		{"2025-08-07 10:00 Asia/Tokyo", time.Date(2025, 8, 7, 10, 0, 0, 0, tokyo), false},
+		{"today", time.Date(2025, 8, 6, 0, 0, 0, 0, berlin), true},
+		{"Tomorrow 9am", time.Date(2025, 8, 7, 9, 0, 0, 0, berlin), false},
+		{"tomorrow at 17:30", time.Date(2025, 8, 7, 17, 30, 0, 0, berlin), false},
+		{"friday", time.Date(2025, 8, 8, 0, 0, 0, 0, berlin), true},
+		{"next wednesday", time.Date(2025, 8, 13, 0, 0, 0, 0, berlin), true},
+		{"next friday 12pm", time.Date(2025, 8, 8, 12, 0, 0, 0, berlin), false},
+		{"next week", time.Date(2025, 8, 11, 0, 0, 0, 0, berlin), true},
+		{"in 2 hours", now.Add(2 * time.Hour), false},
+		{"in 3 days", time.Date(2025, 8, 9, 0, 0, 0, 0, berlin), true},
+		{"noon", time.Date(2025, 8, 6, 12, 0, 0, 0, berlin), false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.input, func(t *testing.T) {
+			value, err := Parse(c.input, berlin, now)
+
+			assert.NoError(t, err)
+			assert.True(t, c.time.Equal(value.Time), "expected %s, got %s", c.time, value.Time)
+			assert.Equal(t, c.allDay, value.AllDay)
+		})
+	}
+
+	for _, input := range []string{"", "someday", "2025-13-07", "tomorrow 25:00", "13pm", "next year"} {
+		t.Run("invalid "+input, func(t *testing.T) {
+			_, err := Parse(input, berlin, now)
+			assert.ErrorIs(t, err, ErrUnrecognized)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package fracindex
+
+import (
+	"errors"
+	"strings"
+)
+
+// Digits of the keys in the ascending byte order, so the keys are ordered by the plain string comparison.
+// NOTE: the database columns of the keys have to be compared bytewise, like the `C` collation of PostgreSQL
+const Digits = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+var (
+	ErrInvalidKey   = errors.New("fracindex: invalid key")
+	ErrInvalidRange = errors.New("fracindex: the lower key is not less than the upper key")
+)
+
+// Between a key greater than the lower and less than the upper key, the empty keys are unbounded.
+// the keys never end with the smallest digit, so there is always a key between two distinct keys
+func Between(lower, upper string) (string, error) {
+	if !valid(lower) || !valid(upper) {
+		return "", ErrInvalidKey
+	}
+
+	if len(upper) > 0 && lower >= upper {
+		return "", ErrInvalidRange
+	}
+
+	return midpoint(lower, upper), nil
+}
+
+// Spread n keys of the same length in the ascending order, evenly spaced so the later inserts stay short
+func Spread(n int) []string {
+	keys := make([]string, 0, n)
+	if n <= 0 {
+		return keys
+	}
+
+	base := len(Digits)
+
+	// the space of the keys is at least twice of the count, so no key ends with the smallest digit
+	width, space := 1, base
+	for space < 2*(n+1) {
+		width++
+		space *= base
+	}
+
+	step := space / (n + 1)
+	for i := 1; i <= n; i++ {
+		value := i * step
+		if value%base == 0 {
+			value++ // the step is at least 2, the next key is still greater
+		}
+
+		keys = append(keys, encode(value, width))
+	}
+
+	return keys
+}
+
+// HELPERS
+
+// midpoint of the keys, the upper key is unbounded when it is empty
+func midpoint(lower, upper string) string {
+	if len(upper) > 0 {
+		// the common prefix, the missing digits of the lower key are the smallest digit
+		n := 0
+		for n < len(upper) && digitAt(lower, n) == upper[n] {
+			n++
+		}
+
+		if n > 0 {
+			return upper[:n] + midpoint(suffix(lower, n), upper[n:])
+		}
+	}
+
+	digitLower := 0
+	if len(lower) > 0 {
+		digitLower = strings.IndexByte(Digits, lower[0])
+	}
+
+	digitUpper := len(Digits)
+	if len(upper) > 0 {
+		digitUpper = strings.IndexByte(Digits, upper[0])
+	}
+
+	if digitUpper-digitLower > 1 {
+		return string(Digits[(digitLower+digitUpper+1)/2])
+	}
+
+	// the first digits are consecutive
+	if len(upper) > 1 {
+		return upper[:1]
+	}
+
+	return string(Digits[digitLower]) + midpoint(suffix(lower, 1), "")
+}
+
+func digitAt(key string, i int) byte {
+	if i < len(key) {
+		return key[i]
+	}
+
+	return Digits[0]
+}
+
+func suffix(key string, i int) string {
+	if i < len(key) {
+		return key[i:]
+	}
+
+	return ""
+}
+
+func valid(key string) bool {
+	for i := 0; i < len(key); i++ {
+		if strings.IndexByte(Digits, key[i]) < 0 {
+			return false
+		}
+	}
+
+	return len(key) == 0 || key[len(key)-1] != Digits[0]
+}
+
+// encode the value in the digits, left padded to the width
+func encode(value, width int) string {
+	key := make([]byte, width)
+	for i := width - 1; i >= 0; i-- {
+		key[i] = Digits[value%len(Digits)]
+		value /= len(Digits)
+	}
+
+	return string(key)
+}
@@ -0,0 +1,70 @@
+package fracindex
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBetween(t *testing.T) {
+	t.Run("the key is between the bounds", func(t *testing.T) {
+		cases := [][2]string{{"", ""}, {"", "V"}, {"V", ""}, {"V", "W"}, {"V", "V1"}, {"V01", "V1"}, {"Vz", "W"}, {"1", "2"}}
+
+		for _, c := range cases {
+			key, err := Between(c[0], c[1])
+
+			assert.NoError(t, err, c)
+			assert.Less(t, c[0], key, c)
+			if len(c[1]) > 0 {
+				assert.Less(t, key, c[1], c)
+			}
+		}
+	})
+
+	t.Run("the repeated inserts at the same place", func(t *testing.T) {
+		lower, upper := "", "V"
+
+		for i := 0; i < 100; i++ {
+			key, err := Between(lower, upper)
+			assert.NoError(t, err)
+			assert.True(t, lower < key && key < upper)
+
+			upper = key
+		}
+
+		assert.LessOrEqual(t, len(upper), 20)
+	})
+
+	t.Run("the invalid keys and range", func(t *testing.T) {
+		_, err := Between("W", "V")
+		assert.ErrorIs(t, err, ErrInvalidRange)
+
+		_, err = Between("V", "V")
+		assert.ErrorIs(t, err, ErrInvalidRange)
+
+		_, err = Between("V0", "")
+		assert.ErrorIs(t, err, ErrInvalidKey)
+
+		_, err = Between("", "a-b")
+		assert.ErrorIs(t, err, ErrInvalidKey)
+	})
+}
+
+func TestSpread(t *testing.T) {
+	for _, n := range []int{1, 30, 61, 62, 1000} {
+		keys := Spread(n)
+
+		assert.Len(t, keys, n)
+		assert.True(t, sort.StringsAreSorted(keys))
+
+		for i, key := range keys {
+			assert.NotEqual(t, Digits[0], key[len(key)-1], key)
+			assert.Len(t, key, len(keys[0]))
+
+			if i > 0 {
+				assert.NotEqual(t, keys[i-1], key)
+			}
+		}
+	}
+}
@@ -0,0 +1,205 @@
+package ical
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strings"
+)
+
+// maxLineLength bytes of an unfolded content line
+const maxLineLength = 1 << 20
+
+// Decoder reads the components of the calendar one by one, so the large calendars are not held in memory
+type Decoder struct {
+	s       *bufio.Scanner
+	line    int    // the line of the last scanned physical line
+	pending string // the scanned physical line of the next content line
+	started int
+	more    bool
+}
+
+func NewDecoder(r io.Reader) *Decoder {
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 0, 64*1024), maxLineLength)
+
+	return &Decoder{s: s}
+}
+
+// Next the next component of the calendar(like VTODO, VEVENT or VTIMEZONE) with its sub-components, io.EOF at the end
+func (d *Decoder) Next() (*Component, error) {
+	for {
+		line, p, err := d.property()
+		if err != nil {
+			return nil, err
+		}
+
+		if !strings.EqualFold(p.Name, "BEGIN") || strings.EqualFold(p.Value, "VCALENDAR") {
+			continue // the calendar itself and its properties
+		}
+
+		return d.component(line, strings.ToUpper(p.Value))
+	}
+}
+
+// component reads the properties and the sub-components until the END of the component
+func (d *Decoder) component(line int, name string) (*Component, error) {
+	c := &Component{Name: name, Line: line}
+
+	for {
+		propLine, p, err := d.property()
+		if errors.Is(err, io.EOF) {
+			return nil, &SyntaxError{Line: line, Msg: "the " + name + " is not closed"}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case strings.EqualFold(p.Name, "END"):
+			if !strings.EqualFold(p.Value, name) {
+				return nil, &SyntaxError{Line: propLine, Msg: "unexpected END:" + p.Value}
+			}
+
+			return c, nil
+		case strings.EqualFold(p.Name, "BEGIN"):
+			sub, subErr := d.component(propLine, strings.ToUpper(p.Value))
+			if subErr != nil {
+				return nil, subErr
+			}
+
+			c.Components = append(c.Components, sub)
+		default:
+			c.Properties = append(c.Properties, p)
+		}
+	}
+}
+
+// property the next unfolded content line with its line, the blank lines are skipped
+func (d *Decoder) property() (int, *Property, error) {
+	for {
+		content, line, err := d.unfold()
+		if err != nil {
+			return 0, nil, err
+		}
+
+		if len(strings.TrimSpace(content)) == 0 {
+			continue
+		}
+
+		p, ok := parse(content)
+		if !ok {
+			return 0, nil, &SyntaxError{Line: line, Msg: "malformed content line"}
+		}
+
+		return line, p, nil
+	}
+}
+
+// unfold joins the physical lines of the content line, the continuation lines start with a space or a tab
+func (d *Decoder) unfold() (content string, line int, err error) {
+	if !d.more {
+		if !d.scan() {
+			return "", 0, d.err()
+		}
+	}
+
+	content, line = d.pending, d.started
+	d.more = false
+
+	for d.scan() {
+		if next := d.pending; len(next) > 0 && (next[0] == ' ' || next[0] == '\t') {
+			content += next[1:]
+			continue
+		}
+
+		d.more = true // the scanned line starts the next content line
+		break
+	}
+
+	if err = d.s.Err(); err != nil {
+		return "", 0, d.err()
+	}
+
+	return content, line, nil
+}
+
+func (d *Decoder) scan() bool {
+	if !d.s.Scan() {
+		return false
+	}
+
+	d.line++
+	d.pending = strings.TrimSuffix(d.s.Text(), "\r")
+	if len(d.pending) == 0 || (d.pending[0] != ' ' && d.pending[0] != '\t') {
+		d.started = d.line
+	}
+
+	return true
+}
+
+func (d *Decoder) err() error {
+	if errors.Is(d.s.Err(), bufio.ErrTooLong) {
+		return &SyntaxError{Line: d.line + 1, Msg: "the line is too long"}
+	}
+
+	if d.s.Err() != nil {
+		return d.s.Err()
+	}
+
+	return io.EOF
+}
+
+// parse the content line: name *(";" param) ":" value, the quoted parameter values may contain the separators
+func parse(content string) (*Property, bool) {
+	end := strings.IndexAny(content, ";:")
+	if end <= 0 {
+		return nil, false
+	}
+
+	p := &Property{Name: strings.ToUpper(content[:end])}
+	rest := content[end:]
+
+	for len(rest) > 0 && rest[0] == ';' {
+		eq := strings.IndexByte(rest, '=')
+		if eq < 0 {
+			return nil, false
+		}
+
+		param := Param{Name: strings.ToUpper(rest[1:eq])}
+		rest = rest[eq+1:]
+
+		var value strings.Builder
+		for len(rest) > 0 && rest[0] != ';' && rest[0] != ':' {
+			if rest[0] == '"' {
+				closing := strings.IndexByte(rest[1:], '"')
+				if closing < 0 {
+					return nil, false
+				}
+
+				value.WriteString(rest[1 : closing+1])
+				rest = rest[closing+2:]
+				continue
+			}
+
+			next := strings.IndexAny(rest, `;:"`)
+			if next < 0 {
+				return nil, false
+			}
+
+			value.WriteString(rest[:next])
+			rest = rest[next:]
+		}
+
+		param.Value = value.String()
+		p.Params = append(p.Params, param)
+	}
+
+	if len(rest) == 0 || rest[0] != ':' {
+		return nil, false
+	}
+
+	p.Value = rest[1:]
+	return p, true
+}
@@ -0,0 +1,66 @@
+package ical
+
+import (
+	"io"
+	"strings"
+)
+
+// Encoder writes the content lines with CRLF and the folding of the long lines
+type Encoder struct {
+	w io.Writer
+}
+
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: w}
+}
+
+// Begin opens the component, the object is streamed by Begin, Property, Encode and End
+func (e *Encoder) Begin(name string) error {
+	return e.Property(&Property{Name: "BEGIN", Value: name})
+}
+
+func (e *Encoder) End(name string) error {
+	return e.Property(&Property{Name: "END", Value: name})
+}
+
+func (e *Encoder) Property(p *Property) error {
+	var b strings.Builder
+	b.WriteString(p.Name)
+
+	for _, param := range p.Params {
+		b.WriteString(";" + param.Name + "=")
+
+		if strings.ContainsAny(param.Value, ":;,") {
+			b.WriteString(`"` + strings.ReplaceAll(param.Value, `"`, "'") + `"`)
+			continue
+		}
+
+		b.WriteString(param.Value)
+	}
+
+	b.WriteString(":" + p.Value)
+
+	_, err := io.WriteString(e.w, fold(b.String()))
+	return err
+}
+
+// Encode writes the component with its sub-components
+func (e *Encoder) Encode(c *Component) error {
+	if err := e.Begin(c.Name); err != nil {
+		return err
+	}
+
+	for _, p := range c.Properties {
+		if err := e.Property(p); err != nil {
+			return err
+		}
+	}
+
+	for _, sub := range c.Components {
+		if err := e.Encode(sub); err != nil {
+			return err
+		}
+	}
+
+	return e.End(c.Name)
+}
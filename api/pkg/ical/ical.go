@@ -0,0 +1,260 @@
+// Package ical writes and reads the iCalendar objects(RFC 5545), it covers the components and the values used by the todos
+package ical
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	dateLayout     = "20060102"
+	dateTimeLayout = "20060102T150405"
+	// lineLength octets of a content line, the longer lines are folded
+	lineLength = 75
+)
+
+// ErrInvalidRecur the RRULE value is not a recurrence rule
+var ErrInvalidRecur = errors.New("ical: invalid recurrence rule")
+
+// SyntaxError the content line is malformed, or the components are not closed
+type SyntaxError struct {
+	Line int
+	Msg  string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("ical: line %d: %s", e.Line, e.Msg)
+}
+
+type Param struct {
+	Name  string
+	Value string
+}
+
+// Property a content line, the value is kept in its encoded form
+type Property struct {
+	Name   string
+	Params []Param
+	Value  string
+}
+
+// Param the value of the parameter, empty when it is missing
+func (p *Property) Param(name string) string {
+	for _, param := range p.Params {
+		if strings.EqualFold(param.Name, name) {
+			return param.Value
+		}
+	}
+
+	return ""
+}
+
+// Text the unescaped value of the TEXT property
+func (p *Property) Text() string {
+	return Unescape(p.Value)
+}
+
+// Time the value of the DATE or the DATE-TIME property, allDay for the DATE values.
+// the UTC values are in UTC, the values with TZID in its zone and the floating values in the location
+func (p *Property) Time(floating *time.Location) (t time.Time, allDay bool, err error) {
+	value := strings.TrimSpace(p.Value)
+
+	if strings.EqualFold(p.Param("VALUE"), "DATE") || len(value) == len(dateLayout) {
+		t, err = time.ParseInLocation(dateLayout, value, floating)
+		return t, true, err
+	}
+
+	if utc, found := strings.CutSuffix(value, "Z"); found {
+		t, err = time.ParseInLocation(dateTimeLayout, utc, time.UTC)
+		return
+	}
+
+	location := floating
+	if tzid := strings.TrimPrefix(p.Param("TZID"), "/"); len(tzid) > 0 {
+		if location, err = time.LoadLocation(tzid); err != nil {
+			err = fmt.Errorf("ical: unknown time zone %q", tzid)
+			return
+		}
+	}
+
+	t, err = time.ParseInLocation(dateTimeLayout, value, location)
+	return
+}
+
+// Component a calendar component with its sub-components, like the VALARM of a VTODO
+type Component struct {
+	Name       string
+	Line       int // the line of BEGIN, for the decoded components
+	Properties []*Property
+	Components []*Component
+}
+
+func NewComponent(name string) *Component {
+	return &Component{Name: name}
+}
+
+// Add appends the property with the encoded value
+func (c *Component) Add(name, value string, params ...Param) *Component {
+	c.Properties = append(c.Properties, &Property{Name: name, Params: params, Value: value})
+	return c
+}
+
+// Get the first property of the name, nil when it is missing
+func (c *Component) Get(name string) *Property {
+	for _, p := range c.Properties {
+		if strings.EqualFold(p.Name, name) {
+			return p
+		}
+	}
+
+	return nil
+}
+
+// Text escapes the TEXT value
+func Text(value string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(value)
+}
+
+// Unescape the TEXT value
+func Unescape(value string) string {
+	return strings.NewReplacer(`\\`, `\`, `\;`, ";", `\,`, ",", `\n`, "\n", `\N`, "\n").Replace(value)
+}
+
+// Date the DATE value of the time, in its location
+func Date(t time.Time) string {
+	return t.Format(dateLayout)
+}
+
+// DateTime the DATE-TIME value, UTC with the Z suffix and the other locations in their wall clock for the TZID parameter
+func DateTime(t time.Time) string {
+	if t.Location() == time.UTC {
+		return t.Format(dateTimeLayout) + "Z"
+	}
+
+	return t.Format(dateTimeLayout)
+}
+
+// recurParts the parts of the RRULE with their value syntax
+var recurParts = map[string]func(string) bool{
+	"FREQ": func(v string) bool {
+		switch v {
+		case "SECONDLY", "MINUTELY", "HOURLY", "DAILY", "WEEKLY", "MONTHLY", "YEARLY":
+			return true
+		}
+
+		return false
+	},
+	"UNTIL": func(v string) bool {
+		_, dateErr := time.Parse(dateLayout, v)
+		_, dateTimeErr := time.Parse(dateTimeLayout+"Z", v)
+		return dateErr == nil || dateTimeErr == nil
+	},
+	"COUNT":      positive,
+	"INTERVAL":   positive,
+	"BYSECOND":   numbers(0, 60),
+	"BYMINUTE":   numbers(0, 59),
+	"BYHOUR":     numbers(0, 23),
+	"BYDAY":      weekdays,
+	"BYMONTHDAY": numbers(-31, 31),
+	"BYYEARDAY":  numbers(-366, 366),
+	"BYWEEKNO":   numbers(-53, 53),
+	"BYMONTH":    numbers(1, 12),
+	"BYSETPOS":   numbers(-366, 366),
+	"WKST": func(v string) bool {
+		return weekday(v)
+	},
+}
+
+// ValidateRecur checks the RRULE value(RFC 5545 3.3.10), like FREQ=WEEKLY;BYDAY=MO,WE
+func ValidateRecur(value string) error {
+	seen := make(map[string]bool)
+
+	for _, part := range strings.Split(value, ";") {
+		name, v, ok := strings.Cut(part, "=")
+		name = strings.ToUpper(name)
+
+		valid, known := recurParts[name]
+		if !ok || !known || seen[name] || !valid(strings.ToUpper(v)) {
+			return ErrInvalidRecur
+		}
+
+		seen[name] = true
+	}
+
+	if !seen["FREQ"] || (seen["COUNT"] && seen["UNTIL"]) {
+		return ErrInvalidRecur
+	}
+
+	return nil
+}
+
+// HELPERS
+
+func positive(v string) bool {
+	n, err := strconv.Atoi(v)
+	return err == nil && n > 0
+}
+
+func numbers(min, max int) func(string) bool {
+	return func(v string) bool {
+		for _, item := range strings.Split(v, ",") {
+			n, err := strconv.Atoi(item)
+			if err != nil || n < min || n > max || n == 0 && min < 0 {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// weekdays the weekdays with the optional ordinal, like MO,-1FR
+func weekdays(v string) bool {
+	for _, item := range strings.Split(v, ",") {
+		if len(item) < 2 || !weekday(item[len(item)-2:]) {
+			return false
+		}
+
+		if ordinal := item[:len(item)-2]; len(ordinal) > 0 && !numbers(-53, 53)(strings.TrimPrefix(ordinal, "+")) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func weekday(v string) bool {
+	switch v {
+	case "MO", "TU", "WE", "TH", "FR", "SA", "SU":
+		return true
+	}
+
+	return false
+}
+
+// fold splits the content line into the lines of at most lineLength octets, without splitting the characters
+func fold(line string) string {
+	if len(line) <= lineLength {
+		return line + "\r\n"
+	}
+
+	var b strings.Builder
+	limit := lineLength
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+
+		b.WriteString(line[:cut] + "\r\n ")
+		line = line[cut:]
+		limit = lineLength - 1 // the leading space of the continuation line
+	}
+
+	b.WriteString(line + "\r\n")
+	return b.String()
+}
@@ -0,0 +1,110 @@
+package ical
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncoder(t *testing.T) {
+	t.Run("the long lines are folded without splitting the characters", func(t *testing.T) {
+		var b bytes.Buffer
+		summary := strings.Repeat("ä", 60)
+
+		err := NewEncoder(&b).Encode(NewComponent("VTODO").Add("SUMMARY", Text(summary)))
+
+		assert.NoError(t, err)
+		for _, line := range strings.Split(strings.TrimSuffix(b.String(), "\r\n"), "\r\n") {
+			assert.LessOrEqual(t, len(line), lineLength)
+		}
+
+		c, err := NewDecoder(&b).Next()
+		assert.NoError(t, err)
+		assert.Equal(t, summary, c.Get("SUMMARY").Text())
+	})
+
+	t.Run("the parameter values with separators are quoted", func(t *testing.T) {
+		var b bytes.Buffer
+
+		err := NewEncoder(&b).Property(&Property{Name: "X-LINK", Params: []Param{{Name: "LABEL", Value: "a:b"}}, Value: "c"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "X-LINK;LABEL=\"a:b\":c\r\n", b.String())
+	})
+}
+
+func TestDecoder(t *testing.T) {
+	calendar := "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n" +
+		"BEGIN:VTODO\r\nUID:1\r\nSUMMARY:call\\, then\r\n  write\\nnotes\r\nDUE;TZID=\"Europe/Berlin\":20250807T090000\r\n" +
+		"BEGIN:VALARM\r\nACTION:DISPLAY\r\nEND:VALARM\r\nEND:VTODO\r\n" +
+		"BEGIN:VEVENT\r\nUID:2\r\nDTSTART;VALUE=DATE:20250808\r\nEND:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	t.Run("the components are read with their lines and sub-components", func(t *testing.T) {
+		d := NewDecoder(strings.NewReader(calendar))
+
+		todo, err := d.Next()
+		assert.NoError(t, err)
+		assert.Equal(t, "VTODO", todo.Name)
+		assert.Equal(t, 3, todo.Line)
+		assert.Equal(t, "call, then write\nnotes", todo.Get("SUMMARY").Text())
+		assert.Len(t, todo.Components, 1)
+
+		due, allDay, err := todo.Get("DUE").Time(time.UTC)
+		assert.NoError(t, err)
+		assert.False(t, allDay)
+		assert.Equal(t, "2025-08-07T09:00:00+02:00", due.Format(time.RFC3339))
+
+		event, err := d.Next()
+		assert.NoError(t, err)
+		assert.Equal(t, 12, event.Line)
+
+		start, allDay, err := event.Get("DTSTART").Time(time.UTC)
+		assert.NoError(t, err)
+		assert.True(t, allDay)
+		assert.Equal(t, "2025-08-08", start.Format(time.DateOnly))
+
+		_, err = d.Next()
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("the unclosed component is a syntax error", func(t *testing.T) {
+		_, err := NewDecoder(strings.NewReader("BEGIN:VCALENDAR\nBEGIN:VTODO\nSUMMARY:x\n")).Next()
+
+		var syntaxErr *SyntaxError
+		assert.True(t, errors.As(err, &syntaxErr))
+		assert.Equal(t, 2, syntaxErr.Line)
+	})
+}
+
+func TestValidateRecur(t *testing.T) {
+	for _, rule := range []string{"FREQ=WEEKLY;BYDAY=MO,-1FR", "FREQ=DAILY;COUNT=10", "FREQ=MONTHLY;BYMONTHDAY=-1;UNTIL=20251231T235959Z"} {
+		assert.NoError(t, ValidateRecur(rule), rule)
+	}
+
+	for _, rule := range []string{"", "BYDAY=MO", "FREQ=SOMETIMES", "FREQ=DAILY;COUNT=2;UNTIL=20251231", "FREQ=DAILY;FREQ=WEEKLY", "FREQ=MONTHLY;BYMONTHDAY=0"} {
+		assert.ErrorIs(t, ValidateRecur(rule), ErrInvalidRecur, rule)
+	}
+}
+
+func TestTimezone(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	tz := Timezone(berlin, time.Date(2025, 8, 7, 0, 0, 0, 0, berlin), time.Date(2025, 12, 1, 0, 0, 0, 0, berlin))
+
+	assert.Equal(t, "Europe/Berlin", tz.Get("TZID").Value)
+	assert.Len(t, tz.Components, 2)
+
+	summer, winter := tz.Components[0], tz.Components[1]
+	assert.Equal(t, "DAYLIGHT", summer.Name)
+	assert.Equal(t, "20250330T020000", summer.Get("DTSTART").Value)
+	assert.Equal(t, "+0100", summer.Get("TZOFFSETFROM").Value)
+	assert.Equal(t, "+0200", summer.Get("TZOFFSETTO").Value)
+	assert.Equal(t, "STANDARD", winter.Name)
+	assert.Equal(t, "20251026T030000", winter.Get("DTSTART").Value)
+}
@@ -0,0 +1,65 @@
+package ical
+
+import "time"
+
+// maxObservances of a VTIMEZONE, the range of the zone is cut after that
+const maxObservances = 256
+
+// Timezone the VTIMEZONE of the location with the observances in effect between from and to
+func Timezone(location *time.Location, from, to time.Time) *Component {
+	tz := NewComponent("VTIMEZONE").Add("TZID", location.String())
+
+	onset, _ := from.In(location).ZoneBounds()
+	if onset.IsZero() {
+		onset = time.Date(1970, 1, 1, 0, 0, 0, 0, location) // the zone has no transitions before
+	}
+
+	for range maxObservances {
+		tz.Components = append(tz.Components, observance(onset))
+
+		_, next := onset.ZoneBounds()
+		if next.IsZero() || next.After(to) {
+			break
+		}
+
+		onset = next
+	}
+
+	return tz
+}
+
+// observance the STANDARD or the DAYLIGHT component of the zone starting at the onset
+func observance(onset time.Time) *Component {
+	name, offset := onset.Zone()
+	_, prior := onset.Add(-time.Second).Zone()
+
+	kind := "STANDARD"
+	if onset.IsDST() {
+		kind = "DAYLIGHT"
+	}
+
+	// NOTE: the onset is in the wall clock of the prior offset
+	start := onset.In(time.FixedZone("", prior)).Format(dateTimeLayout)
+
+	return NewComponent(kind).
+		Add("DTSTART", start).
+		Add("TZOFFSETFROM", formatOffset(prior)).
+		Add("TZOFFSETTO", formatOffset(offset)).
+		Add("TZNAME", Text(name))
+}
+
+// formatOffset the UTC-OFFSET value, like +0200 or -0330
+func formatOffset(seconds int) string {
+	sign := byte('+')
+	if seconds < 0 {
+		sign, seconds = '-', -seconds
+	}
+
+	hours, minutes, rest := seconds/3600, seconds%3600/60, seconds%60
+	value := []byte{sign, byte('0' + hours/10), byte('0' + hours%10), byte('0' + minutes/10), byte('0' + minutes%10)}
+	if rest > 0 {
+		value = append(value, byte('0'+rest/10), byte('0'+rest%10))
+	}
+
+	return string(value)
+}
@@ -0,0 +1,357 @@
+// Package logfile writes the logs to the daily files rotated by size, the old files are compressed and removed by retention
+package logfile
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	dateLayout = "2006-01-02"
+	extension  = ".log"
+	gzipSuffix = ".gz"
+)
+
+type Options struct {
+	// MaxSize the file is rotated when a write exceeds it, zero disables the size rotation
+	MaxSize int64
+	// MaxBackups the count of the kept rotated files, zero keeps all of them
+	MaxBackups int
+	// MaxAge the rotated files older than that are removed, zero keeps all of them
+	MaxAge time.Duration
+	// Compress gzips the rotated files
+	Compress bool
+	// Location the days start at its midnight, default: time.Local
+	Location *time.Location
+	// Now the clock, default: time.Now
+	Now func() time.Time
+}
+
+// File the file of the day: <dir>/<prefix>-<date>.log, the rotated files of the same day are <prefix>-<date>.<n>.log.
+// it is safe for the concurrent writes
+type File struct {
+	dir    string
+	prefix string
+	opts   Options
+
+	mu   sync.Mutex
+	file *os.File
+	day  string
+	size int64
+	// cleaning the compression and the retention of the rotated files run in background, one at a time
+	cleaning sync.WaitGroup
+	cleanMu  sync.Mutex
+}
+
+func New(dir, prefix string, opts Options) (*File, error) {
+	if opts.Location == nil {
+		opts.Location = time.Local
+	}
+
+	if opts.Now == nil {
+		opts.Now = time.Now
+	}
+
+	f := &File{dir: dir, prefix: prefix, opts: opts}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+
+	// NOTE: the files of the previous runs are compressed and retained like the rotated ones
+	f.cleanup()
+	return f, nil
+}
+
+// Write rotates the file at the midnight and when the size is exceeded, before the write
+func (f *File) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.file == nil {
+		return 0, os.ErrClosed
+	}
+
+	// NOTE: a failed rotation keeps the current file, the write is not lost and the rotation is retried on the next write
+	var rotateErr error
+	switch {
+	case f.today() != f.day:
+		rotateErr = f.rotate(false)
+	case f.opts.MaxSize > 0 && f.size > 0 && f.size+int64(len(p)) > f.opts.MaxSize:
+		rotateErr = f.rotate(true)
+	}
+
+	n, err := f.file.Write(p)
+	f.size += int64(n)
+	if err == nil {
+		err = rotateErr
+	}
+
+	return n, err
+}
+
+func (f *File) Sync() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.file == nil {
+		return os.ErrClosed
+	}
+
+	return f.file.Sync()
+}
+
+// Reopen opens the file of the day again, after it is moved by the external tools like logrotate
+func (f *File) Reopen() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.open()
+}
+
+// Close waits for the background compression and retention
+func (f *File) Close() error {
+	f.mu.Lock()
+	var err error
+	if f.file != nil {
+		err = f.file.Close()
+		f.file = nil
+	}
+	f.mu.Unlock()
+
+	f.cleaning.Wait()
+	return err
+}
+
+// Name the path of the file of the day
+func (f *File) Name() string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.path(f.day)
+}
+
+// HELPERS
+
+func (f *File) today() string {
+	return f.opts.Now().In(f.opts.Location).Format(dateLayout)
+}
+
+func (f *File) path(day string) string {
+	return filepath.Join(f.dir, fmt.Sprintf("%s-%s%s", f.prefix, day, extension))
+}
+
+// open opens the file of the day, the current file is closed only after the new one is opened
+func (f *File) open() error {
+	if err := os.MkdirAll(f.dir, 0o755); err != nil {
+		return err
+	}
+
+	day := f.today()
+	file, err := os.OpenFile(f.path(day), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	if f.file != nil {
+		_ = f.file.Close()
+	}
+
+	f.file, f.day, f.size = file, day, info.Size()
+	return nil
+}
+
+// rotate opens the file of the day, the file of the same day is moved to its next free sequence.
+// when the open fails, the current file is kept and the moved file is put back
+func (f *File) rotate(sameDay bool) error {
+	backup := ""
+	if sameDay {
+		next, err := f.nextBackup(f.day)
+		if err != nil {
+			return err
+		}
+
+		if err = os.Rename(f.path(f.day), next); err != nil {
+			return err
+		}
+
+		backup = next
+	}
+
+	if err := f.open(); err != nil {
+		if backup != "" {
+			_ = os.Rename(backup, f.path(f.day))
+		}
+
+		return err
+	}
+
+	f.cleanup()
+	return nil
+}
+
+// cleanup runs the clean in background
+func (f *File) cleanup() {
+	f.cleaning.Add(1)
+	go func() {
+		defer f.cleaning.Done()
+		f.clean()
+	}()
+}
+
+// nextBackup the path of the next sequence of the day, the compressed backups are counted
+func (f *File) nextBackup(day string) (string, error) {
+	for seq := 1; ; seq++ {
+		name := filepath.Join(f.dir, fmt.Sprintf("%s-%s.%d%s", f.prefix, day, seq, extension))
+
+		_, plainErr := os.Stat(name)
+		_, gzipErr := os.Stat(name + gzipSuffix)
+		if os.IsNotExist(plainErr) && os.IsNotExist(gzipErr) {
+			return name, nil
+		}
+
+		if plainErr != nil && !os.IsNotExist(plainErr) {
+			return "", plainErr
+		}
+	}
+}
+
+// clean compresses the backups and removes the backups past the retention
+func (f *File) clean() {
+	f.cleanMu.Lock()
+	defer f.cleanMu.Unlock()
+
+	if f.opts.Compress {
+		for _, backup := range f.backups() {
+			if !strings.HasSuffix(backup.path, gzipSuffix) {
+				_ = compress(backup.path)
+			}
+		}
+	}
+
+	if f.opts.MaxBackups <= 0 && f.opts.MaxAge <= 0 {
+		return
+	}
+
+	backups := f.backups()
+	cutoff := f.opts.Now().Add(-f.opts.MaxAge)
+
+	for i, backup := range backups {
+		expired := f.opts.MaxAge > 0 && backup.modified.Before(cutoff)
+		exceeded := f.opts.MaxBackups > 0 && i >= f.opts.MaxBackups
+
+		if expired || exceeded {
+			_ = os.Remove(backup.path)
+		}
+	}
+}
+
+type backup struct {
+	path     string
+	modified time.Time
+}
+
+// backups the rotated files of the prefix, the newest first. the file of the day is not a backup
+func (f *File) backups() []backup {
+	entries, err := os.ReadDir(f.dir)
+	if err != nil {
+		return nil
+	}
+
+	f.mu.Lock()
+	current := filepath.Base(f.path(f.day))
+	f.mu.Unlock()
+
+	list := make([]backup, 0)
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || name == current || !f.owns(name) {
+			continue
+		}
+
+		info, infoErr := entry.Info()
+		if infoErr != nil {
+			continue
+		}
+
+		list = append(list, backup{path: filepath.Join(f.dir, name), modified: info.ModTime()})
+	}
+
+	sort.Slice(list, func(i, j int) bool { return list[i].modified.After(list[j].modified) })
+	return list
+}
+
+// owns the file is a log of the prefix: <prefix>-<date>[.<n>].log[.gz]
+func (f *File) owns(name string) bool {
+	rest, ok := strings.CutPrefix(name, f.prefix+"-")
+	if !ok {
+		return false
+	}
+
+	rest = strings.TrimSuffix(rest, gzipSuffix)
+	rest, ok = strings.CutSuffix(rest, extension)
+	if !ok || len(rest) < len(dateLayout) {
+		return false
+	}
+
+	if _, err := time.Parse(dateLayout, rest[:len(dateLayout)]); err != nil {
+		return false
+	}
+
+	if seq := rest[len(dateLayout):]; len(seq) > 0 {
+		n, err := strconv.Atoi(strings.TrimPrefix(seq, "."))
+		return strings.HasPrefix(seq, ".") && err == nil && n > 0
+	}
+
+	return true
+}
+
+// compress gzips the file next to it and removes the file, the modification time is kept for the retention
+func compress(path string) (err error) {
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = src.Close() }()
+
+	info, err := src.Stat()
+	if err != nil {
+		return err
+	}
+
+	dst, err := os.OpenFile(path+gzipSuffix, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+
+	zw := gzip.NewWriter(dst)
+	if _, err = io.Copy(zw, src); err == nil {
+		err = zw.Close()
+	}
+
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		_ = os.Remove(path + gzipSuffix)
+		return err
+	}
+
+	_ = os.Chtimes(path+gzipSuffix, info.ModTime(), info.ModTime())
+	return os.Remove(path)
+}
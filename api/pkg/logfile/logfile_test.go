@@ -0,0 +1,159 @@
+package logfile
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// clock the controllable time of the tests
+type clock struct {
+	now time.Time
+}
+
+func (c *clock) Now() time.Time { return c.now }
+
+func names(t *testing.T, dir string) []string {
+	entries, err := os.ReadDir(dir)
+	assert.Nil(t, err)
+
+	list := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		list = append(list, entry.Name())
+	}
+
+	sort.Strings(list)
+	return list
+}
+
+func TestFile(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	assert.Nil(t, err)
+
+	t.Run("rotated at the midnight of the location", func(t *testing.T) {
+		dir := t.TempDir()
+		c := &clock{now: time.Date(2025, 8, 7, 21, 30, 0, 0, time.UTC)} // 23:30 in Berlin
+
+		f, err := New(dir, "info", Options{Location: berlin, Now: c.Now})
+		assert.Nil(t, err)
+
+		_, err = f.Write([]byte("before\n"))
+		assert.Nil(t, err)
+
+		c.now = c.now.Add(time.Hour) // 00:30 of the next day in Berlin, still the same day in UTC
+		_, err = f.Write([]byte("after\n"))
+		assert.Nil(t, err)
+		assert.Nil(t, f.Close())
+
+		assert.Equal(t, []string{"info-2025-08-07.log", "info-2025-08-08.log"}, names(t, dir))
+
+		content, err := os.ReadFile(filepath.Join(dir, "info-2025-08-08.log"))
+		assert.Nil(t, err)
+		assert.Equal(t, "after\n", string(content))
+	})
+
+	t.Run("rotated by size and compressed", func(t *testing.T) {
+		dir := t.TempDir()
+		c := &clock{now: time.Date(2025, 8, 7, 10, 0, 0, 0, time.UTC)}
+
+		f, err := New(dir, "err", Options{MaxSize: 10, Compress: true, Location: time.UTC, Now: c.Now})
+		assert.Nil(t, err)
+
+		for _, line := range []string{"line one\n", "line two\n", "line three\n"} {
+			_, err = f.Write([]byte(line))
+			assert.Nil(t, err)
+		}
+
+		assert.Nil(t, f.Close())
+		assert.Equal(t, []string{"err-2025-08-07.1.log.gz", "err-2025-08-07.2.log.gz", "err-2025-08-07.log"}, names(t, dir))
+
+		gz, err := os.Open(filepath.Join(dir, "err-2025-08-07.1.log.gz"))
+		assert.Nil(t, err)
+		defer func() { _ = gz.Close() }()
+
+		zr, err := gzip.NewReader(gz)
+		assert.Nil(t, err)
+
+		content, err := io.ReadAll(zr)
+		assert.Nil(t, err)
+		assert.Equal(t, "line one\n", string(content))
+	})
+
+	t.Run("the backups past the retention are removed", func(t *testing.T) {
+		dir := t.TempDir()
+		c := &clock{now: time.Date(2025, 8, 10, 10, 0, 0, 0, time.UTC)}
+
+		for i, day := range []string{"2025-08-01", "2025-08-07", "2025-08-08", "2025-08-09"} {
+			path := filepath.Join(dir, "info-"+day+".log")
+			assert.Nil(t, os.WriteFile(path, []byte("old\n"), 0o644))
+
+			modified := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC).AddDate(0, 0, []int{0, 6, 7, 8}[i])
+			assert.Nil(t, os.Chtimes(path, modified, modified))
+		}
+
+		assert.Nil(t, os.WriteFile(filepath.Join(dir, "other.log"), []byte("kept\n"), 0o644))
+
+		f, err := New(dir, "info", Options{MaxBackups: 2, MaxAge: 5 * 24 * time.Hour, Location: time.UTC, Now: c.Now})
+		assert.Nil(t, err)
+		assert.Nil(t, f.Close())
+
+		// the oldest is expired, and only the two newest are kept
+		assert.Equal(t, []string{"info-2025-08-08.log", "info-2025-08-09.log", "info-2025-08-10.log", "other.log"}, names(t, dir))
+	})
+
+	t.Run("reopened after it is moved", func(t *testing.T) {
+		dir := t.TempDir()
+		c := &clock{now: time.Date(2025, 8, 7, 10, 0, 0, 0, time.UTC)}
+
+		f, err := New(dir, "info", Options{Location: time.UTC, Now: c.Now})
+		assert.Nil(t, err)
+
+		_, err = f.Write([]byte("moved\n"))
+		assert.Nil(t, err)
+
+		assert.Nil(t, os.Rename(f.Name(), filepath.Join(dir, "logrotated")))
+		assert.Nil(t, f.Reopen())
+
+		_, err = f.Write([]byte("reopened\n"))
+		assert.Nil(t, err)
+		assert.Nil(t, f.Close())
+
+		content, err := os.ReadFile(filepath.Join(dir, "info-2025-08-07.log"))
+		assert.Nil(t, err)
+		assert.Equal(t, "reopened\n", string(content))
+	})
+	t.Run("the current file is kept when the rotation fails", func(t *testing.T) {
+		dir := t.TempDir()
+		c := &clock{now: time.Date(2025, 8, 7, 10, 0, 0, 0, time.UTC)}
+
+		f, err := New(dir, "info", Options{Location: time.UTC, Now: c.Now})
+		assert.Nil(t, err)
+
+		// the file of the next day can not be opened while a directory takes its path
+		next := filepath.Join(dir, "info-2025-08-08.log")
+		assert.Nil(t, os.Mkdir(next, 0o755))
+
+		c.now = c.now.AddDate(0, 0, 1)
+		_, err = f.Write([]byte("kept\n"))
+		assert.NotNil(t, err)
+
+		assert.Nil(t, os.Remove(next))
+		_, err = f.Write([]byte("rotated\n"))
+		assert.Nil(t, err)
+		assert.Nil(t, f.Close())
+
+		content, err := os.ReadFile(filepath.Join(dir, "info-2025-08-07.log"))
+		assert.Nil(t, err)
+		assert.Equal(t, "kept\n", string(content))
+
+		content, err = os.ReadFile(next)
+		assert.Nil(t, err)
+		assert.Equal(t, "rotated\n", string(content))
+	})
+}
@@ -0,0 +1,44 @@
+package markdown
+
+import (
+	"bytes"
+	"strings"
+	"unicode"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+)
+
+var (
+	// renderer omits the raw HTML of the source, the GitHub flavored extensions are enabled
+	renderer = goldmark.New(goldmark.WithExtensions(extension.GFM))
+	// policy allows the user generated content elements only(no script, style, iframe or event handlers)
+	policy = bluemonday.UGCPolicy()
+)
+
+// Sanitize normalizes the line breaks and removes the invalid UTF-8 and control characters of the Markdown source
+func Sanitize(src string) string {
+	src = strings.ToValidUTF8(src, "")
+	src = strings.ReplaceAll(src, "\r\n", "\n")
+
+	src = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) && r != '\n' && r != '\t' {
+			return -1
+		}
+
+		return r
+	}, src)
+
+	return strings.TrimSpace(src)
+}
+
+// ToSafeHTML renders the Markdown source to HTML which is safe to be embedded in the pages
+func ToSafeHTML(src string) (string, error) {
+	var buf bytes.Buffer
+	if err := renderer.Convert([]byte(src), &buf); err != nil {
+		return "", err
+	}
+
+	return policy.Sanitize(buf.String()), nil
+}
@@ -0,0 +1,30 @@
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToSafeHTML(t *testing.T) {
+	t.Run("renders the markdown", func(t *testing.T) {
+		html, err := ToSafeHTML("## Steps\n- draft the **outline**")
+
+		assert.NoError(t, err)
+		assert.Contains(t, html, "<h2>Steps</h2>")
+		assert.Contains(t, html, "<strong>outline</strong>")
+	})
+
+	t.Run("drops the unsafe html", func(t *testing.T) {
+		html, err := ToSafeHTML("hi <script>alert(1)</script> [link](javascript:alert(1)) <img src=x onerror=alert(1)>")
+
+		assert.NoError(t, err)
+		assert.NotContains(t, html, "<script")
+		assert.NotContains(t, html, "javascript:")
+		assert.NotContains(t, html, "onerror")
+	})
+}
+
+func TestSanitize(t *testing.T) {
+	assert.Equal(t, "línea 1\nlínea 2 ✅", Sanitize(" línea 1\r\nlínea 2\x00 ✅\x07 \n"))
+}
@@ -0,0 +1,35 @@
+package mention
+
+import (
+	"regexp"
+	"strings"
+)
+
+// MaxLength of the mentioned user id, it is consistent with the `X-User-ID` header limit
+const MaxLength = 255
+
+// pattern `@` at the start or after a non-word character, so the e-mail addresses are not mentions
+var pattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_@])@([\p{L}\p{N}_][\p{L}\p{N}_.\-]*)`)
+
+// Parse the mentioned user ids of the text in the order of the first appearance, without duplicates.
+// the trailing punctuation is not part of the id, like "@alice." at the end of a sentence
+func Parse(text string) []string {
+	mentions := make([]string, 0)
+	seen := make(map[string]struct{})
+
+	for _, match := range pattern.FindAllStringSubmatch(text, -1) {
+		id := strings.TrimRight(match[1], ".-")
+		if len(id) == 0 || len(id) > MaxLength {
+			continue
+		}
+
+		if _, ok := seen[id]; ok {
+			continue
+		}
+
+		seen[id] = struct{}{}
+		mentions = append(mentions, id)
+	}
+
+	return mentions
+}
@@ -0,0 +1,23 @@
+package mention
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("mentions in the order of appearance", func(t *testing.T) {
+		mentions := Parse("@bob please review, then ask @alice.smith and @bob again.\n(@jörg-42)")
+
+		assert.Equal(t, []string{"bob", "alice.smith", "jörg-42"}, mentions)
+	})
+
+	t.Run("trailing punctuation is dropped", func(t *testing.T) {
+		assert.Equal(t, []string{"alice"}, Parse("thanks @alice."))
+	})
+
+	t.Run("e-mail addresses and the lone signs are not mentions", func(t *testing.T) {
+		assert.Empty(t, Parse("mail bob@example.com or @ me @@twice"))
+	})
+}
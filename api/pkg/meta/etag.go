@@ -0,0 +1,97 @@
+package meta
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	HeaderETag        = "ETag"
+	HeaderIfMatch     = "If-Match"
+	HeaderIfNoneMatch = "If-None-Match"
+)
+
+var ErrETagMismatch = errors.New("the entity tag does not match the current version")
+
+// ETag strong entity tag of the resource version, the variants of the same version like the rendered HTML get their own
+// tags with the variant suffix, like "3-html"
+func ETag(version uint, variant string) string {
+	if len(variant) > 0 {
+		return fmt.Sprintf(`"%d-%s"`, version, variant)
+	}
+
+	return fmt.Sprintf(`"%d"`, version)
+}
+
+// SetETag sets the `ETag` header of the response
+func SetETag(c *gin.Context, version uint) {
+	c.Header(HeaderETag, ETag(version, ""))
+}
+
+// SetVariantETag sets the `ETag` header of a variant of the response
+func SetVariantETag(c *gin.Context, version uint, variant string) {
+	c.Header(HeaderETag, ETag(version, variant))
+}
+
+// IfMatchVersions parses the versions of the `If-Match` header, nil is returned for the absent header or `*`.
+// the write is applied when any of them is current, the variants match their version. the weak tags never match,
+// as the strong comparison is required by RFC 9110
+func IfMatchVersions(c *gin.Context) (versions []uint, err error) {
+	header := strings.TrimSpace(c.GetHeader(HeaderIfMatch))
+	if len(header) == 0 || header == "*" {
+		return
+	}
+
+	for _, tag := range strings.Split(header, ",") {
+		if version, ok := tagVersion(strings.TrimSpace(tag)); ok {
+			versions = append(versions, version)
+		}
+	}
+
+	if len(versions) == 0 {
+		err = ErrETagMismatch
+	}
+
+	return
+}
+
+// NotModified reports whether the `If-None-Match` header of the request lists the current tag(weak comparison)
+func NotModified(c *gin.Context, etag string) bool {
+	header := strings.TrimSpace(c.GetHeader(HeaderIfNoneMatch))
+	if len(header) == 0 {
+		return false
+	}
+
+	if header == "*" {
+		return true
+	}
+
+	for _, tag := range strings.Split(header, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(tag), "W/") == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HELPERS
+
+// tagVersion the version of the strong tag, with or without the variant suffix
+func tagVersion(tag string) (uint, bool) {
+	if !strings.HasPrefix(tag, `"`) || !strings.HasSuffix(tag, `"`) || len(tag) < 3 {
+		return 0, false
+	}
+
+	value, _, _ := strings.Cut(tag[1:len(tag)-1], "-")
+	parsed, err := strconv.ParseUint(value, 10, 64)
+	if err != nil || parsed == 0 {
+		return 0, false
+	}
+
+	return uint(parsed), true
+}
@@ -0,0 +1,45 @@
+package meta
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestETag(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	request := func(header, value string) *gin.Context {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request = httptest.NewRequest(http.MethodPut, "/api/v1/todo/1", nil)
+		ctx.Request.Header.Set(header, value)
+		return ctx
+	}
+
+	t.Run("the variants have their own tags", func(t *testing.T) {
+		assert.Equal(t, `"3"`, ETag(3, ""))
+		assert.Equal(t, `"3-html"`, ETag(3, "html"))
+		assert.True(t, NotModified(request(HeaderIfNoneMatch, `W/"3-html"`), ETag(3, "html")))
+		assert.False(t, NotModified(request(HeaderIfNoneMatch, `"3"`), ETag(3, "html")))
+	})
+
+	t.Run("every tag of the If-Match list", func(t *testing.T) {
+		versions, err := IfMatchVersions(request(HeaderIfMatch, `"2", W/"4", "3-html"`))
+		assert.NoError(t, err)
+		assert.Equal(t, []uint{2, 3}, versions)
+	})
+
+	t.Run("any version is accepted by the absent header or the wildcard", func(t *testing.T) {
+		versions, err := IfMatchVersions(request(HeaderIfMatch, "*"))
+		assert.NoError(t, err)
+		assert.Nil(t, versions)
+	})
+
+	t.Run("the weak tags never match", func(t *testing.T) {
+		_, err := IfMatchVersions(request(HeaderIfMatch, `W/"3", "abc"`))
+		assert.ErrorIs(t, err, ErrETagMismatch)
+	})
+}
@@ -0,0 +1,81 @@
+package meta
+
+import (
+	"microservice/pkg/validator"
+	"strings"
+)
+
+const (
+	// ProblemMime the media type of the problem details(RFC 7807), the clients accepting it get the errors in that format
+	ProblemMime = "application/problem+json"
+	// problemTypePrefix the type of a problem is the URN of its mapped status, like urn:problem:validation_err
+	problemTypePrefix = "urn:problem:"
+)
+
+type (
+	// Problem the problem details of an error response(RFC 7807)
+	Problem struct {
+		Type     string          `json:"type" example:"urn:problem:validation_err"`
+		Title    string          `json:"title" example:"validation error"`
+		Status   int             `json:"status" example:"422"`
+		Detail   string          `json:"detail,omitempty" example:"validation failed for the description field."`
+		Instance string          `json:"instance,omitempty" example:"/api/v1/todo/create"`
+		Errors   []*ProblemField `json:"errors,omitempty"`
+	}
+
+	// ProblemField the failed rule of a field with its localized message
+	ProblemField struct {
+		Field   string `json:"field" example:"description"`
+		Rule    string `json:"rule" example:"required"`
+		Message string `json:"message" example:"the description field does not satisfy the required rule"`
+	}
+)
+
+// problem the problem details of the result
+func (r *Response) problem() *Problem {
+	problem := &Problem{
+		Type:     "about:blank",
+		Title:    r.Result.Message,
+		Status:   r.Result.Status,
+		Detail:   r.Result.Error,
+		Instance: r.ctx.Request.URL.Path,
+	}
+
+	if len(r.status) > 0 {
+		problem.Type = problemTypePrefix + string(r.status)
+	}
+
+	for _, fe := range r.fields {
+		problem.Errors = append(problem.Errors, &ProblemField{Field: fe.Field, Rule: fe.Rule, Message: r.fieldMessage(fe)})
+	}
+
+	return problem
+}
+
+// fieldMessage the translated message of the validator, the rules without a translation like the custom rules get the generic message
+func (r *Response) fieldMessage(fe validator.FieldError) string {
+	if msg, ok := fe.Message(r.lang); ok {
+		return msg
+	}
+
+	return r.l.Plural("validation_field", map[string]string{"field": fe.Field, "rule": fe.Rule, "param": fe.Param})
+}
+
+// fieldsMessage the messages of all the failed fields, the error of the legacy envelope
+func (r *Response) fieldsMessage() string {
+	messages := make([]string, 0, len(r.fields))
+	for _, fe := range r.fields {
+		messages = append(messages, r.fieldMessage(fe))
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// acceptsProblem the client prefers the problem details to the envelope of the result
+func (r *Response) acceptsProblem() bool {
+	if r.Result.Status < 400 || len(r.ctx.GetHeader("Accept")) == 0 {
+		return false
+	}
+
+	return r.ctx.NegotiateFormat("application/json", ProblemMime) == ProblemMime
+}
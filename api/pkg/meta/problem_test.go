@@ -0,0 +1,68 @@
+package meta
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	localeMock "microservice/internal/adapter/locale/mocks"
+	"microservice/internal/server/http/status"
+	"microservice/pkg/validator"
+)
+
+func TestResponse_Problem(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	fieldErrs := validator.Errors{
+		{Field: "description", Rule: "required"},
+		{Field: "priority", Rule: "max", Param: "5"},
+	}
+
+	respond := func(t *testing.T, accept string, err error) *httptest.ResponseRecorder {
+		ctrl := gomock.NewController(t)
+		l := localeMock.NewMockILocale(ctrl)
+		l.EXPECT().Lang(gomock.Any()).Return(l).AnyTimes()
+		l.EXPECT().Get(gomock.Any()).Return("invalid request data").AnyTimes()
+		l.EXPECT().Plural("validation_field", gomock.Any()).DoAndReturn(func(_ string, params map[string]string) string {
+			return params["field"] + " " + params["rule"]
+		}).AnyTimes()
+
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		ctx.Request = httptest.NewRequest(http.MethodPost, "/api/v1/todo/create", nil)
+		ctx.Request.Header.Set("Accept", accept)
+
+		Resp(ctx, l).Status(status.Validate).Err(err).Json()
+		return w
+	}
+
+	t.Run("problem details with every failed field", func(t *testing.T) {
+		w := respond(t, ProblemMime, fieldErrs)
+
+		var problem Problem
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Equal(t, ProblemMime, w.Header().Get("Content-Type"))
+		assert.Equal(t, "urn:problem:validation_err", problem.Type)
+		assert.Equal(t, "/api/v1/todo/create", problem.Instance)
+		assert.Equal(t, []*ProblemField{
+			{Field: "description", Rule: "required", Message: "description required"},
+			{Field: "priority", Rule: "max", Message: "priority max"},
+		}, problem.Errors)
+		assert.Equal(t, "description required; priority max", problem.Detail)
+	})
+
+	t.Run("legacy envelope for the json clients", func(t *testing.T) {
+		w := respond(t, "application/json", errors.New("invalid body"))
+
+		var result Result
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+		assert.Equal(t, "invalid body", result.Error)
+		assert.Contains(t, w.Header().Get("Content-Type"), "application/json")
+	})
+}
@@ -5,6 +5,8 @@ import (
 	"github.com/gin-gonic/gin"
 	"microservice/internal/adapter/locale"
 	st "microservice/internal/server/http/status"
+	"microservice/pkg/reqctx"
+	"microservice/pkg/validator"
 	"net/http"
 )
 
@@ -17,20 +19,31 @@ type (
 	}
 
 	Response struct {
-		l   locale.ILocale
-		ctx *gin.Context
+		l      locale.ILocale
+		ctx    *gin.Context
+		lang   string
+		status st.HttpMappedStatus
+		// fields the failed rules of the fields, they are listed by the problem details
+		fields validator.Errors
 		Result
 	}
 )
 
 func Resp(c *gin.Context, l locale.ILocale) *Response {
 	resp := &Response{}
-	resp.l = l
+	resp.lang = reqctx.Language(c)
+	resp.l = l.Lang(resp.lang)
 	resp.ctx = c
 	return resp
 }
 
+// Locale the messages in the negotiated language of the request
+func Locale(c *gin.Context, l locale.ILocale) locale.ILocale {
+	return l.Lang(reqctx.Language(c))
+}
+
 func (r *Response) Status(status st.HttpMappedStatus) *Response {
+	r.status = status
 	r.Result.Status = st.MappedStatuses[status]
 	r.Result.Message = r.l.Get(string(status))
 	return r
@@ -49,8 +62,15 @@ func (r *Response) Data(data interface{}) *Response {
 	return r
 }
 
+// Err the message of the error, the failed rules of the validation errors are listed by the problem details
 func (r *Response) Err(err error) *Response {
-	r.Result.Error = err.Error()
+	if fieldErrs, ok := validator.FieldErrors(err); ok {
+		r.fields = fieldErrs
+		r.Result.Error = r.fieldsMessage()
+		return r
+	}
+
+	r.Result.Error = r.publicErr(err)
 	return r
 }
 
@@ -58,11 +78,12 @@ func (r *Response) ServiceErr(err error) *Response {
 	var se *Error
 
 	if errors.As(err, &se) == true {
+		r.status = se.Msg
 		r.Result.Status = st.MappedStatuses[se.Msg]
 		r.Result.Message = r.l.Get(string(se.Msg))
 
 		if se.Err != nil {
-			r.Result.Error = se.Err.Error()
+			r.Result.Error = r.publicErr(se)
 		}
 
 		if len(se.Detail) > 0 {
@@ -76,11 +97,32 @@ func (r *Response) ServiceErr(err error) *Response {
 	return r
 }
 
+// publicErr the message of the error for the clients, the internal errors are replaced by the reference of the request
+func (r *Response) publicErr(err error) string {
+	var se *Error
+	if errors.As(err, &se) == false || se.Internal() == false {
+		return err.Error()
+	}
+
+	reference := reqctx.RequestID(r.ctx)
+	if len(reference) == 0 {
+		return ""
+	}
+
+	return r.l.Plural("internal_error_reference", map[string]string{"reference": reference})
+}
+
 func (r *Response) Json() {
 	if r.Result.Status == 0 {
 		r.Status(st.Success)
 	}
 
+	if r.acceptsProblem() {
+		r.ctx.Header("Content-Type", ProblemMime)
+		r.ctx.JSON(r.Result.Status, r.problem())
+		return
+	}
+
 	r.ctx.JSON(r.Result.Status, r.Result)
 	return
 }
@@ -16,6 +16,8 @@ type (
 		Msg    st.HttpMappedStatus
 		Err    error
 		Detail map[string]any
+		// internal the error is not shown to the clients, see InternalErr
+		internal bool
 	}
 )
 
@@ -28,6 +30,17 @@ func ServiceErr(msg st.HttpMappedStatus, err ...error) *Error {
 	return se
 }
 
+// InternalErr the error of the lower layers, like the database errors with their tables, constraints and statements.
+// the clients get the reference of the request instead of it, the error is logged by the caller with the request id
+func InternalErr(msg st.HttpMappedStatus, err error) *Error {
+	return &Error{Msg: msg, Err: err, internal: true}
+}
+
+// Internal the error is hidden from the clients
+func (svc *Error) Internal() bool {
+	return svc.internal
+}
+
 func (svc *Error) Data(items map[string]any) *Error {
 	svc.Detail = items
 	return svc
@@ -41,3 +54,13 @@ func (svc *Error) SetErr(err string) *Error {
 func (svc *Error) Error() string {
 	return svc.Err.Error()
 }
+
+// ErrStatus the mapped status of the service error, the other errors are failures
+func ErrStatus(err error) st.HttpMappedStatus {
+	var se *Error
+	if errors.As(err, &se) {
+		return se.Msg
+	}
+
+	return st.Failed
+}
@@ -0,0 +1,40 @@
+package meta
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// StreamIdleTimeout the streamed response fails when a write to the client stalls for that long
+const StreamIdleTimeout = time.Minute
+
+// Stream lifts the write timeout of the server for the streamed responses like the exports, which may take longer than it.
+// the write deadline is extended on every write instead, so a stalled client is still cut off
+func Stream(c *gin.Context) {
+	writer := &streamWriter{ResponseWriter: c.Writer, rc: http.NewResponseController(c.Writer)}
+	writer.extend()
+	c.Writer = writer
+}
+
+// streamWriter extends the write deadline of the connection before every write
+type streamWriter struct {
+	gin.ResponseWriter
+	rc *http.ResponseController
+}
+
+func (w *streamWriter) Write(data []byte) (int, error) {
+	w.extend()
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *streamWriter) WriteString(data string) (int, error) {
+	w.extend()
+	return w.ResponseWriter.WriteString(data)
+}
+
+// extend the writers without a deadline like the test recorders are not supported, their writes are not limited anyway
+func (w *streamWriter) extend() {
+	_ = w.rc.SetWriteDeadline(time.Now().Add(StreamIdleTimeout))
+}
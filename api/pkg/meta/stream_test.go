@@ -0,0 +1,64 @@
+package meta
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStream(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	const (
+		writeTimeout = 100 * time.Millisecond
+		rows         = 10
+	)
+
+	// export the rows slower than the write timeout of the server, the whole export takes 3 times longer
+	export := func(c *gin.Context) {
+		c.Status(http.StatusOK)
+		for i := 0; i < rows; i++ {
+			time.Sleep(3 * writeTimeout / rows)
+			_, _ = c.Writer.WriteString("row\n")
+			c.Writer.Flush()
+		}
+	}
+
+	serve := func(t *testing.T, handlers ...gin.HandlerFunc) (string, error) {
+		engine := gin.New()
+		engine.GET("/export", handlers...)
+
+		server := httptest.NewUnstartedServer(engine)
+		server.Config.WriteTimeout = writeTimeout
+		server.Start()
+		t.Cleanup(server.Close)
+
+		resp, err := server.Client().Get(server.URL + "/export")
+		require.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+
+		body, err := io.ReadAll(resp.Body)
+		return string(body), err
+	}
+
+	t.Run("the export longer than the write timeout is complete", func(t *testing.T) {
+		body, err := serve(t, func(c *gin.Context) { Stream(c) }, export)
+
+		assert.NoError(t, err)
+		assert.Equal(t, strings.Repeat("row\n", rows), body)
+	})
+
+	t.Run("the export is cut off by the write timeout without the stream", func(t *testing.T) {
+		body, err := serve(t, export)
+
+		assert.Error(t, err)
+		assert.Less(t, len(body), rows*len("row\n"))
+	})
+}
@@ -0,0 +1,57 @@
+package reqctx
+
+import "context"
+
+// values of the request shared with the lower layers through the context
+
+type ctxKey int
+
+const (
+	actorKey ctxKey = iota
+	requestIDKey
+	groupsKey
+	languageKey
+)
+
+// WithActor stores the id of the user who sends the request
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey, actor)
+}
+
+// Actor the id of the user who sends the request, empty for the anonymous requests
+func Actor(ctx context.Context) string {
+	actor, _ := ctx.Value(actorKey).(string)
+	return actor
+}
+
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey, id)
+}
+
+// RequestID the correlation id of the request, empty when it is not set
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey).(string)
+	return id
+}
+
+// WithGroups stores the ids of the groups of the actor
+func WithGroups(ctx context.Context, groups []string) context.Context {
+	return context.WithValue(ctx, groupsKey, groups)
+}
+
+// Groups the ids of the groups of the actor, nil when they are not set
+func Groups(ctx context.Context) []string {
+	groups, _ := ctx.Value(groupsKey).([]string)
+	return groups
+}
+
+// WithLanguage stores the negotiated language of the response
+func WithLanguage(ctx context.Context, lang string) context.Context {
+	return context.WithValue(ctx, languageKey, lang)
+}
+
+// Language the negotiated language of the response, empty when it is not set
+func Language(ctx context.Context) string {
+	lang, _ := ctx.Value(languageKey).(string)
+	return lang
+}
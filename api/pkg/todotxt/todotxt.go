@@ -0,0 +1,124 @@
+// Package todotxt reads and writes the lines of the todo.txt format(https://github.com/todotxt/todo.txt)
+package todotxt
+
+import (
+	"errors"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ErrEmptyTask the line has no description
+var ErrEmptyTask = errors.New("todotxt: the task has no description")
+
+var (
+	priority = regexp.MustCompile(`^\([A-Z]\)$`)
+	// tag the key starts with a letter, so the times like 10:30 and the URLs are kept in the description
+	tag = regexp.MustCompile(`^([A-Za-z][A-Za-z0-9_-]*):([^\s:/][^\s]*)$`)
+)
+
+// Task a line of the todo.txt format, the projects(+project) and the contexts(@context) are kept in the description
+type Task struct {
+	Completed   bool
+	Priority    string     // A-Z, empty for the tasks without priority
+	CompletedOn *time.Time // date of the completion, only for the completed tasks
+	CreatedOn   *time.Time
+	Description string
+	Tags        map[string]string // the key:value pairs like due:2025-08-07
+}
+
+// Parse reads the line of a task, the malformed dates are kept in the description as the format has no escaping
+func Parse(line string) (res Task, err error) {
+	words := strings.Fields(line)
+
+	if len(words) > 0 && words[0] == "x" {
+		res.Completed = true
+		words = words[1:]
+	}
+
+	if len(words) > 0 && !res.Completed && priority.MatchString(words[0]) {
+		res.Priority = words[0][1:2]
+		words = words[1:]
+	}
+
+	var dates []*time.Time
+	for len(words) > 0 && len(dates) < 2 {
+		date, dateErr := time.Parse(time.DateOnly, words[0])
+		if dateErr != nil {
+			break
+		}
+
+		dates = append(dates, &date)
+		words = words[1:]
+	}
+
+	switch {
+	case len(dates) == 2 && res.Completed:
+		res.CompletedOn, res.CreatedOn = dates[0], dates[1]
+	case len(dates) == 2:
+		res.CreatedOn = dates[0]
+		words = append([]string{dates[1].Format(time.DateOnly)}, words...) // the second date is a part of the description
+	case len(dates) == 1 && res.Completed:
+		res.CompletedOn = dates[0]
+	case len(dates) == 1:
+		res.CreatedOn = dates[0]
+	}
+
+	description := make([]string, 0, len(words))
+	for _, word := range words {
+		if match := tag.FindStringSubmatch(word); match != nil {
+			if res.Tags == nil {
+				res.Tags = make(map[string]string)
+			}
+
+			res.Tags[match[1]] = match[2]
+			continue
+		}
+
+		description = append(description, word)
+	}
+
+	res.Description = strings.Join(description, " ")
+	if len(res.Description) == 0 {
+		err = ErrEmptyTask
+	}
+
+	return
+}
+
+// String the line of the task, the line breaks of the description are replaced with spaces and the tags are sorted by their key
+func (t Task) String() string {
+	var b strings.Builder
+
+	if t.Completed {
+		b.WriteString("x ")
+	} else if len(t.Priority) > 0 {
+		b.WriteString("(" + t.Priority + ") ")
+	}
+
+	if t.Completed && t.CompletedOn != nil {
+		b.WriteString(t.CompletedOn.Format(time.DateOnly) + " ")
+	}
+
+	if t.CreatedOn != nil {
+		b.WriteString(t.CreatedOn.Format(time.DateOnly) + " ")
+	}
+
+	b.WriteString(strings.Join(strings.Fields(t.Description), " "))
+
+	keys := make([]string, 0, len(t.Tags))
+	for key := range t.Tags {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if len(t.Tags[key]) > 0 {
+			b.WriteString(" " + key + ":" + t.Tags[key])
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,65 @@
+package todotxt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("priority, creation date and tags of an open task", func(t *testing.T) {
+		task, err := Parse("(A) 2025-08-01 call @phone +office at 10:30 due:2025-08-07 https://example.com")
+
+		assert.NoError(t, err)
+		assert.False(t, task.Completed)
+		assert.Equal(t, "A", task.Priority)
+		assert.Equal(t, "2025-08-01", task.CreatedOn.Format(time.DateOnly))
+		assert.Equal(t, "call @phone +office at 10:30 https://example.com", task.Description)
+		assert.Equal(t, map[string]string{"due": "2025-08-07"}, task.Tags)
+	})
+
+	t.Run("completion and creation dates of a completed task", func(t *testing.T) {
+		task, err := Parse("x 2025-08-07 2025-08-01 file the report")
+
+		assert.NoError(t, err)
+		assert.True(t, task.Completed)
+		assert.Equal(t, "2025-08-07", task.CompletedOn.Format(time.DateOnly))
+		assert.Equal(t, "2025-08-01", task.CreatedOn.Format(time.DateOnly))
+		assert.Equal(t, "file the report", task.Description)
+	})
+
+	t.Run("the single date of a completed task is its completion", func(t *testing.T) {
+		task, err := Parse("x 2011-03-03 Call Mom")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "2011-03-03", task.CompletedOn.Format(time.DateOnly))
+		assert.Nil(t, task.CreatedOn)
+	})
+
+	t.Run("the task without description is rejected", func(t *testing.T) {
+		_, err := Parse("x 2025-08-07 due:2025-08-08")
+
+		assert.ErrorIs(t, err, ErrEmptyTask)
+	})
+}
+
+func TestTask_String(t *testing.T) {
+	completed, created := time.Date(2025, 8, 7, 0, 0, 0, 0, time.UTC), time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+
+	task := Task{
+		Completed:   true,
+		CompletedOn: &completed,
+		CreatedOn:   &created,
+		Description: "file the\nreport",
+		Tags:        map[string]string{"status": "done", "due": "2025-08-07"},
+	}
+
+	line := task.String()
+	assert.Equal(t, "x 2025-08-07 2025-08-01 file the report due:2025-08-07 status:done", line)
+
+	parsed, err := Parse(line)
+	assert.NoError(t, err)
+	assert.Equal(t, task.Tags, parsed.Tags)
+	assert.Equal(t, "file the report", parsed.Description)
+}
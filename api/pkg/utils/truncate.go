@@ -0,0 +1,28 @@
+package utils
+
+import (
+	"unicode/utf8"
+
+	"github.com/rivo/uniseg"
+)
+
+// Truncate shortens the text to the max count of characters(runes) and appends the ellipsis to the shortened ones.
+// the text is cut between the grapheme clusters, so the marks, the emoji sequences and the flags are never split and the cut may be shorter than max
+func Truncate(text string, max int) string {
+	if utf8.RuneCountInString(text) <= max {
+		return text
+	}
+
+	cut, count := 0, 0
+	graphemes := uniseg.NewGraphemes(text)
+	for graphemes.Next() {
+		count += len(graphemes.Runes())
+		if count > max {
+			break
+		}
+
+		_, cut = graphemes.Positions()
+	}
+
+	return text[:cut] + "..."
+}
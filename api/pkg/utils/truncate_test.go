@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		max  int
+		want string
+	}{
+		{name: "shorter text", text: "buy milk", max: 10, want: "buy milk"},
+		{name: "exact length", text: "buy milk", max: 8, want: "buy milk"},
+		{name: "longer text", text: "buy milk and eggs", max: 8, want: "buy milk..."},
+		{name: "multi-byte runes", text: "größere Äpfel kaufen", max: 7, want: "größere..."},
+		{name: "exact length of multi-byte runes", text: "日本語の", max: 4, want: "日本語の"},
+		{name: "emoji", text: "🥛🥚🍞🧀", max: 2, want: "🥛🥚..."},
+		{name: "combining characters stay with the base", text: "cafe\u0301 au lait", max: 4, want: "caf..."},
+		{name: "cut after the combining character", text: "cafe\u0301 au lait", max: 5, want: "cafe\u0301..."},
+		{name: "spacing marks stay with the base", text: "\u0915\u093e\u0915\u093e", max: 3, want: "\u0915\u093e..."},
+		{name: "enclosing marks stay with the base", text: "1\u20e32\u20e3", max: 3, want: "1\u20e3..."},
+		{name: "variation selectors stay with the base", text: "\u2764\ufe0f\u2764\ufe0f", max: 3, want: "\u2764\ufe0f..."},
+		{name: "joined emoji are not split", text: "\U0001f469\u200d\U0001f4bb tasks", max: 2, want: "..."},
+		{name: "flags are not split", text: "\U0001f1e9\U0001f1ea\U0001f1eb\U0001f1f7", max: 3, want: "\U0001f1e9\U0001f1ea..."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Truncate(tt.text, tt.max))
+		})
+	}
+}
@@ -2,6 +2,9 @@ package validator
 
 import (
 	goValidator "github.com/go-playground/validator/v10"
+	"microservice/pkg/datetime"
+	"microservice/pkg/ical"
+	"reflect"
 	"regexp"
 	"time"
 )
@@ -27,6 +30,18 @@ func isJwt(fl goValidator.FieldLevel) (res bool) {
 	return
 }
 
+// searchLanguages the built-in full-text search configurations of PostgreSQL
+var searchLanguages = map[string]struct{}{
+	"simple": {}, "arabic": {}, "danish": {}, "dutch": {}, "english": {}, "finnish": {}, "french": {},
+	"german": {}, "greek": {}, "hungarian": {}, "indonesian": {}, "italian": {}, "norwegian": {},
+	"portuguese": {}, "romanian": {}, "russian": {}, "spanish": {}, "swedish": {}, "turkish": {},
+}
+
+func isSearchLanguage(fl goValidator.FieldLevel) bool {
+	_, ok := searchLanguages[fl.Field().String()]
+	return ok
+}
+
 func DateValidator(field goValidator.FieldLevel) bool {
 	if dateStr, ok := field.Field().Interface().(string); ok {
 		_, err := time.Parse(DateOnly, dateStr)
@@ -35,6 +50,51 @@ func DateValidator(field goValidator.FieldLevel) bool {
 	return false
 }
 
+// DateOrDateTimeValidator accepts the date-only(2006-01-02) or RFC3339 formats
+func DateOrDateTimeValidator(field goValidator.FieldLevel) bool {
+	value := field.Field().String()
+
+	if _, err := time.Parse(DateOnly, value); err == nil {
+		return true
+	}
+
+	_, err := time.Parse(time.RFC3339, value)
+	return err == nil
+}
+
+// DueDateValidator accepts the absolute, date-only and relative date-time expressions of the `datetime` package,
+// they are parsed in the time zone of the `TimeZone` field of the request like by its conversion
+func DueDateValidator(field goValidator.FieldLevel) bool {
+	var zone string
+	if parent := reflect.Indirect(field.Parent()); parent.Kind() == reflect.Struct {
+		if tz := parent.FieldByName("TimeZone"); tz.IsValid() && tz.Kind() == reflect.String {
+			zone = tz.String()
+		}
+	}
+
+	_, err := datetime.Parse(field.Field().String(), DueLocation(zone), time.Now())
+	return err == nil
+}
+
+// DueLocation the time zone of the zone-less due dates, default: UTC. the invalid zones are reported by the `timezone` rule
+func DueLocation(zone string) *time.Location {
+	if len(zone) == 0 {
+		return time.UTC
+	}
+
+	location, err := time.LoadLocation(zone)
+	if err != nil || location == time.Local {
+		return time.UTC
+	}
+
+	return location
+}
+
+// RecurrenceValidator accepts the RFC 5545 RRULE values, like FREQ=WEEKLY;BYDAY=MO
+func RecurrenceValidator(field goValidator.FieldLevel) bool {
+	return ical.ValidateRecur(field.Field().String()) == nil
+}
+
 func TimeHourMinuteValidator(field goValidator.FieldLevel) bool {
 	if field.Field().Interface().(string) == "" {
 		return true
@@ -0,0 +1,86 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	goValidator "github.com/go-playground/validator/v10"
+)
+
+// FieldError the failed rule of a field
+type FieldError struct {
+	// Field the path of the field by its name in the request, like items[0].dueDate
+	Field string
+	// Rule the tag of the failed rule, like required
+	Rule string
+	// Param the parameter of the rule, like 255 of max=255
+	Param string
+
+	err goValidator.FieldError
+}
+
+// Errors the failed rules of all the fields, in the order of the fields
+type Errors []FieldError
+
+func (e Errors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, fe := range e {
+		messages = append(messages, fmt.Sprintf("validation failed for the %s field.", fe.Field))
+	}
+
+	return strings.Join(messages, " ")
+}
+
+// FieldErrors the failed rules of the validation errors, the other errors are not the errors of the fields
+func FieldErrors(err error) (Errors, bool) {
+	var fieldErrs Errors
+	if errors.As(err, &fieldErrs) {
+		return fieldErrs, true
+	}
+
+	var validationErrs goValidator.ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		return nil, false
+	}
+
+	fieldErrs = make(Errors, 0, len(validationErrs))
+	for _, validationErr := range validationErrs {
+		fieldErrs = append(fieldErrs, FieldError{
+			Field: fieldPath(validationErr.Namespace()),
+			Rule:  validationErr.Tag(),
+			Param: validationErr.Param(),
+			err:   validationErr,
+		})
+	}
+
+	return fieldErrs, true
+}
+
+// HELPERS
+
+// fieldPath the namespace without the name of the validated struct
+func fieldPath(namespace string) string {
+	if _, path, found := strings.Cut(namespace, "."); found {
+		return path
+	}
+
+	return namespace
+}
+
+// fieldName the name of the field in the request: its json, form or param tag, the Go name otherwise
+func fieldName(field reflect.StructField) string {
+	for _, tag := range []string{"json", "form", "param"} {
+		name, _, _ := strings.Cut(field.Tag.Get(tag), ",")
+		if name == "-" {
+			return ""
+		}
+
+		if len(name) > 0 {
+			return name
+		}
+	}
+
+	return field.Name
+}
@@ -0,0 +1,50 @@
+package validator
+
+import (
+	"log"
+
+	"github.com/go-playground/locales/en"
+	"github.com/go-playground/locales/es"
+	ut "github.com/go-playground/universal-translator"
+	goValidator "github.com/go-playground/validator/v10"
+	enTranslations "github.com/go-playground/validator/v10/translations/en"
+	esTranslations "github.com/go-playground/validator/v10/translations/es"
+	"golang.org/x/text/language"
+)
+
+// translators the translators of the messages of the built-in rules, by the base language
+var translators = make(map[string]ut.Translator)
+
+func registerTranslations() {
+	uni := ut.New(en.New(), en.New(), es.New())
+
+	for lang, register := range map[string]func(*goValidator.Validate, ut.Translator) error{
+		"en": enTranslations.RegisterDefaultTranslations,
+		"es": esTranslations.RegisterDefaultTranslations,
+	} {
+		trans, _ := uni.GetTranslator(lang)
+		if err := register(validate, trans); err != nil {
+			log.Fatalf("[validator] %s translations register err: %s", lang, err)
+		}
+
+		translators[lang] = trans
+	}
+}
+
+// Message the translated message of the failed rule in the language, like es-ES.
+// false for the unsupported languages and the rules without a translation, like the custom rules
+func (fe FieldError) Message(lang string) (string, bool) {
+	if fe.err == nil {
+		return "", false
+	}
+
+	base, _ := language.Make(lang).Base()
+	trans, ok := translators[base.String()]
+	if !ok {
+		return "", false
+	}
+
+	// NOTE: the rules without a translation are translated to the error of the validator
+	msg := fe.err.Translate(trans)
+	return msg, msg != fe.err.Error()
+}
@@ -2,8 +2,6 @@ package validator
 
 import (
 	"context"
-	"errors"
-	"fmt"
 	"github.com/gin-gonic/gin/binding"
 	"github.com/go-playground/validator/v10"
 	"log"
@@ -18,23 +16,21 @@ func init() {
 	}
 
 	validate = v
+	// the errors name the fields as they are sent
+	validate.RegisterTagNameFunc(fieldName)
 	registerCustomValidators()
+	registerTranslations()
 }
 
 func ValidateStruct(ctx context.Context, s interface{}) error {
 	return validate.StructCtx(ctx, s)
 }
 
+// ValidateRequestDto validates the request, the failed rules of all the fields are returned as Errors
 func ValidateRequestDto(ctx context.Context, s interface{}) (err error) {
 	if err = validate.StructCtx(ctx, s); err != nil {
-		var validationErrors validator.ValidationErrors
-		if errors.As(err, &validationErrors) {
-			for _, validationError := range validationErrors {
-				errMsg := fmt.Sprintf("validation failed for the %s field.", validationError.Field())
-				err = errors.New(errMsg)
-			}
-
-			return
+		if fieldErrs, ok := FieldErrors(err); ok {
+			err = fieldErrs
 		}
 
 		return
@@ -60,10 +56,26 @@ func registerCustomValidators() {
 		log.Fatalf(errMsg, err)
 	}
 
+	if err = validate.RegisterValidation("searchLanguage", isSearchLanguage); err != nil {
+		log.Fatalf(errMsg, err)
+	}
+
 	if err = validate.RegisterValidation("date", DateValidator, true); err != nil {
 		log.Fatalf(errMsg, err)
 	}
 
+	if err = validate.RegisterValidation("dateOrDateTime", DateOrDateTimeValidator); err != nil {
+		log.Fatalf(errMsg, err)
+	}
+
+	if err = validate.RegisterValidation("dueDate", DueDateValidator); err != nil {
+		log.Fatalf(errMsg, err)
+	}
+
+	if err = validate.RegisterValidation("rrule", RecurrenceValidator); err != nil {
+		log.Fatalf(errMsg, err)
+	}
+
 	if err = validate.RegisterValidation("time", TimeValidator, true); err != nil {
 		log.Fatalf(errMsg, err)
 	}
@@ -0,0 +1,101 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type validatorTestItem struct {
+	Title string `json:"title" binding:"required"`
+}
+
+type validatorTestRequest struct {
+	Description string               `json:"description" binding:"required,max=5"`
+	Priority    int                  `form:"priority" binding:"min=1"`
+	Items       []*validatorTestItem `json:"items" binding:"dive"`
+}
+
+func TestValidateRequestDto(t *testing.T) {
+	t.Run("failed rules of all the fields", func(t *testing.T) {
+		err := ValidateRequestDto(context.Background(), &validatorTestRequest{
+			Description: "too long",
+			Items:       []*validatorTestItem{{Title: "first"}, {}},
+		})
+
+		fieldErrs, ok := FieldErrors(err)
+		assert.True(t, ok)
+
+		rules := make([]string, 0, len(fieldErrs))
+		for _, fe := range fieldErrs {
+			rules = append(rules, fe.Field+" "+fe.Rule+" "+fe.Param)
+		}
+
+		assert.Equal(t, []string{"description max 5", "priority min 1", "items[1].title required "}, rules)
+		assert.Equal(t, "validation failed for the description field. validation failed for the priority field. "+
+			"validation failed for the items[1].title field.", err.Error())
+	})
+
+	t.Run("valid request", func(t *testing.T) {
+		assert.NoError(t, ValidateRequestDto(context.Background(), &validatorTestRequest{Description: "short", Priority: 1}))
+	})
+
+	t.Run("other errors are not the errors of the fields", func(t *testing.T) {
+		_, ok := FieldErrors(errors.New("EOF"))
+		assert.False(t, ok)
+	})
+}
+
+func TestFieldError_Message(t *testing.T) {
+	err := ValidateRequestDto(context.Background(), &validatorTestRequest{Priority: 1})
+	fieldErrs, _ := FieldErrors(err)
+
+	t.Run("translated by the base language", func(t *testing.T) {
+		msg, ok := fieldErrs[0].Message("es-ES")
+		assert.True(t, ok)
+		assert.Equal(t, "description es un campo requerido", msg)
+
+		msg, ok = fieldErrs[0].Message("en-US")
+		assert.True(t, ok)
+		assert.Equal(t, "description is a required field", msg)
+	})
+
+	t.Run("unsupported languages and custom rules are not translated", func(t *testing.T) {
+		_, ok := fieldErrs[0].Message("ja")
+		assert.False(t, ok)
+
+		type customRequest struct {
+			Recurrence string `json:"recurrence" binding:"rrule"`
+		}
+
+		customErrs, _ := FieldErrors(ValidateRequestDto(context.Background(), &customRequest{Recurrence: "DAILY"}))
+		_, ok = customErrs[0].Message("en-US")
+		assert.False(t, ok)
+	})
+}
+
+type dueDateTestRequest struct {
+	DueDate  string `json:"dueDate" binding:"omitempty,dueDate"`
+	TimeZone string `json:"timeZone" binding:"omitempty,timezone"`
+}
+
+func TestDueLocation(t *testing.T) {
+	local := time.Local
+	time.Local = time.FixedZone("UTC+14", 14*60*60)
+	t.Cleanup(func() { time.Local = local })
+
+	t.Run("the zone-less due dates are in UTC without the zone of the server", func(t *testing.T) {
+		assert.Equal(t, time.UTC, DueLocation(""))
+		assert.Equal(t, time.UTC, DueLocation("Local"))
+		assert.Equal(t, "Europe/Berlin", DueLocation("Europe/Berlin").String())
+	})
+
+	t.Run("the due date is validated in the zone of the request", func(t *testing.T) {
+		assert.NoError(t, ValidateRequestDto(context.Background(), &dueDateTestRequest{DueDate: "2025-08-07", TimeZone: "Asia/Tokyo"}))
+		assert.NoError(t, ValidateRequestDto(context.Background(), &dueDateTestRequest{DueDate: "tomorrow 9am"}))
+		assert.Error(t, ValidateRequestDto(context.Background(), &dueDateTestRequest{DueDate: "someday", TimeZone: "Asia/Tokyo"}))
+	})
+}
@@ -0,0 +1,21 @@
+CREATE EXTENSION IF NOT EXISTS "pg_trgm";
+
+-- +migrate Up
+ALTER TABLE todos
+    ADD COLUMN IF NOT EXISTS search_language REGCONFIG NOT NULL DEFAULT 'english';
+
+-- the vector is built with the language of each row, so the stemming stays valid after the configuration changes
+ALTER TABLE todos
+    ADD COLUMN IF NOT EXISTS search_vector TSVECTOR
+        GENERATED ALWAYS AS (to_tsvector(search_language, coalesce(description, ''))) STORED;
+
+CREATE INDEX IF NOT EXISTS todos_search_vector_idx ON todos USING GIN (search_vector);
+
+-- trigram index for the fuzzy (typo tolerant) fallback search
+CREATE INDEX IF NOT EXISTS todos_description_trgm_idx ON todos USING GIN (description gin_trgm_ops);
+
+-- +migrate Down
+-- DROP INDEX IF EXISTS todos_description_trgm_idx;
+-- DROP INDEX IF EXISTS todos_search_vector_idx;
+-- ALTER TABLE todos DROP COLUMN IF EXISTS search_vector;
+-- ALTER TABLE todos DROP COLUMN IF EXISTS search_language;
@@ -0,0 +1,10 @@
+-- +migrate Up
+-- todos without a due date are listed by the `no_due_date` view
+ALTER TABLE todos
+    ALTER COLUMN due_date DROP NOT NULL;
+
+CREATE INDEX IF NOT EXISTS todos_due_date_idx ON todos (due_date);
+
+-- +migrate Down
+-- DROP INDEX IF EXISTS todos_due_date_idx;
+-- ALTER TABLE todos ALTER COLUMN due_date SET NOT NULL;
@@ -0,0 +1,7 @@
+-- +migrate Up
+-- long-form Markdown notes of the todo
+ALTER TABLE todos
+    ADD COLUMN IF NOT EXISTS notes TEXT NULL;
+
+-- +migrate Down
+-- ALTER TABLE todos DROP COLUMN IF EXISTS notes;
@@ -0,0 +1,9 @@
+-- +migrate Up
+-- all-day todos are due at the start of the day in their time zone
+ALTER TABLE todos
+    ADD COLUMN IF NOT EXISTS all_day BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS due_time_zone VARCHAR(64) NULL;
+
+-- +migrate Down
+-- ALTER TABLE todos DROP COLUMN IF EXISTS due_time_zone;
+-- ALTER TABLE todos DROP COLUMN IF EXISTS all_day;
@@ -0,0 +1,7 @@
+-- +migrate Up
+-- optimistic concurrency: the version is incremented on every write and exposed as the ETag
+ALTER TABLE todos
+    ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
+
+-- +migrate Down
+-- ALTER TABLE todos DROP COLUMN IF EXISTS version;
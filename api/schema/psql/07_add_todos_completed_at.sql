@@ -0,0 +1,7 @@
+-- +migrate Up
+-- the completed items are excluded from the overdue filter
+ALTER TABLE todos
+    ADD COLUMN IF NOT EXISTS completed_at TIMESTAMP NULL;
+
+-- +migrate Down
+-- ALTER TABLE todos DROP COLUMN IF EXISTS completed_at;
@@ -0,0 +1,31 @@
+-- +migrate Up
+-- audit trail: every write of the todos is recorded as an immutable revision
+CREATE TABLE IF NOT EXISTS todo_revisions (
+    id BIGSERIAL PRIMARY KEY,
+    todo_uuid UUID NOT NULL,
+    action VARCHAR(16) NOT NULL,
+    actor VARCHAR(255) NULL,
+    request_id VARCHAR(255) NULL,
+    before JSONB NULL,
+    after JSONB NULL,
+    diff JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+    );
+
+CREATE INDEX IF NOT EXISTS todo_revisions_todo_uuid_idx ON todo_revisions (todo_uuid, id);
+
+CREATE OR REPLACE FUNCTION todo_revisions_immutable() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'todo revisions are immutable';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS todo_revisions_immutable_trg ON todo_revisions;
+CREATE TRIGGER todo_revisions_immutable_trg
+    BEFORE UPDATE OR DELETE ON todo_revisions
+    FOR EACH ROW EXECUTE FUNCTION todo_revisions_immutable();
+
+-- +migrate Down
+-- DROP TRIGGER IF EXISTS todo_revisions_immutable_trg ON todo_revisions;
+-- DROP FUNCTION IF EXISTS todo_revisions_immutable;
+-- DROP TABLE todo_revisions;
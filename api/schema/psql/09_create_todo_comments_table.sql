@@ -0,0 +1,23 @@
+-- +migrate Up
+-- threaded comments: the replies reference the root comment of the thread
+CREATE TABLE IF NOT EXISTS todo_comments (
+    id BIGSERIAL PRIMARY KEY,
+    uuid UUID DEFAULT uuid_generate_v4() NOT NULL,
+    todo_uuid UUID NOT NULL,
+    parent_uuid UUID NULL,
+    author VARCHAR(255) NULL,
+    body TEXT NOT NULL,
+    mentions JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL
+    );
+
+CREATE UNIQUE INDEX IF NOT EXISTS todo_comments_uuid_unique ON todo_comments (uuid);
+CREATE INDEX IF NOT EXISTS todo_comments_todo_uuid_idx ON todo_comments (todo_uuid, id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS todo_comments_parent_uuid_idx ON todo_comments (parent_uuid) WHERE deleted_at IS NULL;
+-- the mentions of a user: mentions @> '["alice"]'
+CREATE INDEX IF NOT EXISTS todo_comments_mentions_idx ON todo_comments USING GIN (mentions);
+
+-- +migrate Down
+-- DROP TABLE todo_comments;
@@ -0,0 +1,22 @@
+-- +migrate Up
+-- metadata of the attachments, the contents are the blobs of the storage adapter
+CREATE TABLE IF NOT EXISTS todo_attachments (
+    id BIGSERIAL PRIMARY KEY,
+    uuid UUID DEFAULT uuid_generate_v4() NOT NULL,
+    todo_uuid UUID NOT NULL,
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(255) NOT NULL,
+    size BIGINT NOT NULL,
+    checksum CHAR(64) NOT NULL, -- SHA-256, hex
+    storage_key VARCHAR(512) NOT NULL,
+    uploader VARCHAR(255) NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+    );
+
+CREATE UNIQUE INDEX IF NOT EXISTS todo_attachments_uuid_unique ON todo_attachments (uuid);
+CREATE UNIQUE INDEX IF NOT EXISTS todo_attachments_storage_key_unique ON todo_attachments (storage_key);
+CREATE INDEX IF NOT EXISTS todo_attachments_todo_uuid_idx ON todo_attachments (todo_uuid, id);
+
+-- +migrate Down
+-- DROP TABLE todo_attachments;
@@ -0,0 +1,9 @@
+-- +migrate Up
+-- the creator of the item, the items without owner are accessible by everyone
+ALTER TABLE todos
+    ADD COLUMN IF NOT EXISTS owner VARCHAR(255) NULL;
+
+CREATE INDEX IF NOT EXISTS todos_owner_idx ON todos (owner) WHERE deleted_at IS NULL;
+
+-- +migrate Down
+-- ALTER TABLE todos DROP COLUMN IF EXISTS owner;
@@ -0,0 +1,38 @@
+-- +migrate Up
+-- the permissions granted to the users and the groups on the items
+CREATE TABLE IF NOT EXISTS todo_shares (
+    id BIGSERIAL PRIMARY KEY,
+    uuid UUID DEFAULT uuid_generate_v4() NOT NULL,
+    todo_uuid UUID NOT NULL,
+    grantee_type VARCHAR(16) NOT NULL,
+    grantee VARCHAR(255) NOT NULL,
+    permission VARCHAR(16) NOT NULL,
+    granted_by VARCHAR(255) NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+    );
+
+CREATE UNIQUE INDEX IF NOT EXISTS todo_shares_uuid_unique ON todo_shares (uuid);
+-- a grantee has one grant per item, the grant is upserted
+CREATE UNIQUE INDEX IF NOT EXISTS todo_shares_grantee_unique ON todo_shares (todo_uuid, grantee_type, grantee);
+CREATE INDEX IF NOT EXISTS todo_shares_grantee_idx ON todo_shares (grantee_type, grantee);
+
+-- the read-only links, only the SHA-256 of the token is stored
+CREATE TABLE IF NOT EXISTS todo_share_links (
+    id BIGSERIAL PRIMARY KEY,
+    uuid UUID DEFAULT uuid_generate_v4() NOT NULL,
+    todo_uuid UUID NOT NULL,
+    token_hash CHAR(64) NOT NULL,
+    expires_at TIMESTAMP NULL,
+    created_by VARCHAR(255) NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+    );
+
+CREATE UNIQUE INDEX IF NOT EXISTS todo_share_links_uuid_unique ON todo_share_links (uuid);
+CREATE UNIQUE INDEX IF NOT EXISTS todo_share_links_token_hash_unique ON todo_share_links (token_hash);
+CREATE INDEX IF NOT EXISTS todo_share_links_todo_uuid_idx ON todo_share_links (todo_uuid);
+
+-- +migrate Down
+-- DROP TABLE todo_share_links;
+-- DROP TABLE todo_shares;
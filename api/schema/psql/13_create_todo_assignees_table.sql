@@ -0,0 +1,16 @@
+-- +migrate Up
+-- the users responsible for the items, not necessarily the owner
+CREATE TABLE IF NOT EXISTS todo_assignees (
+    id BIGSERIAL PRIMARY KEY,
+    todo_uuid UUID NOT NULL,
+    assignee VARCHAR(255) NOT NULL,
+    assigned_by VARCHAR(255) NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+    );
+
+CREATE UNIQUE INDEX IF NOT EXISTS todo_assignees_assignee_unique ON todo_assignees (todo_uuid, assignee);
+-- the filters and the workload are looked up by the assignee
+CREATE INDEX IF NOT EXISTS todo_assignees_assignee_idx ON todo_assignees (assignee);
+
+-- +migrate Down
+-- DROP TABLE todo_assignees;
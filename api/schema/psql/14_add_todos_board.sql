@@ -0,0 +1,26 @@
+-- +migrate Up
+-- the column of the item on the board, and its position in the column as a fractional index
+ALTER TABLE todos
+    ADD COLUMN IF NOT EXISTS status VARCHAR(16) NOT NULL DEFAULT 'todo';
+
+-- the ranks are compared bytewise, so their order is the order of the fractional index digits
+ALTER TABLE todos
+    ADD COLUMN IF NOT EXISTS board_rank VARCHAR(255) COLLATE "C" NULL;
+
+UPDATE todos SET status = 'done' WHERE completed_at IS NOT NULL AND status <> 'done';
+
+-- the existing items are ranked by their creation, the fixed width keeps the numeric order and the suffix avoids the trailing zero
+UPDATE todos
+SET board_rank = ranked.board_rank
+FROM (SELECT id,
+             LPAD(ROW_NUMBER() OVER (PARTITION BY status ORDER BY created_at, id)::TEXT, 12, '0') || 'V' AS board_rank
+      FROM todos
+      WHERE board_rank IS NULL) AS ranked
+WHERE todos.id = ranked.id;
+
+CREATE INDEX IF NOT EXISTS todos_board_idx ON todos (status, board_rank) WHERE deleted_at IS NULL;
+
+-- +migrate Down
+-- DROP INDEX IF EXISTS todos_board_idx;
+-- ALTER TABLE todos DROP COLUMN IF EXISTS board_rank;
+-- ALTER TABLE todos DROP COLUMN IF EXISTS status;
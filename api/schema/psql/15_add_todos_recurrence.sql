@@ -0,0 +1,7 @@
+-- +migrate Up
+-- RFC 5545 recurrence rule of the due date, like FREQ=WEEKLY;BYDAY=MO. the calendar clients expand it
+ALTER TABLE todos
+    ADD COLUMN IF NOT EXISTS recurrence VARCHAR(255) NULL;
+
+-- +migrate Down
+-- ALTER TABLE todos DROP COLUMN IF EXISTS recurrence;
@@ -0,0 +1,18 @@
+-- +migrate Up
+-- the subscribed iCalendar feeds of the users, only the SHA-256 of the token is stored
+CREATE TABLE IF NOT EXISTS todo_calendar_feeds (
+    id BIGSERIAL PRIMARY KEY,
+    uuid UUID DEFAULT uuid_generate_v4() NOT NULL,
+    owner VARCHAR(255) NOT NULL,
+    component VARCHAR(16) NOT NULL,
+    token_hash CHAR(64) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+    );
+
+CREATE UNIQUE INDEX IF NOT EXISTS todo_calendar_feeds_uuid_unique ON todo_calendar_feeds (uuid);
+CREATE UNIQUE INDEX IF NOT EXISTS todo_calendar_feeds_token_hash_unique ON todo_calendar_feeds (token_hash);
+CREATE INDEX IF NOT EXISTS todo_calendar_feeds_owner_idx ON todo_calendar_feeds (owner);
+
+-- +migrate Down
+-- DROP TABLE todo_calendar_feeds;